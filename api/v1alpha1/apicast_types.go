@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
 
@@ -144,6 +145,11 @@ type ApicastEnvironmentSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -161,6 +167,10 @@ type ApicastEnvironmentSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 	// Canary defines spec changes for the canary Deployment. If
 	// left unset the canary Deployment wil not be created.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -194,6 +204,8 @@ func (spec *ApicastEnvironmentSpec) Default() {
 	spec.Replicas = intOrDefault(spec.Replicas, &apicastDefaultReplicas)
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, apicastDefaultPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, apicastDefaultResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, DefaultVerticalPodAutoscalerSpec)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, apicastDefaultLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, apicastDefaultReadinessProbe)
 	spec.LoadBalancer = InitializeElasticLoadBalancerSpec(spec.LoadBalancer, DefaultElasticLoadBalancerSpec)
@@ -202,6 +214,15 @@ func (spec *ApicastEnvironmentSpec) Default() {
 	spec.Config.Default()
 }
 
+func (spec *ApicastEnvironmentSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := spec.PDB.validate(fldPath.Child("pdb"))
+	errs = append(errs, spec.HPA.validate(fldPath.Child("hpa"))...)
+	errs = append(errs, spec.VPA.validate(fldPath.Child("vpa"), spec.HPA, nil)...)
+	errs = append(errs, spec.PublishingStrategies.validate(fldPath.Child("publishingStrategies"))...)
+
+	return append(errs, spec.Marin3r.validate(fldPath.Child("marin3r"))...)
+}
+
 // ApicastConfig configures app behavior for Apicast
 type ApicastConfig struct {
 	// Apicast configurations cache TTL
@@ -234,6 +255,7 @@ type ApicastStatus struct {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 
 // Apicast is the Schema for the apicasts API
@@ -250,6 +272,22 @@ func (a *Apicast) Default() {
 	a.Spec.Default()
 }
 
+// Validate checks the Apicast for errors that the CRD schema can't detect
+func (a *Apicast) Validate() error {
+	// canary patches are applied to the defaulted spec
+	defaulted := a.Spec.DeepCopy()
+	defaulted.Default()
+
+	fldPath := field.NewPath("spec")
+	errs := field.ErrorList{}
+	errs = append(errs, a.Spec.Staging.validate(fldPath.Child("staging"))...)
+	errs = append(errs, validateCanary(fldPath.Child("staging", "canary"), a.Spec.Staging.Canary, defaulted.ResolveCanarySpec)...)
+	errs = append(errs, a.Spec.Production.validate(fldPath.Child("production"))...)
+	errs = append(errs, validateCanary(fldPath.Child("production", "canary"), a.Spec.Production.Canary, defaulted.ResolveCanarySpec)...)
+
+	return invalid("Apicast", a.GetName(), errs)
+}
+
 var _ reconciler.ObjectWithAppStatus = &Apicast{}
 
 func (d *Apicast) GetStatus() any {
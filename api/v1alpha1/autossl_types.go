@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
 
@@ -90,6 +91,11 @@ type AutoSSLSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -111,6 +117,10 @@ type AutoSSLSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 	// Canary defines spec changes for the canary Deployment. If
 	// left unset the canary Deployment wil not be created.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -139,6 +149,8 @@ func (spec *AutoSSLSpec) Default() {
 	spec.Replicas = intOrDefault(spec.Replicas, &autosslDefaultReplicas)
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, autosslDefaultPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, autosslDefaultResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, DefaultVerticalPodAutoscalerSpec)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, autosslDefaultProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, autosslDefaultProbe)
 	spec.LoadBalancer = InitializeElasticLoadBalancerSpec(spec.LoadBalancer, DefaultElasticLoadBalancerSpec)
@@ -229,6 +241,7 @@ type AutoSSLStatus struct {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 
 // AutoSSL is the Schema for the autossls API
@@ -245,6 +258,23 @@ func (a *AutoSSL) Default() {
 	a.Spec.Default()
 }
 
+// Validate checks the AutoSSL for errors that the CRD schema can't detect
+func (a *AutoSSL) Validate() error {
+	// canary patches are applied to the defaulted spec
+	defaulted := a.Spec.DeepCopy()
+	defaulted.Default()
+
+	fldPath := field.NewPath("spec")
+	errs := field.ErrorList{}
+	errs = append(errs, a.Spec.PDB.validate(fldPath.Child("pdb"))...)
+	errs = append(errs, a.Spec.HPA.validate(fldPath.Child("hpa"))...)
+	errs = append(errs, a.Spec.VPA.validate(fldPath.Child("vpa"), a.Spec.HPA, nil)...)
+	errs = append(errs, a.Spec.PublishingStrategies.validate(fldPath.Child("publishingStrategies"))...)
+	errs = append(errs, validateCanary(fldPath.Child("canary"), a.Spec.Canary, defaulted.ResolveCanarySpec)...)
+
+	return invalid("AutoSSL", a.GetName(), errs)
+}
+
 var _ reconciler.ObjectWithAppStatus = &AutoSSL{}
 
 func (d *AutoSSL) GetStatus() any {
@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
 
@@ -227,6 +228,11 @@ type ListenerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -243,6 +249,10 @@ type ListenerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 	// Canary defines spec changes for the canary Deployment. If
 	// left unset the canary Deployment wil not be created.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -275,6 +285,8 @@ func (spec *ListenerSpec) Default() {
 	spec.Replicas = intOrDefault(spec.Replicas, &backendDefaultListenerReplicas)
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, backendDefaultListenerPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, backendDefaultListenerResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, DefaultVerticalPodAutoscalerSpec)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, backendDefaultListenerLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, backendDefaultListenerReadinessProbe)
 	spec.LoadBalancer = InitializeNetworkLoadBalancerSpec(spec.LoadBalancer, DefaultNetworkLoadBalancerSpec)
@@ -288,6 +300,15 @@ func (spec *ListenerSpec) Default() {
 	spec.Config.Default()
 }
 
+func (spec *ListenerSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := spec.PDB.validate(fldPath.Child("pdb"))
+	errs = append(errs, spec.HPA.validate(fldPath.Child("hpa"))...)
+	errs = append(errs, spec.VPA.validate(fldPath.Child("vpa"), spec.HPA, nil)...)
+	errs = append(errs, spec.PublishingStrategies.validate(fldPath.Child("publishingStrategies"))...)
+
+	return append(errs, spec.Marin3r.validate(fldPath.Child("marin3r"))...)
+}
+
 // WorkerSpec is the configuration for Backend Worker
 type WorkerSpec struct {
 	// Listener specific configuration options for the component element
@@ -302,6 +323,11 @@ type WorkerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// KEDA ScaledObject for the component. When set, it replaces the
+	// Horizontal Pod Autoscaler to scale on external events like queue depth.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	KEDA *KEDAScaledObjectSpec `json:"keda,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -310,6 +336,11 @@ type WorkerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -326,6 +357,10 @@ type WorkerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 	// Canary defines spec changes for the canary Deployment. If
 	// left unset the canary Deployment wil not be created.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -336,9 +371,15 @@ type WorkerSpec struct {
 // Default implements defaulting for the each backend worker
 func (spec *WorkerSpec) Default() {
 	spec.HPA = InitializeHorizontalPodAutoscalerSpec(spec.HPA, backendDefaultWorkerHPA)
+	spec.KEDA = InitializeKEDAScaledObjectSpec(spec.KEDA, defaultKEDAScaledObjectSpec{
+		MinReplicas: backendDefaultWorkerHPA.MinReplicas,
+		MaxReplicas: backendDefaultWorkerHPA.MaxReplicas,
+	})
 	spec.Replicas = intOrDefault(spec.Replicas, &backendDefaultWorkerReplicas)
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, backendDefaultWorkerPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, backendDefaultWorkerResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, DefaultVerticalPodAutoscalerSpec)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, backendDefaultWorkerLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, backendDefaultWorkerReadinessProbe)
 
@@ -349,6 +390,20 @@ func (spec *WorkerSpec) Default() {
 	spec.Config.Default()
 }
 
+func (spec *WorkerSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if spec == nil {
+		return errs
+	}
+
+	errs = append(errs, spec.PDB.validate(fldPath.Child("pdb"))...)
+	errs = append(errs, spec.HPA.validate(fldPath.Child("hpa"))...)
+	errs = append(errs, spec.VPA.validate(fldPath.Child("vpa"), spec.HPA, spec.KEDA)...)
+
+	return append(errs, spec.KEDA.validate(fldPath.Child("keda"))...)
+}
+
 // CronSpec is the configuration for Backend Cron
 type CronSpec struct {
 	// Number of replicas for the component
@@ -359,6 +414,11 @@ type CronSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Describes node affinity scheduling rules for the pod.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -367,12 +427,18 @@ type CronSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 }
 
 // Default implements defaulting for the each backend cron
 func (spec *CronSpec) Default() {
 	spec.Replicas = intOrDefault(spec.Replicas, &backendDefaultCronReplicas)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, backendDefaultCronResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, DefaultVerticalPodAutoscalerSpec)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 }
 
 // BackendConfig configures app behavior for Backend
@@ -479,6 +545,7 @@ type BackendStatus struct {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 
 // Backend is the Schema for the backends API
@@ -495,6 +562,25 @@ func (b *Backend) Default() {
 	b.Spec.Default()
 }
 
+// Validate checks the Backend for errors that the CRD schema can't detect
+func (b *Backend) Validate() error {
+	// canary patches are applied to the defaulted spec
+	defaulted := b.Spec.DeepCopy()
+	defaulted.Default()
+
+	fldPath := field.NewPath("spec")
+	errs := field.ErrorList{}
+	errs = append(errs, b.Spec.Listener.validate(fldPath.Child("listener"))...)
+	errs = append(errs, validateCanary(fldPath.Child("listener", "canary"), b.Spec.Listener.Canary, defaulted.ResolveCanarySpec)...)
+	errs = append(errs, b.Spec.Worker.validate(fldPath.Child("worker"))...)
+
+	if b.Spec.Worker != nil {
+		errs = append(errs, validateCanary(fldPath.Child("worker", "canary"), b.Spec.Worker.Canary, defaulted.ResolveCanarySpec)...)
+	}
+
+	return invalid("Backend", b.GetName(), errs)
+}
+
 var _ reconciler.ObjectWithAppStatus = &Backend{}
 
 func (d *Backend) GetStatus() any {
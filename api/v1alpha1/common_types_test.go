@@ -960,6 +960,63 @@ func TestInitializeHorizontalPodAutoscalerSpec(t *testing.T) {
 	}
 }
 
+func TestInitializeSchedulingSpec(t *testing.T) {
+	type args struct {
+		spec *SchedulingSpec
+		def  SchedulingSpec
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want *SchedulingSpec
+	}{
+		{
+			name: "Initializes the struct with appropriate defaults if nil",
+			args: args{nil, DefaultSchedulingSpec},
+			want: &SchedulingSpec{
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+					MaxSkew:           1,
+					TopologyKey:       corev1.LabelTopologyZone,
+					WhenUnsatisfiable: corev1.ScheduleAnyway,
+				}},
+				PodAntiAffinity: ptr.To(PodAntiAffinityPresetSoft),
+			},
+		},
+		{
+			name: "Keeps the values that are set",
+			args: args{&SchedulingSpec{
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+					MaxSkew:           2,
+					TopologyKey:       corev1.LabelHostname,
+					WhenUnsatisfiable: corev1.DoNotSchedule,
+				}},
+				PodAntiAffinity:    ptr.To(PodAntiAffinityPresetHard),
+				PriorityClassName:  ptr.To("high"),
+				ServiceAccountName: ptr.To("sa"),
+			}, DefaultSchedulingSpec},
+			want: &SchedulingSpec{
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+					MaxSkew:           2,
+					TopologyKey:       corev1.LabelHostname,
+					WhenUnsatisfiable: corev1.DoNotSchedule,
+				}},
+				PodAntiAffinity:    ptr.To(PodAntiAffinityPresetHard),
+				PriorityClassName:  ptr.To("high"),
+				ServiceAccountName: ptr.To("sa"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := InitializeSchedulingSpec(tt.args.spec, tt.args.def)
+			if diff := deep.Equal(got, tt.want); len(diff) > 0 {
+				t.Errorf("InitializeSchedulingSpec() = diff %v", diff)
+			}
+		})
+	}
+}
+
 func TestResourceRequirementsSpec_Default(t *testing.T) {
 	type fields struct {
 		Limits   corev1.ResourceList
@@ -0,0 +1,41 @@
+package v1alpha1
+
+// v1alpha1 is the hub version of the saas API group: the controllers
+// work with v1alpha1 objects and the other versions are converted
+// to and from it by the conversion webhook.
+
+// Hub marks this type as a conversion hub.
+func (*Apicast) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*AutoSSL) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*Backend) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*CORSProxy) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*EchoAPI) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*MappingService) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*RedisShard) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*Sentinel) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*ShardedRedisBackup) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*System) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*TwemproxyConfig) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*Zync) Hub() {}
@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
 
@@ -87,6 +88,11 @@ type CORSProxySpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -108,6 +114,10 @@ type CORSProxySpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 	// Describes how the services provided by this workload are exposed to its consumers
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -121,6 +131,8 @@ func (spec *CORSProxySpec) Default() {
 	spec.Replicas = intOrDefault(spec.Replicas, &corsproxyDefaultReplicas)
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, corsproxyDefaultPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, corsproxyDefaultResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, DefaultVerticalPodAutoscalerSpec)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, corsproxyDefaultProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, corsproxyDefaultProbe)
 	spec.GrafanaDashboard = InitializeGrafanaDashboardSpec(spec.GrafanaDashboard, corsproxyDefaultGrafanaDashboard)
@@ -150,6 +162,7 @@ type CORSProxyStatus struct {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 
 // CORSProxy is the Schema for the corsproxies API
@@ -166,6 +179,18 @@ func (c *CORSProxy) Default() {
 	c.Spec.Default()
 }
 
+// Validate checks the CORSProxy for errors that the CRD schema can't detect
+func (c *CORSProxy) Validate() error {
+	fldPath := field.NewPath("spec")
+	errs := field.ErrorList{}
+	errs = append(errs, c.Spec.PDB.validate(fldPath.Child("pdb"))...)
+	errs = append(errs, c.Spec.HPA.validate(fldPath.Child("hpa"))...)
+	errs = append(errs, c.Spec.VPA.validate(fldPath.Child("vpa"), c.Spec.HPA, nil)...)
+	errs = append(errs, c.Spec.PublishingStrategies.validate(fldPath.Child("publishingStrategies"))...)
+
+	return invalid("CORSProxy", c.GetName(), errs)
+}
+
 var _ reconciler.ObjectWithAppStatus = &CORSProxy{}
 
 func (d *CORSProxy) GetStatus() any {
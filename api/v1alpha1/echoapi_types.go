@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
 
@@ -90,6 +91,11 @@ type EchoAPISpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -104,6 +110,10 @@ type EchoAPISpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 	// Describes how the services provided by this workload are exposed to its consumers
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -132,6 +142,8 @@ func (spec *EchoAPISpec) Default() {
 	spec.Replicas = intOrDefault(spec.Replicas, &echoapiDefaultReplicas)
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, echoapiDefaultPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, echoapiDefaultResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, DefaultVerticalPodAutoscalerSpec)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, echoapiDefaultLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, echoapiDefaultReadinessProbe)
 	spec.Marin3r = InitializeMarin3rSidecarSpec(spec.Marin3r, echoapiDefaultMarin3rSpec)
@@ -145,6 +157,7 @@ type EchoAPIStatus struct {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 
 // EchoAPI is the Schema for the echoapis API
@@ -161,6 +174,19 @@ func (e *EchoAPI) Default() {
 	e.Spec.Default()
 }
 
+// Validate checks the EchoAPI for errors that the CRD schema can't detect
+func (e *EchoAPI) Validate() error {
+	fldPath := field.NewPath("spec")
+	errs := field.ErrorList{}
+	errs = append(errs, e.Spec.PDB.validate(fldPath.Child("pdb"))...)
+	errs = append(errs, e.Spec.HPA.validate(fldPath.Child("hpa"))...)
+	errs = append(errs, e.Spec.VPA.validate(fldPath.Child("vpa"), e.Spec.HPA, nil)...)
+	errs = append(errs, e.Spec.PublishingStrategies.validate(fldPath.Child("publishingStrategies"))...)
+	errs = append(errs, e.Spec.Marin3r.validate(fldPath.Child("marin3r"))...)
+
+	return invalid("EchoAPI", e.GetName(), errs)
+}
+
 var _ reconciler.ObjectWithAppStatus = &EchoAPI{}
 
 func (d *EchoAPI) GetStatus() any {
@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
 
@@ -95,6 +96,11 @@ type MappingServiceSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -116,6 +122,10 @@ type MappingServiceSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 	// Describes how the services provided by this workload are exposed to its consumers
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -129,6 +139,8 @@ func (spec *MappingServiceSpec) Default() {
 	spec.Replicas = intOrDefault(spec.Replicas, &mappingserviceDefaultReplicas)
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, mappingserviceDefaultPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, mappingserviceDefaultResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, DefaultVerticalPodAutoscalerSpec)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, mappingserviceLivenessDefaultProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, mappingserviceReadinessDefaultProbe)
 	spec.GrafanaDashboard = InitializeGrafanaDashboardSpec(spec.GrafanaDashboard, mappingserviceDefaultGrafanaDashboard)
@@ -171,6 +183,7 @@ type MappingServiceStatus struct {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 
 // MappingService is the Schema for the mappingservices API
@@ -187,6 +200,18 @@ func (ms *MappingService) Default() {
 	ms.Spec.Default()
 }
 
+// Validate checks the MappingService for errors that the CRD schema can't detect
+func (ms *MappingService) Validate() error {
+	fldPath := field.NewPath("spec")
+	errs := field.ErrorList{}
+	errs = append(errs, ms.Spec.PDB.validate(fldPath.Child("pdb"))...)
+	errs = append(errs, ms.Spec.HPA.validate(fldPath.Child("hpa"))...)
+	errs = append(errs, ms.Spec.VPA.validate(fldPath.Child("vpa"), ms.Spec.HPA, nil)...)
+	errs = append(errs, ms.Spec.PublishingStrategies.validate(fldPath.Child("publishingStrategies"))...)
+
+	return invalid("MappingService", ms.GetName(), errs)
+}
+
 var _ reconciler.ObjectWithAppStatus = &MappingService{}
 
 func (d *MappingService) GetStatus() any {
@@ -2,12 +2,14 @@ package v1alpha1
 
 import (
 	"reflect"
+	"slices"
 	"sort"
 
 	envoyconfig "github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/envoyconfig/descriptor"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
 
@@ -57,17 +59,34 @@ func InitializePublishingStrategies(spec *PublishingStrategies) *PublishingStrat
 	}
 }
 
+// validate checks the Marin3rSidecar strategies of the endpoints
+func (ps *PublishingStrategies) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if ps == nil {
+		return errs
+	}
+
+	for idx, ep := range ps.Endpoints {
+		errs = append(errs, ep.Marin3rSidecar.validate(fldPath.Child("endpoints").Index(idx).Child("marin3rSidecar"))...)
+	}
+
+	return errs
+}
+
 type Strategy string
 
 const (
 	SimpleStrategy         Strategy = "Simple"
 	Marin3rSidecarStrategy Strategy = "Marin3rSidecar"
+	GatewayAPIStrategy     Strategy = "GatewayAPI"
+	IngressStrategy        Strategy = "Ingress"
 )
 
 type PublishingStrategy struct {
 	// Strategy defines the type of publishing strategy
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	// +kubebuilder:validation:Enum=Simple;Marin3rSidecar
+	// +kubebuilder:validation:Enum=Simple;Marin3rSidecar;GatewayAPI;Ingress
 	Strategy Strategy `json:"strategy"`
 	// EndpointName defines the endpoint affected by this publishing strategy
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -80,6 +99,23 @@ type PublishingStrategy struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Marin3rSidecar *Marin3rSidecarSpec `json:"marin3rSidecar,omitempty"`
+	// GatewayAPI holds configuration for the GatewayAPI publishing strategy
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GatewayAPI *GatewayAPISpec `json:"gatewayAPI,omitempty"`
+	// Ingress holds configuration for the Ingress publishing strategy
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Ingress *IngressSpec `json:"ingress,omitempty"`
+	// DeletionPolicy defines what happens to the Service of the endpoint when it is no
+	// longer generated, because the endpoint is removed or its strategy changes. With
+	// "Delete" the Service is deleted, although LoadBalancer Services are only deleted
+	// once they are annotated with "saas.3scale.net/allow-deletion=true". With "Orphan"
+	// the Service is released from the custom resource and kept. Defaults to "Delete".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
 	// Create explicitly tells the controller that this is a new endpoint that
 	// should be added. Default is false, causing the controller to error when seeing
 	// an unknown endpoint.
@@ -88,12 +124,32 @@ type PublishingStrategy struct {
 	Create *bool `json:"create,omitempty"`
 }
 
+type DeletionPolicy string
+
+const (
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
+const (
+	// DeletionPolicyAnnotation records the deletion policy of the endpoint
+	// in its Service, as the policy is no longer in the spec when the
+	// endpoint is removed
+	DeletionPolicyAnnotation string = AnnotationsDomain + "/deletion-policy"
+	// AllowDeletionAnnotation must be set to "true" in LoadBalancer Services
+	// that are no longer generated to allow their deletion
+	AllowDeletionAnnotation string = AnnotationsDomain + "/allow-deletion"
+)
+
 type ServiceType string
 
 const (
 	ServiceTypeClusterIP ServiceType = "ClusterIP"
 	ServiceTypeNLB       ServiceType = "NLB"
 	ServiceTypeELB       ServiceType = "ELB"
+	// ServiceTypeAWSLoadBalancer is a NLB managed by
+	// the aws-load-balancer-controller in ip target mode
+	ServiceTypeAWSLoadBalancer ServiceType = "AWSLoadBalancer"
 )
 
 type Simple struct {
@@ -101,11 +157,11 @@ type Simple struct {
 	// the service to its consumers
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
-	// +kubebuilder:validation:Enum=ClusterIP;ELB;NLB
+	// +kubebuilder:validation:Enum=ClusterIP;ELB;NLB;AWSLoadBalancer
 	ServiceType *ServiceType `json:"serviceType,omitempty"`
 	// ExternalDnsHostnames defines the hostnames that ExternalDNS
 	// should configure records for external consumners to reach the service
-	// Only works with Services of type NLB/ELB
+	// Only works with Services of type NLB/ELB/AWSLoadBalancer
 	ExternalDnsHostnames []string `json:"externalDnsHostnames,omitempty"`
 	// ServiceNameOverride allows the user to override the generated
 	// Service name
@@ -126,6 +182,10 @@ type Simple struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	NetworkLoadBalancerConfig *NetworkLoadBalancerSpec `json:"networkLoadBalancerConfig,omitempty"`
+	// aws-load-balancer-controller configuration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AWSLoadBalancerConfig *AWSLoadBalancerSpec `json:"awsLoadBalancerConfig,omitempty"`
 }
 
 func (s *Simple) Default() {
@@ -134,6 +194,219 @@ func (s *Simple) Default() {
 	}
 }
 
+type GatewayAPIRouteType string
+
+const (
+	HTTPRouteType GatewayAPIRouteType = "HTTPRoute"
+	GRPCRouteType GatewayAPIRouteType = "GRPCRoute"
+	TLSRouteType  GatewayAPIRouteType = "TLSRoute"
+)
+
+// GatewayAPISpec publishes the endpoint through a Gateway API implementation. A ClusterIP
+// Service is created for the endpoint and a route that sends traffic to it is attached
+// to the referenced Gateways.
+type GatewayAPISpec struct {
+	// RouteType is the kind of route to generate. Defaults to HTTPRoute.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=HTTPRoute;GRPCRoute;TLSRoute
+	// +optional
+	RouteType *GatewayAPIRouteType `json:"routeType,omitempty"`
+	// ParentRefs are the Gateways the route attaches to
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems=1
+	ParentRefs []GatewayParentRef `json:"parentRefs"`
+	// Hostnames that the route matches. TLSRoutes match them against the SNI.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Hostnames []string `json:"hostnames,omitempty"`
+	// PathMatches are the request paths that the route matches. Only
+	// used by HTTPRoutes. Defaults to all paths.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PathMatches []GatewayAPIPathMatch `json:"pathMatches,omitempty"`
+	// BackendPort is the port of the Service the route sends traffic
+	// to. Defaults to the first port of the Service.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	BackendPort *int32 `json:"backendPort,omitempty"`
+	// ServiceNameOverride allows the user to override the generated
+	// Service name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ServiceNameOverride *string `json:"serviceName,omitempty"`
+	// ServicePortsOverride allows the user to override the ports
+	// of a Service. It's a replace operation, so specify all the
+	// required ports.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ServicePortsOverride []corev1.ServicePort `json:"servicePorts,omitempty"`
+}
+
+// Default sets default values for any value not specifically set in the GatewayAPISpec struct
+func (spec *GatewayAPISpec) Default() {
+	if spec.RouteType == nil {
+		spec.RouteType = ptr.To(HTTPRouteType)
+	}
+
+	for idx := range spec.PathMatches {
+		if spec.PathMatches[idx].Type == nil {
+			spec.PathMatches[idx].Type = ptr.To(PathPrefixMatchType)
+		}
+	}
+}
+
+// GatewayParentRef references a Gateway
+type GatewayParentRef struct {
+	// Name of the Gateway
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// Namespace of the Gateway. Defaults to the namespace of the route.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// SectionName is the name of the Gateway listener to attach to
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SectionName *string `json:"sectionName,omitempty"`
+}
+
+type GatewayAPIPathMatchType string
+
+const (
+	PathPrefixMatchType        GatewayAPIPathMatchType = "PathPrefix"
+	ExactMatchType             GatewayAPIPathMatchType = "Exact"
+	RegularExpressionMatchType GatewayAPIPathMatchType = "RegularExpression"
+)
+
+// GatewayAPIPathMatch matches the request path
+type GatewayAPIPathMatch struct {
+	// Type of the match. Defaults to PathPrefix.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=PathPrefix;Exact;RegularExpression
+	// +optional
+	Type *GatewayAPIPathMatchType `json:"type,omitempty"`
+	// Value to match the path against
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Value string `json:"value"`
+}
+
+type IngressKind string
+
+const (
+	IngressKindIngress IngressKind = "Ingress"
+	IngressKindRoute   IngressKind = "Route"
+)
+
+// IngressSpec publishes the endpoint through the cluster ingress controller. A
+// ClusterIP Service is created for the endpoint and a networking.k8s.io/v1 Ingress
+// or, in OpenShift clusters, a route.openshift.io/v1 Route sends traffic to it.
+type IngressSpec struct {
+	// Kind is the kind of resource to generate. Defaults to Route when the
+	// route.openshift.io API is available in the cluster, and to Ingress otherwise.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=Ingress;Route
+	// +optional
+	Kind *IngressKind `json:"kind,omitempty"`
+	// ExternalDnsHostnames are the hostnames the endpoint is published at.
+	// Ingresses get a rule for each hostname and a Route is generated for
+	// each one of them, as Routes only hold a single hostname. Routes require
+	// at least one hostname.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExternalDnsHostnames []string `json:"externalDnsHostnames,omitempty"`
+	// IngressClassName is the class of the Ingress. Only used by Ingresses.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+	// Path is the path prefix that is sent to the endpoint. Defaults to "/".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// TLS configures the termination of TLS. TLS is disabled if unset.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TLS *IngressTLSSpec `json:"tls,omitempty"`
+	// Timeout is the server timeout of the ingress controller for the endpoint.
+	// It's set through the HAProxy and NGINX ingress controller annotations.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Annotations are extra annotations to add to the generated resources
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// BackendPort is the port of the Service the traffic is sent
+	// to. Defaults to the first port of the Service.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	BackendPort *int32 `json:"backendPort,omitempty"`
+	// ServiceNameOverride allows the user to override the generated
+	// Service name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ServiceNameOverride *string `json:"serviceName,omitempty"`
+	// ServicePortsOverride allows the user to override the ports
+	// of a Service. It's a replace operation, so specify all the
+	// required ports.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ServicePortsOverride []corev1.ServicePort `json:"servicePorts,omitempty"`
+}
+
+// Default sets default values for any value not specifically set in the IngressSpec struct
+func (spec *IngressSpec) Default() {
+	if spec.Path == nil {
+		spec.Path = ptr.To("/")
+	}
+
+	if spec.TLS != nil && spec.TLS.Termination == nil {
+		spec.TLS.Termination = ptr.To(TLSTerminationEdge)
+	}
+}
+
+type TLSTermination string
+
+const (
+	TLSTerminationEdge        TLSTermination = "edge"
+	TLSTerminationPassthrough TLSTermination = "passthrough"
+	TLSTerminationReencrypt   TLSTermination = "reencrypt"
+)
+
+type InsecureEdgeTerminationPolicy string
+
+const (
+	InsecureEdgeTerminationPolicyNone     InsecureEdgeTerminationPolicy = "None"
+	InsecureEdgeTerminationPolicyAllow    InsecureEdgeTerminationPolicy = "Allow"
+	InsecureEdgeTerminationPolicyRedirect InsecureEdgeTerminationPolicy = "Redirect"
+)
+
+// IngressTLSSpec configures the termination of TLS for the Ingress publishing strategy
+type IngressTLSSpec struct {
+	// Termination is where TLS is terminated. Defaults to "edge". Ingresses
+	// only support "passthrough" and "reencrypt" in OpenShift clusters, where
+	// they are converted to Routes.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=edge;passthrough;reencrypt
+	// +optional
+	Termination *TLSTermination `json:"termination,omitempty"`
+	// SecretName is the Secret that holds the TLS certificate. Only used
+	// by Ingresses, Routes use the certificate of the router.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecretName *string `json:"secretName,omitempty"`
+	// InsecureEdgeTerminationPolicy defines how plain HTTP requests
+	// are handled. Only used by Routes.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=None;Allow;Redirect
+	// +optional
+	InsecureEdgeTerminationPolicy *InsecureEdgeTerminationPolicy `json:"insecureEdgeTerminationPolicy,omitempty"`
+	// DestinationCACertificate is the CA used to validate the certificate
+	// of the endpoint when TLS is reencrypted. Only used by Routes.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DestinationCACertificate *string `json:"destinationCACertificate,omitempty"`
+}
+
 // Marin3rSidecarSpec defines the marin3r sidecar for the component
 type Marin3rSidecarSpec struct {
 	*Simple `json:",inline"`
@@ -203,6 +476,12 @@ func (spec *Marin3rSidecarSpec) Default(def defaultMarin3rSidecarSpec) {
 	if spec.ExtraPodAnnotations == nil {
 		spec.ExtraPodAnnotations = def.ExtraPodAnnotations
 	}
+
+	for _, config := range spec.EnvoyDynamicConfig {
+		if config.Runtime != nil {
+			config.Runtime.Default(spec.Resources)
+		}
+	}
 }
 
 // IsDeactivated true if the field is set with the deactivated value (empty struct)
@@ -210,6 +489,40 @@ func (spec *Marin3rSidecarSpec) IsDeactivated() bool {
 	return reflect.DeepEqual(spec, &Marin3rSidecarSpec{})
 }
 
+// validate checks the runtimes of the sidecar against the
+// listeners defined in the sidecar's dynamic configs
+func (spec *Marin3rSidecarSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if spec == nil {
+		return errs
+	}
+
+	listeners := []string{}
+
+	for name, config := range spec.EnvoyDynamicConfig {
+		if config.ListenerHttp != nil || config.ListenerHttp3 != nil || config.ListenerTcp != nil ||
+			(config.RawConfig != nil && config.RawConfig.Type == "listener") {
+			listeners = append(listeners, name)
+		}
+	}
+
+	names := make([]string, 0, len(spec.EnvoyDynamicConfig))
+	for name := range spec.EnvoyDynamicConfig {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if rt := spec.EnvoyDynamicConfig[name].Runtime; rt != nil {
+			errs = append(errs, rt.validate(fldPath.Child("dynamicConfigs").Key(name).Child("runtime"), listeners)...)
+		}
+	}
+
+	return errs
+}
+
 // InitializeMarin3rSidecarSpec initializes a ResourceRequirementsSpec struct
 func InitializeMarin3rSidecarSpec(spec *Marin3rSidecarSpec, def defaultMarin3rSidecarSpec) *Marin3rSidecarSpec {
 	if spec == nil {
@@ -273,6 +586,14 @@ type EnvoyDynamicConfig struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	ListenerHttp *ListenerHttp `json:"listenerHttp,omitempty"`
+	// ListenerHttp3 contains options for an HTTP/3 (QUIC) listener
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ListenerHttp3 *ListenerHttp3 `json:"listenerHttp3,omitempty"`
+	// ListenerTcp contains options for a TCP proxy listener
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ListenerTcp *ListenerTcp `json:"listenerTcp,omitempty"`
 	// RouteConfiguration contains options for an Envoy route_configuration
 	// protobuffer message
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -305,12 +626,16 @@ func (config *EnvoyDynamicConfig) GetName() string {
 
 // GetGeneratorVersion returns the template's version
 func (config *EnvoyDynamicConfig) GetGeneratorVersion() string {
-	return *config.GeneratorVersion
+	return ptr.Deref(config.GeneratorVersion, "v1")
 }
 
 func (config *EnvoyDynamicConfig) GetOptions() any {
 	if config.ListenerHttp != nil {
 		return config.ListenerHttp
+	} else if config.ListenerHttp3 != nil {
+		return config.ListenerHttp3
+	} else if config.ListenerTcp != nil {
+		return config.ListenerTcp
 	} else if config.RouteConfiguration != nil {
 		return config.RouteConfiguration
 	} else if config.Cluster != nil {
@@ -369,59 +694,962 @@ type ListenerHttp struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	MaxRequestHeadersKb *uint32 `json:"maxRequestHeadersKb,omitempty"`
+	// Ordered list of http filters to add to the HTTP connection manager.
+	// The filters are placed before the ratelimit and router filters, in the
+	// same order they are declared.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HttpFilters []HttpFilter `json:"httpFilters,omitempty"`
+	// Access log options. If unset, a JSON access log with a predefined
+	// set of fields is written to stdout.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AccessLog *AccessLogOptions `json:"accessLog,omitempty"`
+	// Client certificate validation options. Requires CertificateSecretName
+	// to be set. If unset, client certificates are not requested.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ClientValidation *ListenerClientValidation `json:"clientValidation,omitempty"`
 }
 
-// RateLimitOptions contains options for the ratelimit filter of the
-// http connection manager
-type RateLimitOptions struct {
-	// The rate limit domain
+// ClientCertificateMode defines whether client certificates are mandatory
+type ClientCertificateMode string
+
+const (
+	ClientCertificateModeRequired ClientCertificateMode = "Required"
+	ClientCertificateModeOptional ClientCertificateMode = "Optional"
+)
+
+// SubjectAltNameType is the type of a certificate subject alternative name
+type SubjectAltNameType string
+
+const (
+	SubjectAltNameTypeDNS   SubjectAltNameType = "DNS"
+	SubjectAltNameTypeURI   SubjectAltNameType = "URI"
+	SubjectAltNameTypeEmail SubjectAltNameType = "Email"
+	SubjectAltNameTypeIP    SubjectAltNameType = "IP"
+)
+
+// ListenerClientValidation contains options to validate the certificates
+// presented by the clients of a listener (mutual TLS)
+type ListenerClientValidation struct {
+	// The name of the Secret containing the CA certificate used to validate
+	// client certificates. It is delivered to envoy by marin3r using SDS.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	Domain string `json:"domain"`
-	// Whether to allow requests or not if the rate limit service
-	// is unavailable
+	CASecretName string `json:"caSecretName"`
+	// Whether clients must present a certificate (Required) or can connect
+	// without one (Optional). Certificates presented in Optional mode are
+	// still validated. Defaults to Required.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	// +kubebuilder:default:=false
+	// +kubebuilder:validation:Enum=Required;Optional
 	// +optional
-	FailureModeDeny *bool `json:"failureModeDeny,omitempty"`
-	// Max time to wait for a response from the rate limit service
+	Mode *ClientCertificateMode `json:"mode,omitempty"`
+	// If set, client certificates must have at least one subject
+	// alternative name matching one of the entries in the list
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	// +kubebuilder:validation:Format:=duration
-	Timeout metav1.Duration `json:"timeout"`
-	// Location of the rate limit service. Must point to one of the
-	// defined clusters.
+	// +optional
+	SubjectAltNames []SubjectAltNameMatch `json:"subjectAltNames,omitempty"`
+	// Forward the identity of the validated client certificate (subject,
+	// URI and DNS SANs) to the upstream in the x-forwarded-client-cert
+	// header. Any x-forwarded-client-cert header sent by the client is
+	// discarded. Defaults to true.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	RateLimitCluster string `json:"rateLimitCluster"`
+	// +kubebuilder:default:=true
+	// +optional
+	ForwardClientIdentity *bool `json:"forwardClientIdentity,omitempty"`
 }
 
-// Cluster contains options for an Envoy cluster protobuffer message
-type Cluster struct {
-	// The upstream host
+// SubjectAltNameMatch matches a subject alternative name of a certificate
+type SubjectAltNameMatch struct {
+	// The type of the subject alternative name
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	Host string `json:"host"`
-	// The upstream port
+	// +kubebuilder:validation:Enum=DNS;URI;Email;IP
+	Type SubjectAltNameType `json:"type"`
+	// The exact value that the subject alternative name must have
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	Port uint32 `json:"port"`
-	// Specifies if the upstream cluster is http2 or not (default).
+	Value string `json:"value"`
+}
+
+// AccessLogFormat is the format of the access log entries
+type AccessLogFormat string
+
+const (
+	AccessLogFormatJSON AccessLogFormat = "JSON"
+	AccessLogFormatText AccessLogFormat = "Text"
+)
+
+// AccessLogSinkType is the destination of the access log entries
+type AccessLogSinkType string
+
+const (
+	AccessLogSinkStdout AccessLogSinkType = "Stdout"
+	AccessLogSinkFile   AccessLogSinkType = "File"
+	AccessLogSinkGRPC   AccessLogSinkType = "GRPC"
+)
+
+// AccessLogOptions contains options to configure the access log
+// of a listener
+type AccessLogOptions struct {
+	// The format of the access log entries. Not used with the GRPC sink.
+	// Defaults to JSON.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	// +kubebuilder:default:=false
+	// +kubebuilder:validation:Enum=JSON;Text
 	// +optional
-	IsHttp2 *bool `json:"isHttp2"`
+	Format *AccessLogFormat `json:"format,omitempty"`
+	// Additional fields for the JSON format. The values are envoy command
+	// operators (eg "%REQ(X-REQUEST-ID)%"). They are merged over the default
+	// fields, and a field with an empty value removes the default field.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Fields map[string]string `json:"fields,omitempty"`
+	// The format string for the Text format. If unset, envoy's default
+	// format is used.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TextFormat *string `json:"textFormat,omitempty"`
+	// Where to send the access log entries. Defaults to the /dev/stdout file.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Sink *AccessLogSink `json:"sink,omitempty"`
+	// Rules to select which requests are logged. If unset, all requests
+	// are logged.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Filter *AccessLogFilter `json:"filter,omitempty"`
 }
 
-// RouteConfiguration contains options for an Envoy route_configuration
-// protobuffer message
-type RouteConfiguration struct {
-	// The virtual_hosts definitions for this route configuration.
-	// Virtual hosts must be specified using directly Envoy's API
+// AccessLogSink configures the destination of the access log entries
+type AccessLogSink struct {
+	// The type of the sink
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=Stdout;File;GRPC
+	Type AccessLogSinkType `json:"type"`
+	// The path of the file. Only used by the File sink. Defaults
+	// to /dev/stdout.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// The cluster of the gRPC access log service. Required by the
+	// GRPC sink. Must point to one of the defined clusters.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	VirtualHosts []runtime.RawExtension `json:"virtualHosts"`
+	// +optional
+	Cluster *string `json:"cluster,omitempty"`
 }
 
-// Runtime contains options for an Envoy runtime protobuffer message
-type Runtime struct {
-	// The list of listeners to apply overload protection limits to
+// AccessLogFilter selects the requests that are logged. StatusCodeMin
+// and MinDuration are combined with a logical OR, so a request is logged
+// if it matches any of them. ExcludeHealthChecks and SamplePercent are
+// applied on top of that.
+type AccessLogFilter struct {
+	// Log only requests with a response code greater or equal than this
+	// value (eg 500 to log only 5xx responses)
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	ListenerNames []string `json:"listenerNames"`
+	// +kubebuilder:validation:Minimum:=100
+	// +kubebuilder:validation:Maximum:=599
+	// +optional
+	StatusCodeMin *uint32 `json:"statusCodeMin,omitempty"`
+	// Log only requests that took longer than this duration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Format:=duration
+	// +optional
+	MinDuration *metav1.Duration `json:"minDuration,omitempty"`
+	// Do not log health check requests
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExcludeHealthChecks *bool `json:"excludeHealthChecks,omitempty"`
+	// Percentage of the requests to log
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=100
+	// +optional
+	SamplePercent *uint32 `json:"samplePercent,omitempty"`
+}
+
+// ListenerHttp3 contains options for an HTTP/3 listener. HTTP/3 runs over
+// QUIC, so the listener binds to an UDP port. It is usually deployed together
+// with a ListenerHttp in the same port number that advertises HTTP/3 to clients
+// using the alt-svc response header.
+type ListenerHttp3 struct {
+	// The UDP port where the listener listens for new connections
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Port uint32 `json:"port"`
+	// The name of the RouteConfiguration to use in the listener
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	RouteConfigName string `json:"routeConfigName"`
+	// The name of the Secret containing a valid certificate. QUIC
+	// always requires TLS.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	CertificateSecretName string `json:"certificateSecretName"`
+	// Rate limit options for the ratelimit filter of the HTTP connection
+	// manager
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RateLimitOptions *RateLimitOptions `json:"rateLimitOptions,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:default:=true
+	// +optional
+	// Allow headers with underscores
+	AllowHeadersWithUnderscores *bool `json:"allowHeadersWithUnderscores,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	// Max connection duration. If unset no max connection duration will be applied.
+	MaxConnectionDuration *metav1.Duration `json:"maxConnectionDuration,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxRequestHeadersKb *uint32 `json:"maxRequestHeadersKb,omitempty"`
+	// Ordered list of http filters to add to the HTTP connection manager.
+	// The filters are placed before the ratelimit and router filters, in the
+	// same order they are declared.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HttpFilters []HttpFilter `json:"httpFilters,omitempty"`
+	// Access log options. If unset, a JSON access log with a predefined
+	// set of fields is written to stdout.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AccessLog *AccessLogOptions `json:"accessLog,omitempty"`
+}
+
+// ListenerTcpTLSMode defines how a TCP listener handles TLS
+type ListenerTcpTLSMode string
+
+const (
+	// ListenerTcpTLSModeNone proxies connections as they are
+	ListenerTcpTLSModeNone ListenerTcpTLSMode = "None"
+	// ListenerTcpTLSModePassthrough proxies connections without terminating
+	// TLS, inspecting the SNI to route them
+	ListenerTcpTLSModePassthrough ListenerTcpTLSMode = "Passthrough"
+	// ListenerTcpTLSModeTerminate terminates TLS in the listener
+	ListenerTcpTLSModeTerminate ListenerTcpTLSMode = "Terminate"
+)
+
+// ListenerTcp contains options for a TCP proxy listener
+type ListenerTcp struct {
+	// The port where the listener listens for new connections
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Port uint32 `json:"port"`
+	// Whether proxy protocol should be enabled or not. Defaults to false.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:default:=false
+	// +optional
+	ProxyProtocol *bool `json:"proxyProtocol,omitempty"`
+	// How the listener handles TLS. Defaults to None.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=None;Passthrough;Terminate
+	// +optional
+	TLSMode *ListenerTcpTLSMode `json:"tlsMode,omitempty"`
+	// The name of the Secret containing a valid certificate. Required
+	// when tlsMode is Terminate.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CertificateSecretName *string `json:"certificateSecretName,omitempty"`
+	// The cluster where connections are forwarded when they do not
+	// match any of the SNI routes. Must point to one of the defined clusters.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Cluster *string `json:"cluster,omitempty"`
+	// Routes connections to clusters depending on the SNI sent by the client.
+	// Requires tlsMode to be Passthrough or Terminate.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SNIRoutes []ListenerTcpSNIRoute `json:"sniRoutes,omitempty"`
+	// The idle timeout for connections. Defaults to 1h.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Format:=duration
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+}
+
+// ListenerTcpSNIRoute routes the connections with matching SNI to a cluster
+type ListenerTcpSNIRoute struct {
+	// The server names to match. Wildcards like "*.example.com" are supported.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems:=1
+	ServerNames []string `json:"serverNames"`
+	// The cluster where matching connections are forwarded. Must point
+	// to one of the defined clusters.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Cluster string `json:"cluster"`
+}
+
+// HttpFilter holds the options for one of the supported http filters. One
+// and only one of the fields must be set.
+// +kubebuilder:validation:MinProperties:=1
+// +kubebuilder:validation:MaxProperties:=1
+type HttpFilter struct {
+	// Options for the local ratelimit filter
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LocalRateLimit *HttpFilterLocalRateLimit `json:"localRateLimit,omitempty"`
+	// Options for the external authorization filter
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExtAuthz *HttpFilterExtAuthz `json:"extAuthz,omitempty"`
+	// Options for the cors filter. The cors policies themselves are
+	// configured in the virtual hosts or routes.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Cors *HttpFilterCors `json:"cors,omitempty"`
+	// Options for the header mutation filter
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HeaderMutation *HttpFilterHeaderMutation `json:"headerMutation,omitempty"`
+	// Options for the lua filter
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Lua *HttpFilterLua `json:"lua,omitempty"`
+	// Options for the compressor filter
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Compressor *HttpFilterCompressor `json:"compressor,omitempty"`
+}
+
+// HttpFilterLocalRateLimit contains options for the local ratelimit filter,
+// which applies a token bucket ratelimit to all the requests processed by
+// the listener.
+type HttpFilterLocalRateLimit struct {
+	// The maximum tokens that the bucket can hold
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum:=1
+	MaxTokens uint32 `json:"maxTokens"`
+	// The number of tokens added to the bucket during each fill interval.
+	// Defaults to 1.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TokensPerFill *uint32 `json:"tokensPerFill,omitempty"`
+	// The fill interval that tokens are added to the bucket
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Format:=duration
+	FillInterval metav1.Duration `json:"fillInterval"`
+}
+
+// HttpFilterExtAuthzType is the type of the external authorization service
+type HttpFilterExtAuthzType string
+
+const (
+	HttpFilterExtAuthzTypeGRPC HttpFilterExtAuthzType = "GRPC"
+	HttpFilterExtAuthzTypeHTTP HttpFilterExtAuthzType = "HTTP"
+)
+
+// HttpFilterExtAuthz contains options for the external authorization filter
+type HttpFilterExtAuthz struct {
+	// The type of the authorization service, either GRPC or HTTP
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=GRPC;HTTP
+	Type HttpFilterExtAuthzType `json:"type"`
+	// Location of the authorization service. Must point to one of the
+	// defined clusters.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Cluster string `json:"cluster"`
+	// Max time to wait for a response from the authorization service.
+	// Defaults to 200ms.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Format:=duration
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Whether to allow requests or not if the authorization service
+	// is unavailable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:default:=false
+	// +optional
+	FailureModeAllow *bool `json:"failureModeAllow,omitempty"`
+	// Prefix added to the path of the authorization requests. Only
+	// used when type is HTTP.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PathPrefix *string `json:"pathPrefix,omitempty"`
+	// Client request headers sent to the authorization service. Only
+	// used when type is HTTP.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AllowedRequestHeaders []string `json:"allowedRequestHeaders,omitempty"`
+	// Authorization response headers added to the upstream request. Only
+	// used when type is HTTP.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AllowedUpstreamHeaders []string `json:"allowedUpstreamHeaders,omitempty"`
+}
+
+// HttpFilterCors enables the cors filter. It has no options as cors policies
+// are configured per virtual host or route.
+type HttpFilterCors struct{}
+
+// HttpFilterHeaderMutation contains options for the header mutation filter
+type HttpFilterHeaderMutation struct {
+	// Headers to add to the request. Existing values are overwritten.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RequestHeadersToAdd map[string]string `json:"requestHeadersToAdd,omitempty"`
+	// Headers to remove from the request
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RequestHeadersToRemove []string `json:"requestHeadersToRemove,omitempty"`
+	// Headers to add to the response. Existing values are overwritten.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ResponseHeadersToAdd map[string]string `json:"responseHeadersToAdd,omitempty"`
+	// Headers to remove from the response
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ResponseHeadersToRemove []string `json:"responseHeadersToRemove,omitempty"`
+}
+
+// HttpFilterLua contains options for the lua filter
+type HttpFilterLua struct {
+	// The lua code that envoy will execute
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	InlineCode string `json:"inlineCode"`
+}
+
+// HttpFilterCompressor contains options for the compressor filter. Responses
+// are compressed using gzip.
+type HttpFilterCompressor struct {
+	// Minimum response length, in bytes, which will trigger compression.
+	// Defaults to 30.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MinContentLength *uint32 `json:"minContentLength,omitempty"`
+	// Set of content types which will be compressed. If unset, envoy's
+	// default list of content types is used.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ContentTypes []string `json:"contentTypes,omitempty"`
+}
+
+// RateLimitOptions contains options for the ratelimit filter of the
+// http connection manager
+type RateLimitOptions struct {
+	// The rate limit domain
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Domain string `json:"domain"`
+	// Whether to allow requests or not if the rate limit service
+	// is unavailable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:default:=false
+	// +optional
+	FailureModeDeny *bool `json:"failureModeDeny,omitempty"`
+	// Max time to wait for a response from the rate limit service
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Format:=duration
+	Timeout metav1.Duration `json:"timeout"`
+	// Location of the rate limit service. Must point to one of the
+	// defined clusters.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	RateLimitCluster string `json:"rateLimitCluster"`
+}
+
+// Cluster contains options for an Envoy cluster protobuffer message
+type Cluster struct {
+	// The upstream host
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Host string `json:"host"`
+	// The upstream port
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Port uint32 `json:"port"`
+	// Specifies if the upstream cluster is http2 or not (default).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:default:=false
+	// +optional
+	IsHttp2 *bool `json:"isHttp2"`
+	// Timeout for new network connections to hosts in the cluster.
+	// Only used by generatorVersion v2. Defaults to 1s.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ConnectTimeout *metav1.Duration `json:"connectTimeout,omitempty"`
+	// The load balancer policy to use. Only used by generatorVersion v2.
+	// Defaults to RoundRobin.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=RoundRobin;LeastRequest;RingHash
+	// +optional
+	LbPolicy *ClusterLbPolicy `json:"lbPolicy,omitempty"`
+	// Active health checking of the upstream hosts.
+	// Only used by generatorVersion v2.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HealthCheck *ClusterHealthCheck `json:"healthCheck,omitempty"`
+	// Outlier detection (passive health checking) of the upstream hosts.
+	// Only used by generatorVersion v2.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	OutlierDetection *ClusterOutlierDetection `json:"outlierDetection,omitempty"`
+	// Circuit breaking limits for the cluster.
+	// Only used by generatorVersion v2.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CircuitBreakers *ClusterCircuitBreakers `json:"circuitBreakers,omitempty"`
+	// TLS configuration for connections to the upstream hosts. If unset,
+	// plain text connections are used. Only used by generatorVersion v2.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	UpstreamTLS *ClusterUpstreamTLS `json:"upstreamTLS,omitempty"`
+}
+
+type ClusterLbPolicy string
+
+const (
+	ClusterLbPolicyRoundRobin   ClusterLbPolicy = "RoundRobin"
+	ClusterLbPolicyLeastRequest ClusterLbPolicy = "LeastRequest"
+	ClusterLbPolicyRingHash     ClusterLbPolicy = "RingHash"
+)
+
+type ClusterHealthCheckType string
+
+const (
+	ClusterHealthCheckTypeHTTP ClusterHealthCheckType = "HTTP"
+	ClusterHealthCheckTypeTCP  ClusterHealthCheckType = "TCP"
+)
+
+// ClusterHealthCheck contains options for the active health checking
+// of the hosts of an Envoy cluster
+type ClusterHealthCheck struct {
+	// The type of health check to perform
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=HTTP;TCP
+	Type ClusterHealthCheckType `json:"type"`
+	// The HTTP path requested by HTTP health checks. Defaults to "/".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// The value of the host header in HTTP health checks. Defaults
+	// to the upstream host of the cluster.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Host *string `json:"host,omitempty"`
+	// The interval between health checks. Defaults to 5s.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// The time to wait for a health check response. Defaults to 1s.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// The number of healthy health checks required before a host
+	// is marked healthy. Defaults to 1.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HealthyThreshold *uint32 `json:"healthyThreshold,omitempty"`
+	// The number of unhealthy health checks required before a host
+	// is marked unhealthy. Defaults to 3.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	UnhealthyThreshold *uint32 `json:"unhealthyThreshold,omitempty"`
+}
+
+// ClusterOutlierDetection contains options for the outlier
+// detection of the hosts of an Envoy cluster
+type ClusterOutlierDetection struct {
+	// The number of consecutive 5xx responses before a host is ejected
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Consecutive5xx *uint32 `json:"consecutive5xx,omitempty"`
+	// The number of consecutive gateway failures (502, 503, 504) before
+	// a host is ejected
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ConsecutiveGatewayFailure *uint32 `json:"consecutiveGatewayFailure,omitempty"`
+	// The time interval between ejection analysis sweeps
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// The base time that a host is ejected for
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	BaseEjectionTime *metav1.Duration `json:"baseEjectionTime,omitempty"`
+	// The maximum % of hosts in the cluster that can be ejected
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxEjectionPercent *uint32 `json:"maxEjectionPercent,omitempty"`
+}
+
+// ClusterCircuitBreakers contains the circuit breaking
+// limits of an Envoy cluster
+type ClusterCircuitBreakers struct {
+	// The maximum number of connections to the cluster
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxConnections *uint32 `json:"maxConnections,omitempty"`
+	// The maximum number of pending requests to the cluster
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxPendingRequests *uint32 `json:"maxPendingRequests,omitempty"`
+	// The maximum number of parallel requests to the cluster
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxRequests *uint32 `json:"maxRequests,omitempty"`
+	// The maximum number of parallel retries to the cluster
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxRetries *uint32 `json:"maxRetries,omitempty"`
+}
+
+// ClusterUpstreamTLS contains the TLS options for connections
+// to the hosts of an Envoy cluster
+type ClusterUpstreamTLS struct {
+	// The SNI to use when connecting to the upstream hosts.
+	// Defaults to the cluster host.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SNI *string `json:"sni,omitempty"`
+	// The name of the Secret containing the CA certificate used to validate
+	// the upstream hosts certificates. If unset, upstream certificates are
+	// not validated.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CASecretName *string `json:"caSecretName,omitempty"`
+}
+
+// RouteConfiguration contains options for an Envoy route_configuration
+// protobuffer message
+type RouteConfiguration struct {
+	// The virtual_hosts definitions for this route configuration.
+	// Virtual hosts must be specified using directly Envoy's API. With
+	// generatorVersion v2 these are added after the typed virtual hosts.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VirtualHosts []runtime.RawExtension `json:"virtualHosts,omitempty"`
+	// Typed virtual hosts definitions for this route configuration.
+	// Only used by generatorVersion v2.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TypedVirtualHosts []VirtualHost `json:"typedVirtualHosts,omitempty"`
+}
+
+// VirtualHost contains options for an Envoy virtual host
+type VirtualHost struct {
+	// The name of the virtual host
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The domains (host/authority header) that match this virtual host.
+	// Wildcards like "*.example.com" or "*" are supported.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems:=1
+	Domains []string `json:"domains"`
+	// The list of routes, evaluated in order. The first one that
+	// matches is used.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Routes []Route `json:"routes"`
+}
+
+// Route contains options for an Envoy route. One and only one of
+// Cluster, WeightedClusters, Redirect or DirectResponse must be set.
+type Route struct {
+	// The conditions a request must match to use this route
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Match RouteMatch `json:"match"`
+	// Forward matching requests to this cluster
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Cluster *string `json:"cluster,omitempty"`
+	// Split matching requests between several clusters
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	WeightedClusters []WeightedCluster `json:"weightedClusters,omitempty"`
+	// Redirect matching requests
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Redirect *RouteRedirect `json:"redirect,omitempty"`
+	// Respond directly to matching requests
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DirectResponse *RouteDirectResponse `json:"directResponse,omitempty"`
+	// Upstream timeout for the request. Only used with Cluster or
+	// WeightedClusters. Defaults to envoy's default (15s).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Format:=duration
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Retry policy for the request. Only used with Cluster or
+	// WeightedClusters.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Retries *RouteRetryPolicy `json:"retries,omitempty"`
+	// Rate limit descriptors to send to the rate limit service. Only used
+	// with Cluster or WeightedClusters.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RateLimits []RouteRateLimit `json:"rateLimits,omitempty"`
+}
+
+// RouteMatch contains the conditions that a request must match. One and only
+// one of Prefix, Path or Regex must be set.
+type RouteMatch struct {
+	// Match requests whose path starts with this prefix
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+	// Match requests with exactly this path
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// Match requests whose path matches this RE2 regular expression
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Regex *string `json:"regex,omitempty"`
+	// Match requests with all these headers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Headers []HeaderMatch `json:"headers,omitempty"`
+}
+
+// HeaderMatch matches a request header. One and only one of Exact,
+// Prefix, Regex or Present must be set.
+type HeaderMatch struct {
+	// The name of the header
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// Match if the header has exactly this value
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Exact *string `json:"exact,omitempty"`
+	// Match if the header value starts with this prefix
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+	// Match if the header value matches this RE2 regular expression
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Regex *string `json:"regex,omitempty"`
+	// Match if the header is present (true) or absent (false)
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Present *bool `json:"present,omitempty"`
+	// Invert the result of the match
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Invert *bool `json:"invert,omitempty"`
+}
+
+// WeightedCluster is a cluster with the relative weight of the requests
+// it should receive
+type WeightedCluster struct {
+	// The name of the cluster
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The weight of the cluster
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Weight uint32 `json:"weight"`
+}
+
+// RouteRetryPolicy contains options for the retries of a route
+type RouteRetryPolicy struct {
+	// The conditions that trigger a retry, as a comma separated list
+	// (eg "5xx,connect-failure,reset")
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	RetryOn string `json:"retryOn"`
+	// The number of retries. Defaults to 1.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	NumRetries *uint32 `json:"numRetries,omitempty"`
+	// The timeout of each try
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Format:=duration
+	// +optional
+	PerTryTimeout *metav1.Duration `json:"perTryTimeout,omitempty"`
+}
+
+// RedirectResponseCode is the response code of a redirect
+type RedirectResponseCode string
+
+const (
+	RedirectResponseCodeMovedPermanently  RedirectResponseCode = "MovedPermanently"
+	RedirectResponseCodeFound             RedirectResponseCode = "Found"
+	RedirectResponseCodeSeeOther          RedirectResponseCode = "SeeOther"
+	RedirectResponseCodeTemporaryRedirect RedirectResponseCode = "TemporaryRedirect"
+	RedirectResponseCodePermanentRedirect RedirectResponseCode = "PermanentRedirect"
+)
+
+// RouteRedirect contains options to redirect requests
+type RouteRedirect struct {
+	// Replace the host of the url
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HostRedirect *string `json:"hostRedirect,omitempty"`
+	// Replace the path of the url
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PathRedirect *string `json:"pathRedirect,omitempty"`
+	// Replace the scheme of the url with https
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HttpsRedirect *bool `json:"httpsRedirect,omitempty"`
+	// The response code of the redirect. Defaults to MovedPermanently (301).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=MovedPermanently;Found;SeeOther;TemporaryRedirect;PermanentRedirect
+	// +optional
+	ResponseCode *RedirectResponseCode `json:"responseCode,omitempty"`
+}
+
+// RouteDirectResponse contains options to respond directly to requests
+type RouteDirectResponse struct {
+	// The response status code
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum:=200
+	// +kubebuilder:validation:Maximum:=599
+	Status uint32 `json:"status"`
+	// The response body
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Body *string `json:"body,omitempty"`
+}
+
+// RouteRateLimit is a rate limit configuration, which generates a
+// descriptor built from the list of actions
+type RouteRateLimit struct {
+	// The list of actions that compose the descriptor
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems:=1
+	Actions []RateLimitAction `json:"actions"`
+}
+
+// RateLimitAction is an entry of a rate limit descriptor. One and only one
+// of the fields must be set.
+// +kubebuilder:validation:MinProperties:=1
+// +kubebuilder:validation:MaxProperties:=1
+type RateLimitAction struct {
+	// Add an entry with the client's address
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RemoteAddress *RateLimitActionRemoteAddress `json:"remoteAddress,omitempty"`
+	// Add an entry with the value of a request header
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RequestHeader *RateLimitActionRequestHeader `json:"requestHeader,omitempty"`
+	// Add an entry with a fixed value
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GenericKey *RateLimitActionGenericKey `json:"genericKey,omitempty"`
+}
+
+// RateLimitActionRemoteAddress adds the client's address to the descriptor
+type RateLimitActionRemoteAddress struct{}
+
+// RateLimitActionRequestHeader adds the value of a request header to
+// the descriptor
+type RateLimitActionRequestHeader struct {
+	// The name of the header
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	HeaderName string `json:"headerName"`
+	// The key of the descriptor entry
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	DescriptorKey string `json:"descriptorKey"`
+}
+
+// RateLimitActionGenericKey adds a fixed entry to the descriptor
+type RateLimitActionGenericKey struct {
+	// The value of the descriptor entry
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	DescriptorValue string `json:"descriptorValue"`
+	// The key of the descriptor entry. Defaults to "generic_key".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DescriptorKey *string `json:"descriptorKey,omitempty"`
+}
+
+// Runtime contains options for an Envoy runtime protobuffer message
+type Runtime struct {
+	// The list of listeners to apply overload protection limits to
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ListenerNames []string `json:"listenerNames"`
+	// The maximum number of connections of each listener. If unset, it is
+	// derived from the memory limit of the sidecar, or 10000 if the sidecar
+	// has no memory limit.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ListenerConnectionLimit *uint32 `json:"listenerConnectionLimit,omitempty"`
+	// Per listener overrides of ListenerConnectionLimit. The keys must be
+	// listeners in ListenerNames.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ListenerConnectionLimits map[string]uint32 `json:"listenerConnectionLimits,omitempty"`
+	// The maximum number of connections across all listeners. If unset,
+	// it is derived from the memory limit of the sidecar, or 50000 if the
+	// sidecar has no memory limit.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GlobalDownstreamMaxConnections *uint32 `json:"globalDownstreamMaxConnections,omitempty"`
+	// Additional runtime keys, like feature flags or overload manager
+	// settings
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Keys []RuntimeKey `json:"keys,omitempty"`
+}
+
+// RuntimeKey is a typed envoy runtime key. One and only one of the
+// value fields must be set.
+type RuntimeKey struct {
+	// The name of the runtime key (eg "envoy.reloadable_features.some_flag")
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// A boolean value
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Bool *bool `json:"bool,omitempty"`
+	// An integer value
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Integer *int64 `json:"integer,omitempty"`
+	// A string value
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	String *string `json:"string,omitempty"`
+}
+
+const (
+	// envoyConnectionsPerMiB is used to size the connection limits
+	// from the memory limit of the envoy sidecar
+	envoyConnectionsPerMiB int64 = 64
+	// envoyListenerConnectionsRatio is the fraction of the global
+	// connection limit that a single listener can take
+	envoyListenerConnectionsRatio int64 = 5
+	// envoyMinGlobalDownstreamMaxConnections is the lower bound for
+	// the sized global connection limit
+	envoyMinGlobalDownstreamMaxConnections int64 = 1000
+)
+
+// Default sets the connection limits that have not been explicitly set,
+// sizing them from the resources of the envoy sidecar. If there is no
+// memory limit, the limits are left unset so the template defaults apply.
+func (rt *Runtime) Default(resources *ResourceRequirementsSpec) {
+	if resources == nil {
+		return
+	}
+
+	mem, ok := resources.Limits[corev1.ResourceMemory]
+	if !ok {
+		return
+	}
+
+	global := max(mem.Value()/(1024*1024)*envoyConnectionsPerMiB, envoyMinGlobalDownstreamMaxConnections)
+
+	if rt.GlobalDownstreamMaxConnections == nil {
+		rt.GlobalDownstreamMaxConnections = ptr.To(uint32(global))
+	}
+
+	if rt.ListenerConnectionLimit == nil {
+		rt.ListenerConnectionLimit = ptr.To(uint32(global / envoyListenerConnectionsRatio))
+	}
+}
+
+// validate checks that the per listener connection limits
+// are set for listeners in ListenerNames that exist in the sidecar
+func (rt *Runtime) validate(fldPath *field.Path, listeners []string) field.ErrorList {
+	errs := field.ErrorList{}
+
+	names := make([]string, 0, len(rt.ListenerConnectionLimits))
+	for name := range rt.ListenerConnectionLimits {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !slices.Contains(rt.ListenerNames, name) || !slices.Contains(listeners, name) {
+			errs = append(errs, field.NotFound(fldPath.Child("listenerConnectionLimits").Key(name), name))
+		}
+	}
+
+	return errs
 }
 
 // RawConfig is a struct with methods to manage a
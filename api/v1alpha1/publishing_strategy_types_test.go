@@ -7,6 +7,8 @@ import (
 	envoyconfig "github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/envoyconfig/descriptor"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 )
 
 func TestMarin3rSidecarSpec_Default(t *testing.T) {
@@ -266,3 +268,140 @@ func TestMapOfEnvoyDynamicConfig_AsList(t *testing.T) {
 		})
 	}
 }
+
+func TestRuntime_Default(t *testing.T) {
+	tests := []struct {
+		name      string
+		runtime   *Runtime
+		resources *ResourceRequirementsSpec
+		want      *Runtime
+	}{
+		{
+			name:      "Leaves limits unset if there are no resources",
+			runtime:   &Runtime{ListenerNames: []string{"test"}},
+			resources: nil,
+			want:      &Runtime{ListenerNames: []string{"test"}},
+		},
+		{
+			name:    "Leaves limits unset if there is no memory limit",
+			runtime: &Runtime{ListenerNames: []string{"test"}},
+			resources: &ResourceRequirementsSpec{
+				Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			},
+			want: &Runtime{ListenerNames: []string{"test"}},
+		},
+		{
+			name:    "Sizes limits from the memory limit",
+			runtime: &Runtime{ListenerNames: []string{"test"}},
+			resources: &ResourceRequirementsSpec{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+			},
+			want: &Runtime{
+				ListenerNames:                  []string{"test"},
+				ListenerConnectionLimit:        ptr.To(uint32(6553)),
+				GlobalDownstreamMaxConnections: ptr.To(uint32(32768)),
+			},
+		},
+		{
+			name:    "Applies a lower bound to small sidecars",
+			runtime: &Runtime{ListenerNames: []string{"test"}},
+			resources: &ResourceRequirementsSpec{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("10Mi")},
+			},
+			want: &Runtime{
+				ListenerNames:                  []string{"test"},
+				ListenerConnectionLimit:        ptr.To(uint32(200)),
+				GlobalDownstreamMaxConnections: ptr.To(uint32(1000)),
+			},
+		},
+		{
+			name: "Does not override explicitly set limits",
+			runtime: &Runtime{
+				ListenerNames:           []string{"test"},
+				ListenerConnectionLimit: ptr.To(uint32(100)),
+			},
+			resources: &ResourceRequirementsSpec{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+			},
+			want: &Runtime{
+				ListenerNames:                  []string{"test"},
+				ListenerConnectionLimit:        ptr.To(uint32(100)),
+				GlobalDownstreamMaxConnections: ptr.To(uint32(32768)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.runtime.Default(tt.resources)
+			if !reflect.DeepEqual(tt.runtime, tt.want) {
+				t.Errorf("Runtime.Default() = %v, want %v", tt.runtime, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarin3rSidecarSpec_validate(t *testing.T) {
+	tests := []struct {
+		name string
+		spec *Marin3rSidecarSpec
+		want field.ErrorList
+	}{
+		{
+			name: "Nil spec is valid",
+			spec: nil,
+			want: field.ErrorList{},
+		},
+		{
+			name: "Per listener limits for existing listeners are valid",
+			spec: &Marin3rSidecarSpec{
+				EnvoyDynamicConfig: MapOfEnvoyDynamicConfig{
+					"http": {ListenerHttp: &ListenerHttp{}},
+					"raw":  {RawConfig: &RawConfig{Type: "listener"}},
+					"limits": {Runtime: &Runtime{
+						ListenerNames:            []string{"http", "raw"},
+						ListenerConnectionLimits: map[string]uint32{"http": 10, "raw": 20},
+					}},
+				},
+			},
+			want: field.ErrorList{},
+		},
+		{
+			name: "Per listener limits for unknown listeners are invalid",
+			spec: &Marin3rSidecarSpec{
+				EnvoyDynamicConfig: MapOfEnvoyDynamicConfig{
+					"http":    {ListenerHttp: &ListenerHttp{}},
+					"cluster": {Cluster: &Cluster{}},
+					"limits": {Runtime: &Runtime{
+						ListenerNames:            []string{"http", "cluster"},
+						ListenerConnectionLimits: map[string]uint32{"typo": 10, "http": 10, "cluster": 10},
+					}},
+				},
+			},
+			want: field.ErrorList{
+				field.NotFound(field.NewPath("marin3r", "dynamicConfigs").Key("limits").Child("runtime", "listenerConnectionLimits").Key("cluster"), "cluster"),
+				field.NotFound(field.NewPath("marin3r", "dynamicConfigs").Key("limits").Child("runtime", "listenerConnectionLimits").Key("typo"), "typo"),
+			},
+		},
+		{
+			name: "Per listener limits for listeners not in the runtime are invalid",
+			spec: &Marin3rSidecarSpec{
+				EnvoyDynamicConfig: MapOfEnvoyDynamicConfig{
+					"http": {ListenerHttp: &ListenerHttp{}},
+					"limits": {Runtime: &Runtime{
+						ListenerConnectionLimits: map[string]uint32{"http": 10},
+					}},
+				},
+			},
+			want: field.ErrorList{
+				field.NotFound(field.NewPath("marin3r", "dynamicConfigs").Key("limits").Child("runtime", "listenerConnectionLimits").Key("http"), "http"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.spec.validate(field.NewPath("marin3r")); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Marin3rSidecarSpec.validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
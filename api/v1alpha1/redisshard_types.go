@@ -17,13 +17,19 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/3scale-sre/basereconciler/reconciler"
 	"github.com/3scale-sre/basereconciler/util"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
 
@@ -33,9 +39,13 @@ var (
 		Tag:        ptr.To("4.0.11-alpine"),
 		PullPolicy: (*corev1.PullPolicy)(ptr.To(string(corev1.PullIfNotPresent))),
 	}
-	redisShardDefaultMasterIndex int32  = 0
-	redisShardDefaultCommand     string = "redis-server /redis/redis.conf"
-	RedisShardDefaultReplicas    int32  = 3
+	redisShardDefaultMasterIndex int32                          = 0
+	redisShardDefaultCommand     string                         = "redis-server /redis/redis.conf"
+	RedisShardDefaultReplicas    int32                          = 3
+	redisShardDefaultPDB         defaultPodDisruptionBudgetSpec = defaultPodDisruptionBudgetSpec{
+		MaxUnavailable: ptr.To(intstr.FromInt(1)),
+	}
+	redisShardDefaultResources defaultResourceRequirementsSpec = defaultResourceRequirementsSpec{}
 )
 
 // RedisShardSpec defines the desired state of RedisShard
@@ -57,6 +67,43 @@ type RedisShardSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Command *string `json:"command,omitempty"`
+	// StorageClass is the storage class to be used for the redis
+	// data volume. Only used if StorageSize is set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	StorageClass *string `json:"storageClass,omitempty"`
+	// StorageSize is the storage size to provision for the redis data
+	// volume of each redis server. If unset, an ephemeral emptyDir volume
+	// is used instead. Note that the StatefulSet volume claim templates are
+	// immutable, so this cannot be changed once the shard has been created.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	StorageSize *resource.Quantity `json:"storageSize,omitempty"`
+	// Config is a map of redis.conf directives that are added to the
+	// redis servers configuration file. The 'slaveof' and 'replicaof' directives
+	// are managed by the operator and cannot be set. Changes are only picked up
+	// by the redis servers when their Pods are restarted.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+	// Pod Disruption Budget for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PDB *PodDisruptionBudgetSpec `json:"pdb,omitempty"`
+	// Resource requirements for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Describes node affinity scheduling rules for the pod.
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
+	// If specified, the pod's tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 }
 
 // Default implements defaulting for RedisShardSpec
@@ -65,6 +112,9 @@ func (spec *RedisShardSpec) Default() {
 	spec.MasterIndex = intOrDefault(spec.MasterIndex, &redisShardDefaultMasterIndex)
 	spec.SlaveCount = intOrDefault(spec.SlaveCount, ptr.To(RedisShardDefaultReplicas-1))
 	spec.Command = stringOrDefault(spec.Command, &redisShardDefaultCommand)
+	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, redisShardDefaultPDB)
+	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, redisShardDefaultResources)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 }
 
 type RedisShardNodes struct {
@@ -134,9 +184,31 @@ type RedisShardStatus struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=status
 	// +optional
 	ShardNodes *RedisShardNodes `json:"shardNodes,omitempty"`
+	// Replication reports the status of the replication link
+	// of each slave in the redis shard, keyed by the slave alias
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Replication map[string]RedisShardReplicationStatus `json:"replication,omitempty"`
+}
+
+// RedisShardReplicationStatus describes the replication link of a slave
+type RedisShardReplicationStatus struct {
+	// Master is the address of the master the slave replicates from
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Master string `json:"master,omitempty"`
+	// LinkStatus is the status of the replication link with the master
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	LinkStatus string `json:"linkStatus,omitempty"`
+	// SyncInProgress is true while the slave is performing a full sync
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	SyncInProgress bool `json:"syncInProgress,omitempty"`
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 
 // RedisShard is the Schema for the redisshards API
@@ -155,6 +227,27 @@ func (rs *RedisShard) Default() {
 	rs.Spec.Default()
 }
 
+// Validate checks the RedisShard for errors that the CRD schema can't detect
+func (rs *RedisShard) Validate() error {
+	fldPath := field.NewPath("spec")
+	errs := rs.Spec.PDB.validate(fldPath.Child("pdb"))
+
+	if rs.Spec.MasterIndex != nil && rs.Spec.SlaveCount != nil &&
+		(*rs.Spec.MasterIndex < 0 || *rs.Spec.MasterIndex > *rs.Spec.SlaveCount) {
+		errs = append(errs, field.Invalid(fldPath.Child("masterIndex"), *rs.Spec.MasterIndex,
+			fmt.Sprintf("must be between 0 and slaveCount (%d)", *rs.Spec.SlaveCount)))
+	}
+
+	for _, directive := range slices.Sorted(maps.Keys(rs.Spec.Config)) {
+		if d := strings.ToLower(directive); d == "slaveof" || d == "replicaof" {
+			errs = append(errs, field.Forbidden(fldPath.Child("config").Key(directive),
+				"replication is managed by the operator"))
+		}
+	}
+
+	return invalid("RedisShard", rs.GetName(), errs)
+}
+
 var _ reconciler.ObjectWithAppStatus = &RedisShard{}
 
 func (d *RedisShard) GetStatus() any {
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"context"
+	"slices"
 	"sort"
 	"time"
 
@@ -28,7 +29,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
 
@@ -87,6 +90,17 @@ type SentinelConfig struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	ClusterTopology map[string]map[string]string `json:"clusterTopology"`
+	// RedisShardRefs is a list of names of RedisShard resources, in the same
+	// namespace, that sentinel should monitor. The redis servers of each shard are
+	// obtained from the RedisShard status and kept up to date automatically.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RedisShardRefs []string `json:"redisShardRefs,omitempty"`
+	// RedisShardSelector selects the RedisShard resources, in the same namespace,
+	// that sentinel should monitor, in addition to the ones in RedisShardRefs.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RedisShardSelector *metav1.LabelSelector `json:"redisShardSelector,omitempty"`
 	// StorageClass is the storage class to be used for
 	// the persistent sentinel config file where the shards
 	// state is stored
@@ -106,6 +120,25 @@ type SentinelConfig struct {
 	MetricsRefreshInterval *time.Duration `json:"metricsRefreshInterval,omitempty"`
 }
 
+// SelectsRedisShard returns true if the passed RedisShard is
+// referenced or selected by the SentinelConfig
+func (cfg *SentinelConfig) SelectsRedisShard(rs *RedisShard) bool {
+	if slices.Contains(cfg.RedisShardRefs, rs.GetName()) {
+		return true
+	}
+
+	if cfg.RedisShardSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(cfg.RedisShardSelector)
+		if err != nil {
+			return false
+		}
+
+		return selector.Matches(labels.Set(rs.GetLabels()))
+	}
+
+	return false
+}
+
 // Default sets default values for any value not specifically set in the AutoSSLConfig struct
 func (cfg *SentinelConfig) Default() {
 	if cfg.StorageSize == nil {
@@ -154,6 +187,10 @@ type SentinelSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 	// Config configures the sentinel process
 	Config *SentinelConfig `json:"config"`
 }
@@ -164,6 +201,7 @@ func (spec *SentinelSpec) Default() {
 	spec.Replicas = intOrDefault(spec.Replicas, &SentinelDefaultReplicas)
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, sentinelDefaultPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, sentinelDefaultResources)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, sentinelDefaultProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, sentinelDefaultProbe)
 	spec.GrafanaDashboard = InitializeGrafanaDashboardSpec(spec.GrafanaDashboard, sentinelDefaultGrafanaDashboard)
@@ -259,6 +297,7 @@ type RedisServerDetails struct {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:JSONPath=".status.sentinels",name=Sentinels,type=string
 // +kubebuilder:printcolumn:JSONPath=".status.monitoredShards",name=Shards,type=string
@@ -276,6 +315,21 @@ func (s *Sentinel) Default() {
 	s.Spec.Default()
 }
 
+// Validate checks the Sentinel for errors that the CRD schema can't detect
+func (s *Sentinel) Validate() error {
+	fldPath := field.NewPath("spec")
+	errs := s.Spec.PDB.validate(fldPath.Child("pdb"))
+
+	if s.Spec.Config != nil && s.Spec.Config.RedisShardSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(s.Spec.Config.RedisShardSelector); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("config", "redisShardSelector"),
+				s.Spec.Config.RedisShardSelector, err.Error()))
+		}
+	}
+
+	return invalid("Sentinel", s.GetName(), errs)
+}
+
 var _ reconciler.ObjectWithAppStatus = &Sentinel{}
 
 func (d *Sentinel) GetStatus() any {
@@ -22,9 +22,11 @@ import (
 	"sort"
 	"time"
 
+	"github.com/robfig/cron/v3"
 	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
 
@@ -274,6 +276,7 @@ const (
 )
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 
 // ShardedRedisBackup is the Schema for the shardedredisbackups API
@@ -290,6 +293,18 @@ func (srb *ShardedRedisBackup) Default() {
 	srb.Spec.Default()
 }
 
+// Validate checks the ShardedRedisBackup for errors that the CRD schema can't detect
+func (srb *ShardedRedisBackup) Validate() error {
+	fldPath := field.NewPath("spec")
+	errs := field.ErrorList{}
+
+	if _, err := cron.ParseStandard(srb.Spec.Schedule); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("schedule"), srb.Spec.Schedule, err.Error()))
+	}
+
+	return invalid("ShardedRedisBackup", srb.GetName(), errs)
+}
+
 // +kubebuilder:object:root=true
 
 // ShardedRedisBackupList contains a list of ShardedRedisBackup
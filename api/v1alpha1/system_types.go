@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -745,6 +746,11 @@ type SystemAppSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -759,6 +765,10 @@ type SystemAppSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 	// Configures the TerminationGracePeriodSeconds
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -781,6 +791,8 @@ func (spec *SystemAppSpec) Default() {
 	spec.Replicas = intOrDefault(spec.Replicas, &systemDefaultAppReplicas)
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, systemDefaultAppPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, systemDefaultAppResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, DefaultVerticalPodAutoscalerSpec)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, systemDefaultAppLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, systemDefaultAppReadinessProbe)
 	spec.TerminationGracePeriodSeconds = int64OrDefault(
@@ -789,6 +801,20 @@ func (spec *SystemAppSpec) Default() {
 	spec.PublishingStrategies = InitializePublishingStrategies(spec.PublishingStrategies)
 }
 
+func (spec *SystemAppSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if spec == nil {
+		return errs
+	}
+
+	errs = append(errs, spec.PDB.validate(fldPath.Child("pdb"))...)
+	errs = append(errs, spec.HPA.validate(fldPath.Child("hpa"))...)
+	errs = append(errs, spec.VPA.validate(fldPath.Child("vpa"), spec.HPA, nil)...)
+
+	return append(errs, spec.PublishingStrategies.validate(fldPath.Child("publishingStrategies"))...)
+}
+
 // SystemSidekiqSpec configures the Sidekiq component of System
 type SystemSidekiqSpec struct {
 	// The deployment strategy to use to replace existing pods with new ones.
@@ -807,6 +833,11 @@ type SystemSidekiqSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// KEDA ScaledObject for the component. When set, it replaces the
+	// Horizontal Pod Autoscaler to scale on external events like queue depth.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	KEDA *KEDAScaledObjectSpec `json:"keda,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -815,6 +846,11 @@ type SystemSidekiqSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -829,6 +865,10 @@ type SystemSidekiqSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 	// Canary defines spec changes for the canary Deployment. If
 	// left unset the canary Deployment wil not be created.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -870,9 +910,15 @@ func (cfg *SidekiqConfig) Default(def defaultSidekiqConfig) {
 func (spec *SystemSidekiqSpec) Default(sidekiqType systemSidekiqType) {
 	spec.DeploymentStrategy = InitializeDeploymentStrategySpec(spec.DeploymentStrategy, systemDefaultSidekiqDeploymentStrategy)
 	spec.HPA = InitializeHorizontalPodAutoscalerSpec(spec.HPA, systemDefaultSidekiqHPA)
+	spec.KEDA = InitializeKEDAScaledObjectSpec(spec.KEDA, defaultKEDAScaledObjectSpec{
+		MinReplicas: systemDefaultSidekiqHPA.MinReplicas,
+		MaxReplicas: systemDefaultSidekiqHPA.MaxReplicas,
+	})
 	spec.Replicas = intOrDefault(spec.Replicas, &systemDefaultSidekiqReplicas)
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, systemDefaultSidekiqPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, systemDefaultSidekiqResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, DefaultVerticalPodAutoscalerSpec)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, systemDefaultSidekiqLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, systemDefaultSidekiqReadinessProbe)
 	spec.TerminationGracePeriodSeconds = int64OrDefault(
@@ -892,6 +938,20 @@ func (spec *SystemSidekiqSpec) Default(sidekiqType systemSidekiqType) {
 	}
 }
 
+func (spec *SystemSidekiqSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if spec == nil {
+		return errs
+	}
+
+	errs = append(errs, spec.PDB.validate(fldPath.Child("pdb"))...)
+	errs = append(errs, spec.HPA.validate(fldPath.Child("hpa"))...)
+	errs = append(errs, spec.VPA.validate(fldPath.Child("vpa"), spec.HPA, spec.KEDA)...)
+
+	return append(errs, spec.KEDA.validate(fldPath.Child("keda"))...)
+}
+
 // SystemSearchdSpec configures the App component of System
 type SystemSearchdSpec struct {
 	// Deploy searchd instance
@@ -925,6 +985,10 @@ type SystemSearchdSpec struct {
 	// If specified, the Searchd pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 	// Configures the TerminationGracePeriodSeconds for Searchd
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -936,6 +1000,7 @@ func (spec *SystemSearchdSpec) Default() {
 	spec.Enabled = boolOrDefault(spec.Enabled, ptr.To(systemDefaultSearchdEnabled))
 	spec.Image = InitializeImageSpec(spec.Image, systemDefaultSearchdImage)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, systemDefaultSearchdResources)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, systemDefaultSearchdLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, systemDefaultSearchdReadinessProbe)
 
@@ -1002,12 +1067,17 @@ type SystemRailsConsoleSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 }
 
 // Default implements defaulting for the system App component
 func (spec *SystemRailsConsoleSpec) Default(systemDefaultImage *ImageSpec) {
 	spec.Image = InitializeImageSpec(spec.Image, defaultImageSpec(*systemDefaultImage))
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, systemDefaultRailsConsoleResources)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 }
 
 // SystemStatus defines the observed state of System
@@ -1016,6 +1086,7 @@ type SystemStatus struct {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 
 // System is the Schema for the systems API
@@ -1032,6 +1103,38 @@ func (s *System) Default() {
 	s.Spec.Default()
 }
 
+// Validate checks the System for errors that the CRD schema can't detect
+func (s *System) Validate() error {
+	// canary patches are applied to the defaulted spec
+	defaulted := s.Spec.DeepCopy()
+	defaulted.Default()
+
+	fldPath := field.NewPath("spec")
+	errs := field.ErrorList{}
+	errs = append(errs, s.Spec.App.validate(fldPath.Child("app"))...)
+
+	if s.Spec.App != nil {
+		errs = append(errs, validateCanary(fldPath.Child("app", "canary"), s.Spec.App.Canary, defaulted.ResolveCanarySpec)...)
+	}
+
+	for _, sidekiq := range []struct {
+		name string
+		spec *SystemSidekiqSpec
+	}{
+		{"sidekiqDefault", s.Spec.SidekiqDefault},
+		{"sidekiqBilling", s.Spec.SidekiqBilling},
+		{"sidekiqLow", s.Spec.SidekiqLow},
+	} {
+		errs = append(errs, sidekiq.spec.validate(fldPath.Child(sidekiq.name))...)
+
+		if sidekiq.spec != nil {
+			errs = append(errs, validateCanary(fldPath.Child(sidekiq.name, "canary"), sidekiq.spec.Canary, defaulted.ResolveCanarySpec)...)
+		}
+	}
+
+	return invalid("System", s.GetName(), errs)
+}
+
 var _ reconciler.ObjectWithAppStatus = &System{}
 
 func (d *System) GetStatus() any {
@@ -1,8 +1,10 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"time"
 
+	"github.com/3scale-sre/basereconciler/util"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -62,10 +64,67 @@ type TwemproxySpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Options *TwemproxyOptions `json:"options,omitempty"`
+	// ServerPoolOverrides allows to override, for this workload only, the options
+	// of the server pools defined in the referenced TwemproxyConfig. When set, a copy
+	// of the referenced TwemproxyConfig with the overrides applied is created and used
+	// instead. Note that changes to the referenced TwemproxyConfig are only propagated
+	// to the copy when the workload's custom resource is reconciled.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ServerPoolOverrides []TwemproxyServerPoolOverride `json:"serverPoolOverrides,omitempty"`
+}
+
+// TwemproxyServerPoolOverride overrides the options of one of
+// the server pools of a TwemproxyConfig
+type TwemproxyServerPoolOverride struct {
+	// The name of the server pool to override
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The options to override
+	TwemproxyServerPoolOptions `json:",inline"`
 }
 
+// ConfigMapName returns the name of the ConfigMap that holds the
+// twemproxy configuration for the sidecar
 func (spec *TwemproxySpec) ConfigMapName() string {
-	return spec.TwemproxyConfigRef
+	return spec.TwemproxyConfigName()
+}
+
+// TwemproxyConfigName returns the name of the TwemproxyConfig that the sidecar
+// uses. This is the referenced TwemproxyConfig unless there are server pool
+// overrides, in which case a suffix derived from the overrides is added to the
+// name so workloads with the same overrides share the same TwemproxyConfig.
+func (spec *TwemproxySpec) TwemproxyConfigName() string {
+	if len(spec.ServerPoolOverrides) == 0 {
+		return spec.TwemproxyConfigRef
+	}
+
+	// hash the json representation as util.Hash is
+	// not stable for structs with pointer fields
+	b, err := json.Marshal(spec.ServerPoolOverrides)
+	if err != nil {
+		panic(err)
+	}
+
+	return spec.TwemproxyConfigRef + "-" + util.Hash(string(b))
+}
+
+// ApplyServerPoolOverrides returns a copy of the passed TwemproxyConfigSpec
+// with the server pool overrides applied
+func (spec *TwemproxySpec) ApplyServerPoolOverrides(base TwemproxyConfigSpec) TwemproxyConfigSpec {
+	derived := base.DeepCopy()
+
+	for _, override := range spec.ServerPoolOverrides {
+		for idx := range derived.ServerPools {
+			if derived.ServerPools[idx].Name == override.Name {
+				derived.ServerPools[idx].Merge(override.TwemproxyServerPoolOptions)
+			}
+		}
+	}
+
+	return *derived
 }
 
 // Default implements defaulting for the each backend cron
@@ -0,0 +1,91 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+	"k8s.io/utils/ptr"
+)
+
+func TestTwemproxySpec_TwemproxyConfigName(t *testing.T) {
+	overrides := func() []TwemproxyServerPoolOverride {
+		return []TwemproxyServerPoolOverride{
+			{Name: "pool", TwemproxyServerPoolOptions: TwemproxyServerPoolOptions{AutoEjectHosts: ptr.To(true)}},
+		}
+	}
+
+	t.Run("Returns the referenced TwemproxyConfig if there are no overrides", func(t *testing.T) {
+		spec := TwemproxySpec{TwemproxyConfigRef: "config"}
+		if got := spec.TwemproxyConfigName(); got != "config" {
+			t.Errorf("TwemproxySpec.TwemproxyConfigName() = %v, want %v", got, "config")
+		}
+	})
+
+	t.Run("Returns the same derived name for the same overrides", func(t *testing.T) {
+		spec1 := TwemproxySpec{TwemproxyConfigRef: "config", ServerPoolOverrides: overrides()}
+		spec2 := TwemproxySpec{TwemproxyConfigRef: "config", ServerPoolOverrides: overrides()}
+
+		if got := spec1.TwemproxyConfigName(); got == "config" || got != spec2.TwemproxyConfigName() {
+			t.Errorf("TwemproxySpec.TwemproxyConfigName() = %v, want %v", got, spec2.TwemproxyConfigName())
+		}
+	})
+}
+
+func TestTwemproxySpec_ApplyServerPoolOverrides(t *testing.T) {
+	base := TwemproxyConfigSpec{
+		ServerPools: []TwemproxyServerPool{{
+			Name:        "pool",
+			BindAddress: "0.0.0.0:22121",
+			TwemproxyServerPoolOptions: TwemproxyServerPoolOptions{
+				Hash:         ptr.To("fnv1a_64"),
+				Distribution: ptr.To("ketama"),
+			},
+		}},
+	}
+	spec := TwemproxySpec{
+		TwemproxyConfigRef: "config",
+		ServerPoolOverrides: []TwemproxyServerPoolOverride{
+			{Name: "pool", TwemproxyServerPoolOptions: TwemproxyServerPoolOptions{
+				Distribution: ptr.To("modula"), ServerFailureLimit: ptr.To[int32](5)},
+			},
+			{Name: "other", TwemproxyServerPoolOptions: TwemproxyServerPoolOptions{Hash: ptr.To("md5")}},
+		},
+	}
+	want := TwemproxyConfigSpec{
+		ServerPools: []TwemproxyServerPool{{
+			Name:        "pool",
+			BindAddress: "0.0.0.0:22121",
+			TwemproxyServerPoolOptions: TwemproxyServerPoolOptions{
+				Hash:               ptr.To("fnv1a_64"),
+				Distribution:       ptr.To("modula"),
+				ServerFailureLimit: ptr.To[int32](5),
+			},
+		}},
+	}
+
+	got := spec.ApplyServerPoolOverrides(base)
+	if diff := deep.Equal(got, want); len(diff) > 0 {
+		t.Errorf("TwemproxySpec.ApplyServerPoolOverrides() got diff %v", diff)
+	}
+
+	// the base spec must not be modified
+	if *base.ServerPools[0].Distribution != "ketama" {
+		t.Errorf("TwemproxySpec.ApplyServerPoolOverrides() modified the base spec")
+	}
+}
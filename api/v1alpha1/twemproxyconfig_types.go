@@ -17,8 +17,14 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"net"
+	"time"
+
 	"github.com/3scale-sre/basereconciler/util"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -31,6 +37,12 @@ var (
 		SelectorKey:   ptr.To("monitoring-key"),
 		SelectorValue: ptr.To("middleware"),
 	}
+	twemproxyConfigDefaultSentinelEventsDebounce metav1.Duration = metav1.Duration{Duration: 500 * time.Millisecond}
+	twemproxyConfigDefaultResyncPeriod           metav1.Duration = metav1.Duration{Duration: 5 * time.Minute}
+	twemproxyServerPoolDefaultHash               string          = "fnv1a_64"
+	twemproxyServerPoolDefaultHashTag            string          = "{}"
+	twemproxyServerPoolDefaultDistribution       string          = "ketama"
+	twemproxyServerPoolDefaultAutoEjectHosts     bool            = false
 )
 
 // TwemproxyConfigSpec defines the desired state of TwemproxyConfig
@@ -55,6 +67,19 @@ type TwemproxyConfigSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// SentinelEventsDebounce is the time the controller waits since the last
+	// relevant sentinel event (ie a '+switch-master') was received before
+	// triggering a reconcile. This avoids several consecutive reconciles when
+	// sentinel events are received in bursts. Defaults to 500ms.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SentinelEventsDebounce *metav1.Duration `json:"sentinelEventsDebounce,omitempty"`
+	// ResyncPeriod is the interval at which a full resync of the configuration is
+	// performed. Changes in the topology are detected through sentinel events,
+	// so this is just a fallback in case some event is lost. Defaults to 5m.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ResyncPeriod *metav1.Duration `json:"resyncPeriod,omitempty"`
 }
 
 func (spec *TwemproxyConfigSpec) Default() {
@@ -67,6 +92,20 @@ func (spec *TwemproxyConfigSpec) Default() {
 	}
 
 	spec.GrafanaDashboard = InitializeGrafanaDashboardSpec(spec.GrafanaDashboard, twemproxyDefaultGrafanaDashboard)
+	spec.SentinelEventsDebounce = durationOrDefault(spec.SentinelEventsDebounce, &twemproxyConfigDefaultSentinelEventsDebounce)
+	spec.ResyncPeriod = durationOrDefault(spec.ResyncPeriod, &twemproxyConfigDefaultResyncPeriod)
+}
+
+// TargetsSlavesRW returns true if any of the server pools
+// targets read-write slaves
+func (spec *TwemproxyConfigSpec) TargetsSlavesRW() bool {
+	for _, pool := range spec.ServerPools {
+		if pool.Target != nil && *pool.Target == SlavesRW {
+			return true
+		}
+	}
+
+	return false
 }
 
 type TwemproxyServerPool struct {
@@ -102,6 +141,8 @@ type TwemproxyServerPool struct {
 	// +kubebuilder:validation:Enum=masters;slaves-rw
 	// +optional
 	Target *TargetRedisServers `json:"target,omitempty"`
+	// Additional nutcracker options for the server pool
+	TwemproxyServerPoolOptions `json:",inline"`
 }
 
 func (pool *TwemproxyServerPool) Default() {
@@ -109,6 +150,106 @@ func (pool *TwemproxyServerPool) Default() {
 		t := Masters
 		pool.Target = &t
 	}
+
+	pool.TwemproxyServerPoolOptions.Default()
+}
+
+// TwemproxyServerPoolOptions are the nutcracker options of a server pool
+// that can be tuned by the user. See https://github.com/twitter/twemproxy#configuration
+// for a detailed description of each option.
+type TwemproxyServerPoolOptions struct {
+	// The name of the hash function. Defaults to "fnv1a_64".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=one_at_a_time;md5;crc16;crc32;crc32a;fnv1_64;fnv1a_64;fnv1_32;fnv1a_32;hsieh;murmur;jenkins
+	// +optional
+	Hash *string `json:"hash,omitempty"`
+	// A two character string that specifies the part of the key
+	// used for hashing. Defaults to "{}".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=2
+	// +optional
+	HashTag *string `json:"hashTag,omitempty"`
+	// The key distribution mode. Defaults to "ketama".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=ketama;modula;random
+	// +optional
+	Distribution *string `json:"distribution,omitempty"`
+	// Whether servers should be temporarily ejected when they fail
+	// consecutively ServerFailureLimit times. Defaults to false.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AutoEjectHosts *bool `json:"autoEjectHosts,omitempty"`
+	// The timeout in milliseconds to wait for before retrying on a temporarily
+	// ejected server, when AutoEjectHosts is set to true. Nutcracker defaults
+	// to 30000 if unset.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ServerRetryTimeout *int32 `json:"serverRetryTimeout,omitempty"`
+	// The number of consecutive failures on a server that would lead to it being
+	// temporarily ejected when AutoEjectHosts is set to true. Nutcracker defaults
+	// to 2 if unset.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ServerFailureLimit *int32 `json:"serverFailureLimit,omitempty"`
+	// Reference to a Secret key holding the password used to authenticate to
+	// the redis servers. Note that the password is written in plain text to the
+	// generated twemproxy ConfigMap, as nutcracker requires it in its config file.
+	// Changes to the Secret are propagated to the ConfigMap.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RedisAuth *corev1.SecretKeySelector `json:"redisAuth,omitempty"`
+	// The redis database number to use in the redis servers. Nutcracker
+	// defaults to 0 if unset.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RedisDB *int32 `json:"redisDB,omitempty"`
+}
+
+func (opts *TwemproxyServerPoolOptions) Default() {
+	opts.Hash = stringOrDefault(opts.Hash, ptr.To(twemproxyServerPoolDefaultHash))
+	opts.HashTag = stringOrDefault(opts.HashTag, ptr.To(twemproxyServerPoolDefaultHashTag))
+	opts.Distribution = stringOrDefault(opts.Distribution, ptr.To(twemproxyServerPoolDefaultDistribution))
+	opts.AutoEjectHosts = boolOrDefault(opts.AutoEjectHosts, ptr.To(twemproxyServerPoolDefaultAutoEjectHosts))
+}
+
+// Merge overwrites the options with the ones explicitly set in the passed
+// TwemproxyServerPoolOptions
+func (opts *TwemproxyServerPoolOptions) Merge(override TwemproxyServerPoolOptions) {
+	if override.Hash != nil {
+		opts.Hash = override.Hash
+	}
+
+	if override.HashTag != nil {
+		opts.HashTag = override.HashTag
+	}
+
+	if override.Distribution != nil {
+		opts.Distribution = override.Distribution
+	}
+
+	if override.AutoEjectHosts != nil {
+		opts.AutoEjectHosts = override.AutoEjectHosts
+	}
+
+	if override.ServerRetryTimeout != nil {
+		opts.ServerRetryTimeout = override.ServerRetryTimeout
+	}
+
+	if override.ServerFailureLimit != nil {
+		opts.ServerFailureLimit = override.ServerFailureLimit
+	}
+
+	if override.RedisAuth != nil {
+		opts.RedisAuth = override.RedisAuth
+	}
+
+	if override.RedisDB != nil {
+		opts.RedisDB = override.RedisDB
+	}
 }
 
 type TargetRedisServers string
@@ -144,6 +285,7 @@ type TargetServer struct {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:JSONPath=`.status.targets`,name=Selected Targets,type=string
 // TwemproxyConfig is the Schema for the twemproxyconfigs API
@@ -159,6 +301,55 @@ func (tc *TwemproxyConfig) Default() {
 	tc.Spec.Default()
 }
 
+// Validate checks the TwemproxyConfig for errors that the CRD schema can't detect
+func (tc *TwemproxyConfig) Validate() error {
+	fldPath := field.NewPath("spec", "serverPools")
+	errs := field.ErrorList{}
+	names := map[string]bool{}
+
+	for idx, pool := range tc.Spec.ServerPools {
+		if names[pool.Name] {
+			errs = append(errs, field.Duplicate(fldPath.Index(idx).Child("name"), pool.Name))
+		}
+
+		names[pool.Name] = true
+
+		if _, _, err := net.SplitHostPort(pool.BindAddress); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Index(idx).Child("bindAddress"), pool.BindAddress, err.Error()))
+
+			continue
+		}
+
+		for prev := range idx {
+			if bindAddressesOverlap(tc.Spec.ServerPools[prev].BindAddress, pool.BindAddress) {
+				errs = append(errs, field.Invalid(fldPath.Index(idx).Child("bindAddress"), pool.BindAddress,
+					fmt.Sprintf("overlaps with the bind address of server pool '%s'", tc.Spec.ServerPools[prev].Name)))
+			}
+		}
+	}
+
+	return invalid("TwemproxyConfig", tc.GetName(), errs)
+}
+
+// bindAddressesOverlap returns true if both addresses use the same port and
+// either the same host or a wildcard host that listens on all interfaces
+func bindAddressesOverlap(a, b string) bool {
+	hostA, portA, errA := net.SplitHostPort(a)
+	hostB, portB, errB := net.SplitHostPort(b)
+
+	if errA != nil || errB != nil || portA != portB {
+		return false
+	}
+
+	wildcard := func(host string) bool {
+		ip := net.ParseIP(host)
+
+		return host == "" || (ip != nil && ip.IsUnspecified())
+	}
+
+	return hostA == hostB || wildcard(hostA) || wildcard(hostB)
+}
+
 func (tc *TwemproxyConfig) PodSyncSelector() client.MatchingLabels {
 	return client.MatchingLabels{
 		TwemproxyPodSyncLabelKey: util.ObjectKey(tc).Name,
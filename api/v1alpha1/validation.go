@@ -0,0 +1,132 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+// invalid returns an Invalid error for the given kind and name, or
+// nil if the list of errors is empty
+func invalid(kind, name string, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(GroupVersion.WithKind(kind).GroupKind(), name, errs)
+}
+
+// validate checks that the budget does not set both minAvailable
+// and maxUnavailable, as they are mutually exclusive
+func (spec *PodDisruptionBudgetSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if spec != nil && spec.MinAvailable != nil && spec.MaxUnavailable != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("maxUnavailable"), spec.MaxUnavailable.String(),
+			"minAvailable and maxUnavailable are mutually exclusive"))
+	}
+
+	return errs
+}
+
+// validate checks that minReplicas is not greater than maxReplicas
+func (spec *HorizontalPodAutoscalerSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if spec != nil && spec.MinReplicas != nil && spec.MaxReplicas != nil && *spec.MinReplicas > *spec.MaxReplicas {
+		errs = append(errs, field.Invalid(fldPath.Child("minReplicas"), *spec.MinReplicas,
+			"must be less than or equal to maxReplicas"))
+	}
+
+	return errs
+}
+
+// validate checks that the VerticalPodAutoscaler does not apply recommendations for the
+// resources the HorizontalPodAutoscaler scales on, as both would react to the same signal.
+// The HorizontalPodAutoscaler is not used when the component is autoscaled by KEDA.
+func (spec *VerticalPodAutoscalerSpec) validate(fldPath *field.Path,
+	hpa *HorizontalPodAutoscalerSpec, keda *KEDAScaledObjectSpec) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if spec == nil || ptr.Deref(spec.UpdateMode, VPAUpdateModeOff) == VPAUpdateModeOff ||
+		hpa == nil || hpa.IsDeactivated() || keda != nil {
+		return errs
+	}
+
+	controlled := spec.ControlledResources
+	if len(controlled) == 0 {
+		controlled = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+	}
+
+	for _, name := range hpa.resourceNames() {
+		if slices.Contains(controlled, name) {
+			errs = append(errs, field.Invalid(fldPath.Child("updateMode"), *spec.UpdateMode,
+				fmt.Sprintf("must be Off while the hpa scales on %s, unless it is excluded from controlledResources", name)))
+		}
+	}
+
+	return errs
+}
+
+// ValidateAutoscalers returns an error if the VerticalPodAutoscaler applies recommendations for
+// the resources the HorizontalPodAutoscaler scales on. It is checked by the reconcilers so the
+// autoscalers do not conflict when the admission webhooks are disabled.
+func (spec *VerticalPodAutoscalerSpec) ValidateAutoscalers(hpa *HorizontalPodAutoscalerSpec, keda *KEDAScaledObjectSpec) error {
+	return spec.validate(field.NewPath("vpa"), hpa, keda).ToAggregate()
+}
+
+// validate checks that minReplicas is not greater than maxReplicas and
+// that each trigger configures exactly one scaler
+func (spec *KEDAScaledObjectSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if spec == nil {
+		return errs
+	}
+
+	if spec.MinReplicas != nil && spec.MaxReplicas != nil && *spec.MinReplicas > *spec.MaxReplicas {
+		errs = append(errs, field.Invalid(fldPath.Child("minReplicas"), *spec.MinReplicas,
+			"must be less than or equal to maxReplicas"))
+	}
+
+	for idx, trigger := range spec.Triggers {
+		if (trigger.RedisListLength == nil) == (trigger.Postgres == nil) {
+			errs = append(errs, field.Invalid(fldPath.Child("triggers").Index(idx), ptr.Deref(trigger.Name, ""),
+				"exactly one of redisListLength or postgres must be set"))
+		}
+	}
+
+	return errs
+}
+
+// validateCanary checks that the canary patches are valid RFC 6902 JSON patches
+// and that they can be applied to the spec using the given resolve function
+func validateCanary[T any](fldPath *field.Path, canary *Canary, resolve func(*Canary) (T, error)) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if canary == nil {
+		return errs
+	}
+
+	for idx, p := range canary.Patches {
+		if _, err := jsonpatch.DecodePatch([]byte(p)); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("patches").Index(idx), p,
+				fmt.Sprintf("unable to decode patch: %s", err.Error())))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	if _, err := resolve(canary); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("patches"), canary.Patches, err.Error()))
+	}
+
+	return errs
+}
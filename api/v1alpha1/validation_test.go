@@ -0,0 +1,175 @@
+package v1alpha1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+// invalidFields returns the list of fields reported by an Invalid error
+func invalidFields(t *testing.T, err error) []string {
+	if err == nil {
+		return nil
+	}
+
+	var status *apierrors.StatusError
+	if !errors.As(err, &status) || !apierrors.IsInvalid(err) {
+		t.Fatalf("expected an Invalid error but got '%v'", err)
+	}
+
+	fields := []string{}
+	for _, cause := range status.ErrStatus.Details.Causes {
+		fields = append(fields, cause.Field)
+	}
+
+	return fields
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  interface{ Validate() error }
+		want []string
+	}{
+		{
+			name: "Valid Backend",
+			obj: &Backend{Spec: BackendSpec{
+				Listener: ListenerSpec{
+					Canary: &Canary{Patches: []string{`[{"op":"replace","path":"/listener/replicas","value":1}]`}},
+				},
+			}},
+			want: nil,
+		},
+		{
+			name: "Backend with a canary patch that can't be decoded",
+			obj: &Backend{Spec: BackendSpec{
+				Listener: ListenerSpec{Canary: &Canary{Patches: []string{`{"op":"replace"}`}}},
+			}},
+			want: []string{"spec.listener.canary.patches[0]"},
+		},
+		{
+			name: "Backend with a canary patch that can't be applied",
+			obj: &Backend{Spec: BackendSpec{
+				Worker: &WorkerSpec{Canary: &Canary{Patches: []string{`[{"op":"replace","path":"/unknown/field","value":1}]`}}},
+			}},
+			want: []string{"spec.worker.canary.patches"},
+		},
+		{
+			name: "Backend with an invalid PDB, HPA and KEDA ScaledObject",
+			obj: &Backend{Spec: BackendSpec{
+				Listener: ListenerSpec{
+					PDB: &PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromInt(1)), MaxUnavailable: ptr.To(intstr.FromInt(1))},
+					HPA: &HorizontalPodAutoscalerSpec{MinReplicas: ptr.To[int32](3), MaxReplicas: ptr.To[int32](2)},
+				},
+				Worker: &WorkerSpec{
+					KEDA: &KEDAScaledObjectSpec{Triggers: []KEDATriggerSpec{{Name: ptr.To("none")}}},
+				},
+			}},
+			want: []string{"spec.listener.pdb.maxUnavailable", "spec.listener.hpa.minReplicas", "spec.worker.keda.triggers[0]"},
+		},
+		{
+			name: "Backend with VPAs that apply recommendations for the resources the HPA scales on",
+			obj: &Backend{Spec: BackendSpec{
+				Listener: ListenerSpec{
+					HPA: &HorizontalPodAutoscalerSpec{ResourceName: ptr.To("cpu")},
+					VPA: &VerticalPodAutoscalerSpec{UpdateMode: ptr.To(VPAUpdateModeAuto)},
+				},
+				Worker: &WorkerSpec{
+					HPA: &HorizontalPodAutoscalerSpec{Metrics: []autoscalingv2.MetricSpec{{
+						Type:     autoscalingv2.ResourceMetricSourceType,
+						Resource: &autoscalingv2.ResourceMetricSource{Name: corev1.ResourceMemory},
+					}}},
+					VPA: &VerticalPodAutoscalerSpec{UpdateMode: ptr.To(VPAUpdateModeInitial)},
+				},
+			}},
+			want: []string{"spec.listener.vpa.updateMode", "spec.worker.vpa.updateMode"},
+		},
+		{
+			name: "Backend with VPAs that do not overlap with the HPA",
+			obj: &Backend{Spec: BackendSpec{
+				Listener: ListenerSpec{
+					HPA: &HorizontalPodAutoscalerSpec{ResourceName: ptr.To("cpu")},
+					VPA: &VerticalPodAutoscalerSpec{UpdateMode: ptr.To(VPAUpdateModeAuto), ControlledResources: []corev1.ResourceName{corev1.ResourceMemory}},
+				},
+				Worker: &WorkerSpec{
+					HPA:  &HorizontalPodAutoscalerSpec{ResourceName: ptr.To("cpu")},
+					KEDA: &KEDAScaledObjectSpec{Triggers: []KEDATriggerSpec{{RedisListLength: &KEDARedisListLengthTrigger{}}}},
+					VPA:  &VerticalPodAutoscalerSpec{UpdateMode: ptr.To(VPAUpdateModeAuto)},
+				},
+			}},
+			want: nil,
+		},
+		{
+			name: "System with invalid sidekiq canary patches",
+			obj: &System{Spec: SystemSpec{
+				SidekiqLow: &SystemSidekiqSpec{Canary: &Canary{Patches: []string{`not json`}}},
+			}},
+			want: []string{"spec.sidekiqLow.canary.patches[0]"},
+		},
+		{
+			name: "RedisShard with an out of range master index and replication directives",
+			obj: &RedisShard{Spec: RedisShardSpec{
+				MasterIndex: ptr.To[int32](3),
+				SlaveCount:  ptr.To[int32](2),
+				Config:      map[string]string{"maxmemory": "1gb", "SLAVEOF": "10.0.0.1 6379"},
+			}},
+			want: []string{"spec.masterIndex", "spec.config[SLAVEOF]"},
+		},
+		{
+			name: "Sentinel with an invalid RedisShard selector",
+			obj: &Sentinel{Spec: SentinelSpec{
+				Config: &SentinelConfig{RedisShardSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "shard", Operator: "Unknown"}},
+				}},
+			}},
+			want: []string{"spec.config.redisShardSelector"},
+		},
+		{
+			name: "ShardedRedisBackup with a malformed schedule",
+			obj:  &ShardedRedisBackup{Spec: ShardedRedisBackupSpec{Schedule: "every day"}},
+			want: []string{"spec.schedule"},
+		},
+		{
+			name: "Valid ShardedRedisBackup",
+			obj:  &ShardedRedisBackup{Spec: ShardedRedisBackupSpec{Schedule: "0 * * * *"}},
+			want: nil,
+		},
+		{
+			name: "TwemproxyConfig with overlapping bind addresses",
+			obj: &TwemproxyConfig{Spec: TwemproxyConfigSpec{ServerPools: []TwemproxyServerPool{
+				{Name: "shards", BindAddress: "0.0.0.0:22121"},
+				{Name: "other", BindAddress: "127.0.0.1:22121"},
+				{Name: "shards", BindAddress: "127.0.0.1:22122"},
+				{Name: "malformed", BindAddress: "22123"},
+			}}},
+			want: []string{
+				"spec.serverPools[1].bindAddress",
+				"spec.serverPools[2].name",
+				"spec.serverPools[3].bindAddress",
+			},
+		},
+		{
+			name: "Valid TwemproxyConfig",
+			obj: &TwemproxyConfig{Spec: TwemproxyConfigSpec{ServerPools: []TwemproxyServerPool{
+				{Name: "shards", BindAddress: "0.0.0.0:22121"},
+				{Name: "other", BindAddress: "0.0.0.0:22122"},
+			}}},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := invalidFields(t, tt.obj.Validate())
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("Validate() got diff %v", diff)
+			}
+		})
+	}
+}
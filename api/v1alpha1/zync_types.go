@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
 
@@ -190,6 +191,11 @@ type APISpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -204,6 +210,10 @@ type APISpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 	// Describes how the services provided by this workload are exposed to its consumers
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -216,10 +226,26 @@ func (spec *APISpec) Default() {
 	spec.Replicas = intOrDefault(spec.Replicas, &zyncDefaultAPIReplicas)
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, zyncDefaultAPIPDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, zyncDefaultAPIResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, DefaultVerticalPodAutoscalerSpec)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, zyncDefaultAPILivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, zyncDefaultAPIReadinessProbe)
 }
 
+func (spec *APISpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if spec == nil {
+		return errs
+	}
+
+	errs = append(errs, spec.PDB.validate(fldPath.Child("pdb"))...)
+	errs = append(errs, spec.HPA.validate(fldPath.Child("hpa"))...)
+	errs = append(errs, spec.VPA.validate(fldPath.Child("vpa"), spec.HPA, nil)...)
+
+	return append(errs, spec.PublishingStrategies.validate(fldPath.Child("publishingStrategies"))...)
+}
+
 // QueSpec is the configuration for Zync que
 type QueSpec struct {
 	// Pod Disruption Budget for the component
@@ -230,6 +256,11 @@ type QueSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// KEDA ScaledObject for the component. When set, it replaces the
+	// Horizontal Pod Autoscaler to scale on external events like queue depth.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	KEDA *KEDAScaledObjectSpec `json:"keda,omitempty"`
 	// Number of replicas (ignored if hpa is enabled) for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -238,6 +269,11 @@ type QueSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
 	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
 	// Liveness probe for the component
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	// +optional
@@ -252,18 +288,42 @@ type QueSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 }
 
 // Default implements defaulting for the each zync que
 func (spec *QueSpec) Default() {
 	spec.HPA = InitializeHorizontalPodAutoscalerSpec(spec.HPA, zyncDefaultQueHPA)
+	spec.KEDA = InitializeKEDAScaledObjectSpec(spec.KEDA, defaultKEDAScaledObjectSpec{
+		MinReplicas: zyncDefaultQueHPA.MinReplicas,
+		MaxReplicas: zyncDefaultQueHPA.MaxReplicas,
+	})
 	spec.Replicas = intOrDefault(spec.Replicas, &zyncDefaultQueReplicas)
 	spec.PDB = InitializePodDisruptionBudgetSpec(spec.PDB, zyncDefaultQuePDB)
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, zyncDefaultQueResources)
+	spec.VPA = InitializeVerticalPodAutoscalerSpec(spec.VPA, DefaultVerticalPodAutoscalerSpec)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 	spec.LivenessProbe = InitializeProbeSpec(spec.LivenessProbe, zyncDefaultQueLivenessProbe)
 	spec.ReadinessProbe = InitializeProbeSpec(spec.ReadinessProbe, zyncDefaultQueReadinessProbe)
 }
 
+func (spec *QueSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if spec == nil {
+		return errs
+	}
+
+	errs = append(errs, spec.PDB.validate(fldPath.Child("pdb"))...)
+	errs = append(errs, spec.HPA.validate(fldPath.Child("hpa"))...)
+	errs = append(errs, spec.VPA.validate(fldPath.Child("vpa"), spec.HPA, spec.KEDA)...)
+
+	return append(errs, spec.KEDA.validate(fldPath.Child("keda"))...)
+}
+
 // ZyncConfig configures app behavior for Zync
 type ZyncConfig struct {
 	// Rails configuration options for zync components
@@ -348,6 +408,10 @@ type ZyncRailsConsoleSpec struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 }
 
 // Default implements defaulting for the Zync console component
@@ -355,6 +419,7 @@ func (spec *ZyncRailsConsoleSpec) Default(zyncDefaultImage *ImageSpec) {
 	spec.Enabled = boolOrDefault(spec.Enabled, ptr.To(zyncDefaultRailsConsoleEnabled))
 	spec.Image = InitializeImageSpec(spec.Image, defaultImageSpec(*zyncDefaultImage))
 	spec.Resources = InitializeResourceRequirementsSpec(spec.Resources, zyncDefaultRailsConsoleResources)
+	spec.Scheduling = InitializeSchedulingSpec(spec.Scheduling, DefaultSchedulingSpec)
 }
 
 // ZyncStatus defines the observed state of Zync
@@ -363,6 +428,7 @@ type ZyncStatus struct {
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 
 // Zync is the Schema for the zyncs API
@@ -379,6 +445,16 @@ func (z *Zync) Default() {
 	z.Spec.Default()
 }
 
+// Validate checks the Zync for errors that the CRD schema can't detect
+func (z *Zync) Validate() error {
+	fldPath := field.NewPath("spec")
+	errs := field.ErrorList{}
+	errs = append(errs, z.Spec.API.validate(fldPath.Child("api"))...)
+	errs = append(errs, z.Spec.Que.validate(fldPath.Child("que"))...)
+
+	return invalid("Zync", z.GetName(), errs)
+}
+
 var _ reconciler.ObjectWithAppStatus = &Zync{}
 
 func (d *Zync) GetStatus() any {
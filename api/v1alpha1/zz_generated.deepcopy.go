@@ -53,6 +53,11 @@ func (in *APISpec) DeepCopyInto(out *APISpec) {
 		*out = new(ResourceRequirementsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(ProbeSpec)
@@ -75,6 +80,11 @@ func (in *APISpec) DeepCopyInto(out *APISpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PublishingStrategies != nil {
 		in, out := &in.PublishingStrategies, &out.PublishingStrategies
 		*out = new(PublishingStrategies)
@@ -92,6 +102,303 @@ func (in *APISpec) DeepCopy() *APISpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSLoadBalancerAccessLogsSpec) DeepCopyInto(out *AWSLoadBalancerAccessLogsSpec) {
+	*out = *in
+	if in.S3Prefix != nil {
+		in, out := &in.S3Prefix, &out.S3Prefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLoadBalancerAccessLogsSpec.
+func (in *AWSLoadBalancerAccessLogsSpec) DeepCopy() *AWSLoadBalancerAccessLogsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSLoadBalancerAccessLogsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSLoadBalancerHealthCheckSpec) DeepCopyInto(out *AWSLoadBalancerHealthCheckSpec) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.HealthyThreshold != nil {
+		in, out := &in.HealthyThreshold, &out.HealthyThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnhealthyThreshold != nil {
+		in, out := &in.UnhealthyThreshold, &out.UnhealthyThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SuccessCodes != nil {
+		in, out := &in.SuccessCodes, &out.SuccessCodes
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLoadBalancerHealthCheckSpec.
+func (in *AWSLoadBalancerHealthCheckSpec) DeepCopy() *AWSLoadBalancerHealthCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSLoadBalancerHealthCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSLoadBalancerSpec) DeepCopyInto(out *AWSLoadBalancerSpec) {
+	*out = *in
+	if in.TargetType != nil {
+		in, out := &in.TargetType, &out.TargetType
+		*out = new(AWSLoadBalancerTargetType)
+		**out = **in
+	}
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(AWSLoadBalancerScheme)
+		**out = **in
+	}
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CrossZoneLoadBalancingEnabled != nil {
+		in, out := &in.CrossZoneLoadBalancingEnabled, &out.CrossZoneLoadBalancingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LoadBalancerName != nil {
+		in, out := &in.LoadBalancerName, &out.LoadBalancerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EIPAllocations != nil {
+		in, out := &in.EIPAllocations, &out.EIPAllocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManageBackendSecurityGroupRules != nil {
+		in, out := &in.ManageBackendSecurityGroupRules, &out.ManageBackendSecurityGroupRules
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(AWSLoadBalancerTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessLogs != nil {
+		in, out := &in.AccessLogs, &out.AccessLogs
+		*out = new(AWSLoadBalancerAccessLogsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(AWSLoadBalancerHealthCheckSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeregistrationDelayTimeout != nil {
+		in, out := &in.DeregistrationDelayTimeout, &out.DeregistrationDelayTimeout
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PreserveClientIP != nil {
+		in, out := &in.PreserveClientIP, &out.PreserveClientIP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtraTargetGroupAttributes != nil {
+		in, out := &in.ExtraTargetGroupAttributes, &out.ExtraTargetGroupAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLoadBalancerSpec.
+func (in *AWSLoadBalancerSpec) DeepCopy() *AWSLoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSLoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSLoadBalancerTLSSpec) DeepCopyInto(out *AWSLoadBalancerTLSSpec) {
+	*out = *in
+	if in.CertificateARNs != nil {
+		in, out := &in.CertificateARNs, &out.CertificateARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SSLPolicy != nil {
+		in, out := &in.SSLPolicy, &out.SSLPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLoadBalancerTLSSpec.
+func (in *AWSLoadBalancerTLSSpec) DeepCopy() *AWSLoadBalancerTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSLoadBalancerTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogFilter) DeepCopyInto(out *AccessLogFilter) {
+	*out = *in
+	if in.StatusCodeMin != nil {
+		in, out := &in.StatusCodeMin, &out.StatusCodeMin
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinDuration != nil {
+		in, out := &in.MinDuration, &out.MinDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ExcludeHealthChecks != nil {
+		in, out := &in.ExcludeHealthChecks, &out.ExcludeHealthChecks
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SamplePercent != nil {
+		in, out := &in.SamplePercent, &out.SamplePercent
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogFilter.
+func (in *AccessLogFilter) DeepCopy() *AccessLogFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogOptions) DeepCopyInto(out *AccessLogOptions) {
+	*out = *in
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(AccessLogFormat)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TextFormat != nil {
+		in, out := &in.TextFormat, &out.TextFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.Sink != nil {
+		in, out := &in.Sink, &out.Sink
+		*out = new(AccessLogSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(AccessLogFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogOptions.
+func (in *AccessLogOptions) DeepCopy() *AccessLogOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogSink) DeepCopyInto(out *AccessLogSink) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogSink.
+func (in *AccessLogSink) DeepCopy() *AccessLogSink {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddressSpec) DeepCopyInto(out *AddressSpec) {
 	*out = *in
@@ -136,6 +443,57 @@ func (in *AggregatedStatus) DeepCopyInto(out *AggregatedStatus) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.Canaries != nil {
+		in, out := &in.Canaries, &out.Canaries
+		*out = make(map[string]*CanaryStatus, len(*in))
+		for key, val := range *in {
+			var outVal *CanaryStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(CanaryStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.CanaryAnalyses != nil {
+		in, out := &in.CanaryAnalyses, &out.CanaryAnalyses
+		*out = make(map[string]*CanaryAnalysisStatus, len(*in))
+		for key, val := range *in {
+			var outVal *CanaryAnalysisStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(CanaryAnalysisStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]EndpointStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PendingDeletions != nil {
+		in, out := &in.PendingDeletions, &out.PendingDeletions
+		*out = make([]PendingDeletionStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AggregatedStatus.
@@ -228,6 +586,11 @@ func (in *ApicastEnvironmentSpec) DeepCopyInto(out *ApicastEnvironmentSpec) {
 		*out = new(ResourceRequirementsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(ProbeSpec)
@@ -251,6 +614,11 @@ func (in *ApicastEnvironmentSpec) DeepCopyInto(out *ApicastEnvironmentSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Canary != nil {
 		in, out := &in.Canary, &out.Canary
 		*out = new(Canary)
@@ -512,6 +880,11 @@ func (in *AutoSSLSpec) DeepCopyInto(out *AutoSSLSpec) {
 		*out = new(ResourceRequirementsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(ProbeSpec)
@@ -540,6 +913,11 @@ func (in *AutoSSLSpec) DeepCopyInto(out *AutoSSLSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Canary != nil {
 		in, out := &in.Canary, &out.Canary
 		*out = new(Canary)
@@ -935,6 +1313,11 @@ func (in *CORSProxySpec) DeepCopyInto(out *CORSProxySpec) {
 		*out = new(ResourceRequirementsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(ProbeSpec)
@@ -963,6 +1346,11 @@ func (in *CORSProxySpec) DeepCopyInto(out *CORSProxySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PublishingStrategies != nil {
 		in, out := &in.PublishingStrategies, &out.PublishingStrategies
 		*out = new(PublishingStrategies)
@@ -1019,9 +1407,29 @@ func (in *Canary) DeepCopyInto(out *Canary) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Canary.
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]CanaryStep, len(*in))
+		copy(*out, *in)
+	}
+	if in.ErrorRateThreshold != nil {
+		in, out := &in.ErrorRateThreshold, &out.ErrorRateThreshold
+		*out = new(CanaryErrorRateThreshold)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(CanaryAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Canary.
 func (in *Canary) DeepCopy() *Canary {
 	if in == nil {
 		return nil
@@ -1031,6 +1439,128 @@ func (in *Canary) DeepCopy() *Canary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryAnalysis) DeepCopyInto(out *CanaryAnalysis) {
+	*out = *in
+	out.Interval = in.Interval
+	if in.MinSuccessRatePercent != nil {
+		in, out := &in.MinSuccessRatePercent, &out.MinSuccessRatePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxLatencyMilliseconds != nil {
+		in, out := &in.MaxLatencyMilliseconds, &out.MaxLatencyMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailedChecksLimit != nil {
+		in, out := &in.FailedChecksLimit, &out.FailedChecksLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NoDataChecksLimit != nil {
+		in, out := &in.NoDataChecksLimit, &out.NoDataChecksLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SuccessRateQuery != nil {
+		in, out := &in.SuccessRateQuery, &out.SuccessRateQuery
+		*out = new(string)
+		**out = **in
+	}
+	if in.LatencyQuery != nil {
+		in, out := &in.LatencyQuery, &out.LatencyQuery
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryAnalysis.
+func (in *CanaryAnalysis) DeepCopy() *CanaryAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryAnalysisStatus) DeepCopyInto(out *CanaryAnalysisStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.DecisionTime != nil {
+		in, out := &in.DecisionTime, &out.DecisionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryAnalysisStatus.
+func (in *CanaryAnalysisStatus) DeepCopy() *CanaryAnalysisStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryAnalysisStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryErrorRateThreshold) DeepCopyInto(out *CanaryErrorRateThreshold) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryErrorRateThreshold.
+func (in *CanaryErrorRateThreshold) DeepCopy() *CanaryErrorRateThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryErrorRateThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
+	*out = *in
+	in.StepStartTime.DeepCopyInto(&out.StepStartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatus.
+func (in *CanaryStatus) DeepCopy() *CanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStep.
+func (in *CanaryStep) DeepCopy() *CanaryStep {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
@@ -1039,6 +1569,36 @@ func (in *Cluster) DeepCopyInto(out *Cluster) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ConnectTimeout != nil {
+		in, out := &in.ConnectTimeout, &out.ConnectTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LbPolicy != nil {
+		in, out := &in.LbPolicy, &out.LbPolicy
+		*out = new(ClusterLbPolicy)
+		**out = **in
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(ClusterHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutlierDetection != nil {
+		in, out := &in.OutlierDetection, &out.OutlierDetection
+		*out = new(ClusterOutlierDetection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircuitBreakers != nil {
+		in, out := &in.CircuitBreakers, &out.CircuitBreakers
+		*out = new(ClusterCircuitBreakers)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpstreamTLS != nil {
+		in, out := &in.UpstreamTLS, &out.UpstreamTLS
+		*out = new(ClusterUpstreamTLS)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cluster.
@@ -1051,6 +1611,194 @@ func (in *Cluster) DeepCopy() *Cluster {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCircuitBreakers) DeepCopyInto(out *ClusterCircuitBreakers) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxPendingRequests != nil {
+		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRequests != nil {
+		in, out := &in.MaxRequests, &out.MaxRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCircuitBreakers.
+func (in *ClusterCircuitBreakers) DeepCopy() *ClusterCircuitBreakers {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCircuitBreakers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHealthCheck) DeepCopyInto(out *ClusterHealthCheck) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.HealthyThreshold != nil {
+		in, out := &in.HealthyThreshold, &out.HealthyThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.UnhealthyThreshold != nil {
+		in, out := &in.UnhealthyThreshold, &out.UnhealthyThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHealthCheck.
+func (in *ClusterHealthCheck) DeepCopy() *ClusterHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterOutlierDetection) DeepCopyInto(out *ClusterOutlierDetection) {
+	*out = *in
+	if in.Consecutive5xx != nil {
+		in, out := &in.Consecutive5xx, &out.Consecutive5xx
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ConsecutiveGatewayFailure != nil {
+		in, out := &in.ConsecutiveGatewayFailure, &out.ConsecutiveGatewayFailure
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BaseEjectionTime != nil {
+		in, out := &in.BaseEjectionTime, &out.BaseEjectionTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxEjectionPercent != nil {
+		in, out := &in.MaxEjectionPercent, &out.MaxEjectionPercent
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterOutlierDetection.
+func (in *ClusterOutlierDetection) DeepCopy() *ClusterOutlierDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterOutlierDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUpstreamTLS) DeepCopyInto(out *ClusterUpstreamTLS) {
+	*out = *in
+	if in.SNI != nil {
+		in, out := &in.SNI, &out.SNI
+		*out = new(string)
+		**out = **in
+	}
+	if in.CASecretName != nil {
+		in, out := &in.CASecretName, &out.CASecretName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpstreamTLS.
+func (in *ClusterUpstreamTLS) DeepCopy() *ClusterUpstreamTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUpstreamTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerResourceRecommendation) DeepCopyInto(out *ContainerResourceRecommendation) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.LowerBound != nil {
+		in, out := &in.LowerBound, &out.LowerBound
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.UpperBound != nil {
+		in, out := &in.UpperBound, &out.UpperBound
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerResourceRecommendation.
+func (in *ContainerResourceRecommendation) DeepCopy() *ContainerResourceRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerResourceRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronSpec) DeepCopyInto(out *CronSpec) {
 	*out = *in
@@ -1064,6 +1812,11 @@ func (in *CronSpec) DeepCopyInto(out *CronSpec) {
 		*out = new(ResourceRequirementsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NodeAffinity != nil {
 		in, out := &in.NodeAffinity, &out.NodeAffinity
 		*out = new(v1.NodeAffinity)
@@ -1076,6 +1829,11 @@ func (in *CronSpec) DeepCopyInto(out *CronSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronSpec.
@@ -1195,6 +1953,11 @@ func (in *EchoAPISpec) DeepCopyInto(out *EchoAPISpec) {
 		*out = new(ResourceRequirementsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(ProbeSpec)
@@ -1217,6 +1980,11 @@ func (in *EchoAPISpec) DeepCopyInto(out *EchoAPISpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PublishingStrategies != nil {
 		in, out := &in.PublishingStrategies, &out.PublishingStrategies
 		*out = new(PublishingStrategies)
@@ -1341,18 +2109,88 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvoyDynamicConfig) DeepCopyInto(out *EnvoyDynamicConfig) {
+func (in *EndpointHostnameStatus) DeepCopyInto(out *EndpointHostnameStatus) {
 	*out = *in
-	if in.GeneratorVersion != nil {
-		in, out := &in.GeneratorVersion, &out.GeneratorVersion
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointHostnameStatus.
+func (in *EndpointHostnameStatus) DeepCopy() *EndpointHostnameStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointHostnameStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointPortStatus) DeepCopyInto(out *EndpointPortStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointPortStatus.
+func (in *EndpointPortStatus) DeepCopy() *EndpointPortStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointPortStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointStatus) DeepCopyInto(out *EndpointStatus) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]EndpointPortStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]EndpointHostnameStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointStatus.
+func (in *EndpointStatus) DeepCopy() *EndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyDynamicConfig) DeepCopyInto(out *EnvoyDynamicConfig) {
+	*out = *in
+	if in.GeneratorVersion != nil {
+		in, out := &in.GeneratorVersion, &out.GeneratorVersion
+		*out = new(string)
+		**out = **in
 	}
 	if in.ListenerHttp != nil {
 		in, out := &in.ListenerHttp, &out.ListenerHttp
 		*out = new(ListenerHttp)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ListenerHttp3 != nil {
+		in, out := &in.ListenerHttp3, &out.ListenerHttp3
+		*out = new(ListenerHttp3)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListenerTcp != nil {
+		in, out := &in.ListenerTcp, &out.ListenerTcp
+		*out = new(ListenerTcp)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RouteConfiguration != nil {
 		in, out := &in.RouteConfiguration, &out.RouteConfiguration
 		*out = new(RouteConfiguration)
@@ -1418,67 +2256,679 @@ func (in *ExternalSecretSecretStoreReferenceSpec) DeepCopyInto(out *ExternalSecr
 		*out = new(string)
 		**out = **in
 	}
-	if in.Kind != nil {
-		in, out := &in.Kind, &out.Kind
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretSecretStoreReferenceSpec.
+func (in *ExternalSecretSecretStoreReferenceSpec) DeepCopy() *ExternalSecretSecretStoreReferenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSecretSecretStoreReferenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayAPIPathMatch) DeepCopyInto(out *GatewayAPIPathMatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(GatewayAPIPathMatchType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayAPIPathMatch.
+func (in *GatewayAPIPathMatch) DeepCopy() *GatewayAPIPathMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayAPIPathMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayAPISpec) DeepCopyInto(out *GatewayAPISpec) {
+	*out = *in
+	if in.RouteType != nil {
+		in, out := &in.RouteType, &out.RouteType
+		*out = new(GatewayAPIRouteType)
+		**out = **in
+	}
+	if in.ParentRefs != nil {
+		in, out := &in.ParentRefs, &out.ParentRefs
+		*out = make([]GatewayParentRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PathMatches != nil {
+		in, out := &in.PathMatches, &out.PathMatches
+		*out = make([]GatewayAPIPathMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BackendPort != nil {
+		in, out := &in.BackendPort, &out.BackendPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ServiceNameOverride != nil {
+		in, out := &in.ServiceNameOverride, &out.ServiceNameOverride
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServicePortsOverride != nil {
+		in, out := &in.ServicePortsOverride, &out.ServicePortsOverride
+		*out = make([]v1.ServicePort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayAPISpec.
+func (in *GatewayAPISpec) DeepCopy() *GatewayAPISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayAPISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayParentRef) DeepCopyInto(out *GatewayParentRef) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.SectionName != nil {
+		in, out := &in.SectionName, &out.SectionName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayParentRef.
+func (in *GatewayParentRef) DeepCopy() *GatewayParentRef {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayParentRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GithubSpec) DeepCopyInto(out *GithubSpec) {
+	*out = *in
+	in.ClientID.DeepCopyInto(&out.ClientID)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GithubSpec.
+func (in *GithubSpec) DeepCopy() *GithubSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GithubSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaDashboardSpec) DeepCopyInto(out *GrafanaDashboardSpec) {
+	*out = *in
+	if in.SelectorKey != nil {
+		in, out := &in.SelectorKey, &out.SelectorKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.SelectorValue != nil {
+		in, out := &in.SelectorValue, &out.SelectorValue
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDashboardSpec.
+func (in *GrafanaDashboardSpec) DeepCopy() *GrafanaDashboardSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaDashboardSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderMatch) DeepCopyInto(out *HeaderMatch) {
+	*out = *in
+	if in.Exact != nil {
+		in, out := &in.Exact, &out.Exact
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Regex != nil {
+		in, out := &in.Regex, &out.Regex
+		*out = new(string)
+		**out = **in
+	}
+	if in.Present != nil {
+		in, out := &in.Present, &out.Present
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Invert != nil {
+		in, out := &in.Invert, &out.Invert
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderMatch.
+func (in *HeaderMatch) DeepCopy() *HeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HorizontalPodAutoscalerSpec) DeepCopyInto(out *HorizontalPodAutoscalerSpec) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ResourceName != nil {
+		in, out := &in.ResourceName, &out.ResourceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceUtilization != nil {
+		in, out := &in.ResourceUtilization, &out.ResourceUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(v2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]v2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscalerSpec.
+func (in *HorizontalPodAutoscalerSpec) DeepCopy() *HorizontalPodAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HorizontalPodAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpFilter) DeepCopyInto(out *HttpFilter) {
+	*out = *in
+	if in.LocalRateLimit != nil {
+		in, out := &in.LocalRateLimit, &out.LocalRateLimit
+		*out = new(HttpFilterLocalRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExtAuthz != nil {
+		in, out := &in.ExtAuthz, &out.ExtAuthz
+		*out = new(HttpFilterExtAuthz)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cors != nil {
+		in, out := &in.Cors, &out.Cors
+		*out = new(HttpFilterCors)
+		**out = **in
+	}
+	if in.HeaderMutation != nil {
+		in, out := &in.HeaderMutation, &out.HeaderMutation
+		*out = new(HttpFilterHeaderMutation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lua != nil {
+		in, out := &in.Lua, &out.Lua
+		*out = new(HttpFilterLua)
+		**out = **in
+	}
+	if in.Compressor != nil {
+		in, out := &in.Compressor, &out.Compressor
+		*out = new(HttpFilterCompressor)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpFilter.
+func (in *HttpFilter) DeepCopy() *HttpFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpFilterCompressor) DeepCopyInto(out *HttpFilterCompressor) {
+	*out = *in
+	if in.MinContentLength != nil {
+		in, out := &in.MinContentLength, &out.MinContentLength
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ContentTypes != nil {
+		in, out := &in.ContentTypes, &out.ContentTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpFilterCompressor.
+func (in *HttpFilterCompressor) DeepCopy() *HttpFilterCompressor {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpFilterCompressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpFilterCors) DeepCopyInto(out *HttpFilterCors) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpFilterCors.
+func (in *HttpFilterCors) DeepCopy() *HttpFilterCors {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpFilterCors)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpFilterExtAuthz) DeepCopyInto(out *HttpFilterExtAuthz) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FailureModeAllow != nil {
+		in, out := &in.FailureModeAllow, &out.FailureModeAllow
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PathPrefix != nil {
+		in, out := &in.PathPrefix, &out.PathPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedRequestHeaders != nil {
+		in, out := &in.AllowedRequestHeaders, &out.AllowedRequestHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedUpstreamHeaders != nil {
+		in, out := &in.AllowedUpstreamHeaders, &out.AllowedUpstreamHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpFilterExtAuthz.
+func (in *HttpFilterExtAuthz) DeepCopy() *HttpFilterExtAuthz {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpFilterExtAuthz)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpFilterHeaderMutation) DeepCopyInto(out *HttpFilterHeaderMutation) {
+	*out = *in
+	if in.RequestHeadersToAdd != nil {
+		in, out := &in.RequestHeadersToAdd, &out.RequestHeadersToAdd
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequestHeadersToRemove != nil {
+		in, out := &in.RequestHeadersToRemove, &out.RequestHeadersToRemove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseHeadersToAdd != nil {
+		in, out := &in.ResponseHeadersToAdd, &out.ResponseHeadersToAdd
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResponseHeadersToRemove != nil {
+		in, out := &in.ResponseHeadersToRemove, &out.ResponseHeadersToRemove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpFilterHeaderMutation.
+func (in *HttpFilterHeaderMutation) DeepCopy() *HttpFilterHeaderMutation {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpFilterHeaderMutation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpFilterLocalRateLimit) DeepCopyInto(out *HttpFilterLocalRateLimit) {
+	*out = *in
+	if in.TokensPerFill != nil {
+		in, out := &in.TokensPerFill, &out.TokensPerFill
+		*out = new(uint32)
+		**out = **in
+	}
+	out.FillInterval = in.FillInterval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpFilterLocalRateLimit.
+func (in *HttpFilterLocalRateLimit) DeepCopy() *HttpFilterLocalRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpFilterLocalRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpFilterLua) DeepCopyInto(out *HttpFilterLua) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpFilterLua.
+func (in *HttpFilterLua) DeepCopy() *HttpFilterLua {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpFilterLua)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(string)
+		**out = **in
+	}
+	if in.PullSecretName != nil {
+		in, out := &in.PullSecretName, &out.PullSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PullPolicy != nil {
+		in, out := &in.PullPolicy, &out.PullPolicy
+		*out = new(v1.PullPolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
+func (in *ImageSpec) DeepCopy() *ImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressSpec) DeepCopyInto(out *IngressSpec) {
+	*out = *in
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(IngressKind)
+		**out = **in
+	}
+	if in.ExternalDnsHostnames != nil {
+		in, out := &in.ExternalDnsHostnames, &out.ExternalDnsHostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IngressClassName != nil {
+		in, out := &in.IngressClassName, &out.IngressClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(IngressTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BackendPort != nil {
+		in, out := &in.BackendPort, &out.BackendPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ServiceNameOverride != nil {
+		in, out := &in.ServiceNameOverride, &out.ServiceNameOverride
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServicePortsOverride != nil {
+		in, out := &in.ServicePortsOverride, &out.ServicePortsOverride
+		*out = make([]v1.ServicePort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressSpec.
+func (in *IngressSpec) DeepCopy() *IngressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressTLSSpec) DeepCopyInto(out *IngressTLSSpec) {
+	*out = *in
+	if in.Termination != nil {
+		in, out := &in.Termination, &out.Termination
+		*out = new(TLSTermination)
+		**out = **in
+	}
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.InsecureEdgeTerminationPolicy != nil {
+		in, out := &in.InsecureEdgeTerminationPolicy, &out.InsecureEdgeTerminationPolicy
+		*out = new(InsecureEdgeTerminationPolicy)
+		**out = **in
+	}
+	if in.DestinationCACertificate != nil {
+		in, out := &in.DestinationCACertificate, &out.DestinationCACertificate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressTLSSpec.
+func (in *IngressTLSSpec) DeepCopy() *IngressTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDAPostgresTrigger) DeepCopyInto(out *KEDAPostgresTrigger) {
+	*out = *in
+	if in.ActivationTargetQueryValue != nil {
+		in, out := &in.ActivationTargetQueryValue, &out.ActivationTargetQueryValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionFromEnv != nil {
+		in, out := &in.ConnectionFromEnv, &out.ConnectionFromEnv
+		*out = new(string)
+		**out = **in
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBName != nil {
+		in, out := &in.DBName, &out.DBName
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretSecretStoreReferenceSpec.
-func (in *ExternalSecretSecretStoreReferenceSpec) DeepCopy() *ExternalSecretSecretStoreReferenceSpec {
-	if in == nil {
-		return nil
+	if in.SSLMode != nil {
+		in, out := &in.SSLMode, &out.SSLMode
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ExternalSecretSecretStoreReferenceSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GithubSpec) DeepCopyInto(out *GithubSpec) {
-	*out = *in
-	in.ClientID.DeepCopyInto(&out.ClientID)
-	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GithubSpec.
-func (in *GithubSpec) DeepCopy() *GithubSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDAPostgresTrigger.
+func (in *KEDAPostgresTrigger) DeepCopy() *KEDAPostgresTrigger {
 	if in == nil {
 		return nil
 	}
-	out := new(GithubSpec)
+	out := new(KEDAPostgresTrigger)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GrafanaDashboardSpec) DeepCopyInto(out *GrafanaDashboardSpec) {
+func (in *KEDARedisListLengthTrigger) DeepCopyInto(out *KEDARedisListLengthTrigger) {
 	*out = *in
-	if in.SelectorKey != nil {
-		in, out := &in.SelectorKey, &out.SelectorKey
-		*out = new(string)
+	if in.ListLength != nil {
+		in, out := &in.ListLength, &out.ListLength
+		*out = new(int32)
 		**out = **in
 	}
-	if in.SelectorValue != nil {
-		in, out := &in.SelectorValue, &out.SelectorValue
-		*out = new(string)
+	if in.ActivationListLength != nil {
+		in, out := &in.ActivationListLength, &out.ActivationListLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DatabaseIndex != nil {
+		in, out := &in.DatabaseIndex, &out.DatabaseIndex
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EnableTLS != nil {
+		in, out := &in.EnableTLS, &out.EnableTLS
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDashboardSpec.
-func (in *GrafanaDashboardSpec) DeepCopy() *GrafanaDashboardSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDARedisListLengthTrigger.
+func (in *KEDARedisListLengthTrigger) DeepCopy() *KEDARedisListLengthTrigger {
 	if in == nil {
 		return nil
 	}
-	out := new(GrafanaDashboardSpec)
+	out := new(KEDARedisListLengthTrigger)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HorizontalPodAutoscalerSpec) DeepCopyInto(out *HorizontalPodAutoscalerSpec) {
+func (in *KEDAScaledObjectSpec) DeepCopyInto(out *KEDAScaledObjectSpec) {
 	*out = *in
 	if in.MinReplicas != nil {
 		in, out := &in.MinReplicas, &out.MinReplicas
@@ -1490,13 +2940,18 @@ func (in *HorizontalPodAutoscalerSpec) DeepCopyInto(out *HorizontalPodAutoscaler
 		*out = new(int32)
 		**out = **in
 	}
-	if in.ResourceName != nil {
-		in, out := &in.ResourceName, &out.ResourceName
-		*out = new(string)
+	if in.IdleReplicas != nil {
+		in, out := &in.IdleReplicas, &out.IdleReplicas
+		*out = new(int32)
 		**out = **in
 	}
-	if in.ResourceUtilization != nil {
-		in, out := &in.ResourceUtilization, &out.ResourceUtilization
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CooldownPeriod != nil {
+		in, out := &in.CooldownPeriod, &out.CooldownPeriod
 		*out = new(int32)
 		**out = **in
 	}
@@ -1505,49 +2960,86 @@ func (in *HorizontalPodAutoscalerSpec) DeepCopyInto(out *HorizontalPodAutoscaler
 		*out = new(v2.HorizontalPodAutoscalerBehavior)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]KEDATriggerSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscalerSpec.
-func (in *HorizontalPodAutoscalerSpec) DeepCopy() *HorizontalPodAutoscalerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDAScaledObjectSpec.
+func (in *KEDAScaledObjectSpec) DeepCopy() *KEDAScaledObjectSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HorizontalPodAutoscalerSpec)
+	out := new(KEDAScaledObjectSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+func (in *KEDATriggerSpec) DeepCopyInto(out *KEDATriggerSpec) {
 	*out = *in
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.Tag != nil {
-		in, out := &in.Tag, &out.Tag
+	if in.AuthenticationRef != nil {
+		in, out := &in.AuthenticationRef, &out.AuthenticationRef
 		*out = new(string)
 		**out = **in
 	}
-	if in.PullSecretName != nil {
-		in, out := &in.PullSecretName, &out.PullSecretName
-		*out = new(string)
+	if in.RedisListLength != nil {
+		in, out := &in.RedisListLength, &out.RedisListLength
+		*out = new(KEDARedisListLengthTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Postgres != nil {
+		in, out := &in.Postgres, &out.Postgres
+		*out = new(KEDAPostgresTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDATriggerSpec.
+func (in *KEDATriggerSpec) DeepCopy() *KEDATriggerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDATriggerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerClientValidation) DeepCopyInto(out *ListenerClientValidation) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(ClientCertificateMode)
 		**out = **in
 	}
-	if in.PullPolicy != nil {
-		in, out := &in.PullPolicy, &out.PullPolicy
-		*out = new(v1.PullPolicy)
+	if in.SubjectAltNames != nil {
+		in, out := &in.SubjectAltNames, &out.SubjectAltNames
+		*out = make([]SubjectAltNameMatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForwardClientIdentity != nil {
+		in, out := &in.ForwardClientIdentity, &out.ForwardClientIdentity
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
-func (in *ImageSpec) DeepCopy() *ImageSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerClientValidation.
+func (in *ListenerClientValidation) DeepCopy() *ListenerClientValidation {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageSpec)
+	out := new(ListenerClientValidation)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1630,6 +3122,23 @@ func (in *ListenerHttp) DeepCopyInto(out *ListenerHttp) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.HttpFilters != nil {
+		in, out := &in.HttpFilters, &out.HttpFilters
+		*out = make([]HttpFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(AccessLogOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientValidation != nil {
+		in, out := &in.ClientValidation, &out.ClientValidation
+		*out = new(ListenerClientValidation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerHttp.
@@ -1642,6 +3151,53 @@ func (in *ListenerHttp) DeepCopy() *ListenerHttp {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerHttp3) DeepCopyInto(out *ListenerHttp3) {
+	*out = *in
+	if in.RateLimitOptions != nil {
+		in, out := &in.RateLimitOptions, &out.RateLimitOptions
+		*out = new(RateLimitOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowHeadersWithUnderscores != nil {
+		in, out := &in.AllowHeadersWithUnderscores, &out.AllowHeadersWithUnderscores
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxConnectionDuration != nil {
+		in, out := &in.MaxConnectionDuration, &out.MaxConnectionDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxRequestHeadersKb != nil {
+		in, out := &in.MaxRequestHeadersKb, &out.MaxRequestHeadersKb
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.HttpFilters != nil {
+		in, out := &in.HttpFilters, &out.HttpFilters
+		*out = make([]HttpFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(AccessLogOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerHttp3.
+func (in *ListenerHttp3) DeepCopy() *ListenerHttp3 {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerHttp3)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ListenerSpec) DeepCopyInto(out *ListenerSpec) {
 	*out = *in
@@ -1670,6 +3226,11 @@ func (in *ListenerSpec) DeepCopyInto(out *ListenerSpec) {
 		*out = new(ResourceRequirementsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(ProbeSpec)
@@ -1692,6 +3253,11 @@ func (in *ListenerSpec) DeepCopyInto(out *ListenerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Canary != nil {
 		in, out := &in.Canary, &out.Canary
 		*out = new(Canary)
@@ -1729,6 +3295,73 @@ func (in *ListenerSpec) DeepCopy() *ListenerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerTcp) DeepCopyInto(out *ListenerTcp) {
+	*out = *in
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSMode != nil {
+		in, out := &in.TLSMode, &out.TLSMode
+		*out = new(ListenerTcpTLSMode)
+		**out = **in
+	}
+	if in.CertificateSecretName != nil {
+		in, out := &in.CertificateSecretName, &out.CertificateSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(string)
+		**out = **in
+	}
+	if in.SNIRoutes != nil {
+		in, out := &in.SNIRoutes, &out.SNIRoutes
+		*out = make([]ListenerTcpSNIRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerTcp.
+func (in *ListenerTcp) DeepCopy() *ListenerTcp {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerTcp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerTcpSNIRoute) DeepCopyInto(out *ListenerTcpSNIRoute) {
+	*out = *in
+	if in.ServerNames != nil {
+		in, out := &in.ServerNames, &out.ServerNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerTcpSNIRoute.
+func (in *ListenerTcpSNIRoute) DeepCopy() *ListenerTcpSNIRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerTcpSNIRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in MapOfEnvoyDynamicConfig) DeepCopyInto(out *MapOfEnvoyDynamicConfig) {
 	{
@@ -1864,6 +3497,11 @@ func (in *MappingServiceSpec) DeepCopyInto(out *MappingServiceSpec) {
 		*out = new(ResourceRequirementsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(ProbeSpec)
@@ -1892,6 +3530,11 @@ func (in *MappingServiceSpec) DeepCopyInto(out *MappingServiceSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PublishingStrategies != nil {
 		in, out := &in.PublishingStrategies, &out.PublishingStrategies
 		*out = new(PublishingStrategies)
@@ -2077,6 +3720,21 @@ func (in *NetworkLoadBalancerSpec) DeepCopy() *NetworkLoadBalancerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingDeletionStatus) DeepCopyInto(out *PendingDeletionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingDeletionStatus.
+func (in *PendingDeletionStatus) DeepCopy() *PendingDeletionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingDeletionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodDisruptionBudgetSpec) DeepCopyInto(out *PodDisruptionBudgetSpec) {
 	*out = *in
@@ -2182,6 +3840,21 @@ func (in *PublishingStrategy) DeepCopyInto(out *PublishingStrategy) {
 		*out = new(Marin3rSidecarSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GatewayAPI != nil {
+		in, out := &in.GatewayAPI, &out.GatewayAPI
+		*out = new(GatewayAPISpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(IngressSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		**out = **in
+	}
 	if in.Create != nil {
 		in, out := &in.Create, &out.Create
 		*out = new(bool)
@@ -2212,6 +3885,11 @@ func (in *QueSpec) DeepCopyInto(out *QueSpec) {
 		*out = new(HorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KEDA != nil {
+		in, out := &in.KEDA, &out.KEDA
+		*out = new(KEDAScaledObjectSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
@@ -2222,6 +3900,11 @@ func (in *QueSpec) DeepCopyInto(out *QueSpec) {
 		*out = new(ResourceRequirementsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(ProbeSpec)
@@ -2237,21 +3920,106 @@ func (in *QueSpec) DeepCopyInto(out *QueSpec) {
 		*out = new(v1.NodeAffinity)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueSpec.
+func (in *QueSpec) DeepCopy() *QueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitAction) DeepCopyInto(out *RateLimitAction) {
+	*out = *in
+	if in.RemoteAddress != nil {
+		in, out := &in.RemoteAddress, &out.RemoteAddress
+		*out = new(RateLimitActionRemoteAddress)
+		**out = **in
+	}
+	if in.RequestHeader != nil {
+		in, out := &in.RequestHeader, &out.RequestHeader
+		*out = new(RateLimitActionRequestHeader)
+		**out = **in
+	}
+	if in.GenericKey != nil {
+		in, out := &in.GenericKey, &out.GenericKey
+		*out = new(RateLimitActionGenericKey)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitAction.
+func (in *RateLimitAction) DeepCopy() *RateLimitAction {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitActionGenericKey) DeepCopyInto(out *RateLimitActionGenericKey) {
+	*out = *in
+	if in.DescriptorKey != nil {
+		in, out := &in.DescriptorKey, &out.DescriptorKey
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitActionGenericKey.
+func (in *RateLimitActionGenericKey) DeepCopy() *RateLimitActionGenericKey {
+	if in == nil {
+		return nil
 	}
+	out := new(RateLimitActionGenericKey)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueSpec.
-func (in *QueSpec) DeepCopy() *QueSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitActionRemoteAddress) DeepCopyInto(out *RateLimitActionRemoteAddress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitActionRemoteAddress.
+func (in *RateLimitActionRemoteAddress) DeepCopy() *RateLimitActionRemoteAddress {
 	if in == nil {
 		return nil
 	}
-	out := new(QueSpec)
+	out := new(RateLimitActionRemoteAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitActionRequestHeader) DeepCopyInto(out *RateLimitActionRequestHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitActionRequestHeader.
+func (in *RateLimitActionRequestHeader) DeepCopy() *RateLimitActionRequestHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitActionRequestHeader)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2432,6 +4200,21 @@ func (in *RedisShardNodes) DeepCopy() *RedisShardNodes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisShardReplicationStatus) DeepCopyInto(out *RedisShardReplicationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisShardReplicationStatus.
+func (in *RedisShardReplicationStatus) DeepCopy() *RedisShardReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisShardReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisShardSpec) DeepCopyInto(out *RedisShardSpec) {
 	*out = *in
@@ -2455,6 +4238,50 @@ func (in *RedisShardSpec) DeepCopyInto(out *RedisShardSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.StorageClass != nil {
+		in, out := &in.StorageClass, &out.StorageClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageSize != nil {
+		in, out := &in.StorageSize, &out.StorageSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PDB != nil {
+		in, out := &in.PDB, &out.PDB
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ResourceRequirementsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeAffinity != nil {
+		in, out := &in.NodeAffinity, &out.NodeAffinity
+		*out = new(v1.NodeAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisShardSpec.
@@ -2476,6 +4303,13 @@ func (in *RedisShardStatus) DeepCopyInto(out *RedisShardStatus) {
 		*out = new(RedisShardNodes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = make(map[string]RedisShardReplicationStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisShardStatus.
@@ -2493,88 +4327,341 @@ func (in *RedisSpec) DeepCopyInto(out *RedisSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSpec.
-func (in *RedisSpec) DeepCopy() *RedisSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSpec.
+func (in *RedisSpec) DeepCopy() *RedisSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRequirementsSpec) DeepCopyInto(out *ResourceRequirementsSpec) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make([]v1.ResourceClaim, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequirementsSpec.
+func (in *ResourceRequirementsSpec) DeepCopy() *ResourceRequirementsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRequirementsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+	in.Match.DeepCopyInto(&out.Match)
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(string)
+		**out = **in
+	}
+	if in.WeightedClusters != nil {
+		in, out := &in.WeightedClusters, &out.WeightedClusters
+		*out = make([]WeightedCluster, len(*in))
+		copy(*out, *in)
+	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(RouteRedirect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DirectResponse != nil {
+		in, out := &in.DirectResponse, &out.DirectResponse
+		*out = new(RouteDirectResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(RouteRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = make([]RouteRateLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteConfiguration) DeepCopyInto(out *RouteConfiguration) {
+	*out = *in
+	if in.VirtualHosts != nil {
+		in, out := &in.VirtualHosts, &out.VirtualHosts
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TypedVirtualHosts != nil {
+		in, out := &in.TypedVirtualHosts, &out.TypedVirtualHosts
+		*out = make([]VirtualHost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteConfiguration.
+func (in *RouteConfiguration) DeepCopy() *RouteConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteDirectResponse) DeepCopyInto(out *RouteDirectResponse) {
+	*out = *in
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteDirectResponse.
+func (in *RouteDirectResponse) DeepCopy() *RouteDirectResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteDirectResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteMatch) DeepCopyInto(out *RouteMatch) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Regex != nil {
+		in, out := &in.Regex, &out.Regex
+		*out = new(string)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteMatch.
+func (in *RouteMatch) DeepCopy() *RouteMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteRateLimit) DeepCopyInto(out *RouteRateLimit) {
+	*out = *in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]RateLimitAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteRateLimit.
+func (in *RouteRateLimit) DeepCopy() *RouteRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteRedirect) DeepCopyInto(out *RouteRedirect) {
+	*out = *in
+	if in.HostRedirect != nil {
+		in, out := &in.HostRedirect, &out.HostRedirect
+		*out = new(string)
+		**out = **in
+	}
+	if in.PathRedirect != nil {
+		in, out := &in.PathRedirect, &out.PathRedirect
+		*out = new(string)
+		**out = **in
+	}
+	if in.HttpsRedirect != nil {
+		in, out := &in.HttpsRedirect, &out.HttpsRedirect
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResponseCode != nil {
+		in, out := &in.ResponseCode, &out.ResponseCode
+		*out = new(RedirectResponseCode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteRedirect.
+func (in *RouteRedirect) DeepCopy() *RouteRedirect {
 	if in == nil {
 		return nil
 	}
-	out := new(RedisSpec)
+	out := new(RouteRedirect)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceRequirementsSpec) DeepCopyInto(out *ResourceRequirementsSpec) {
+func (in *RouteRetryPolicy) DeepCopyInto(out *RouteRetryPolicy) {
 	*out = *in
-	if in.Limits != nil {
-		in, out := &in.Limits, &out.Limits
-		*out = make(v1.ResourceList, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
-	}
-	if in.Requests != nil {
-		in, out := &in.Requests, &out.Requests
-		*out = make(v1.ResourceList, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
+	if in.NumRetries != nil {
+		in, out := &in.NumRetries, &out.NumRetries
+		*out = new(uint32)
+		**out = **in
 	}
-	if in.Claims != nil {
-		in, out := &in.Claims, &out.Claims
-		*out = make([]v1.ResourceClaim, len(*in))
-		copy(*out, *in)
+	if in.PerTryTimeout != nil {
+		in, out := &in.PerTryTimeout, &out.PerTryTimeout
+		*out = new(metav1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequirementsSpec.
-func (in *ResourceRequirementsSpec) DeepCopy() *ResourceRequirementsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteRetryPolicy.
+func (in *RouteRetryPolicy) DeepCopy() *RouteRetryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceRequirementsSpec)
+	out := new(RouteRetryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteConfiguration) DeepCopyInto(out *RouteConfiguration) {
+func (in *Runtime) DeepCopyInto(out *Runtime) {
 	*out = *in
-	if in.VirtualHosts != nil {
-		in, out := &in.VirtualHosts, &out.VirtualHosts
-		*out = make([]runtime.RawExtension, len(*in))
+	if in.ListenerNames != nil {
+		in, out := &in.ListenerNames, &out.ListenerNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ListenerConnectionLimit != nil {
+		in, out := &in.ListenerConnectionLimit, &out.ListenerConnectionLimit
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ListenerConnectionLimits != nil {
+		in, out := &in.ListenerConnectionLimits, &out.ListenerConnectionLimits
+		*out = make(map[string]uint32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.GlobalDownstreamMaxConnections != nil {
+		in, out := &in.GlobalDownstreamMaxConnections, &out.GlobalDownstreamMaxConnections
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]RuntimeKey, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteConfiguration.
-func (in *RouteConfiguration) DeepCopy() *RouteConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Runtime.
+func (in *Runtime) DeepCopy() *Runtime {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteConfiguration)
+	out := new(Runtime)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Runtime) DeepCopyInto(out *Runtime) {
+func (in *RuntimeKey) DeepCopyInto(out *RuntimeKey) {
 	*out = *in
-	if in.ListenerNames != nil {
-		in, out := &in.ListenerNames, &out.ListenerNames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Bool != nil {
+		in, out := &in.Bool, &out.Bool
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Integer != nil {
+		in, out := &in.Integer, &out.Integer
+		*out = new(int64)
+		**out = **in
+	}
+	if in.String != nil {
+		in, out := &in.String, &out.String
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Runtime.
-func (in *Runtime) DeepCopy() *Runtime {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeKey.
+func (in *RuntimeKey) DeepCopy() *RuntimeKey {
 	if in == nil {
 		return nil
 	}
-	out := new(Runtime)
+	out := new(RuntimeKey)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2653,6 +4740,53 @@ func (in *SSHOptions) DeepCopy() *SSHOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingSpec) DeepCopyInto(out *SchedulingSpec) {
+	*out = *in
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodAntiAffinity != nil {
+		in, out := &in.PodAntiAffinity, &out.PodAntiAffinity
+		*out = new(PodAntiAffinityPreset)
+		**out = **in
+	}
+	if in.PriorityClassName != nil {
+		in, out := &in.PriorityClassName, &out.PriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountName != nil {
+		in, out := &in.ServiceAccountName, &out.ServiceAccountName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingSpec.
+func (in *SchedulingSpec) DeepCopy() *SchedulingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SearchServerSpec) DeepCopyInto(out *SearchServerSpec) {
 	*out = *in
@@ -2840,6 +4974,16 @@ func (in *SentinelConfig) DeepCopyInto(out *SentinelConfig) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.RedisShardRefs != nil {
+		in, out := &in.RedisShardRefs, &out.RedisShardRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RedisShardSelector != nil {
+		in, out := &in.RedisShardSelector, &out.RedisShardSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StorageClass != nil {
 		in, out := &in.StorageClass, &out.StorageClass
 		*out = new(string)
@@ -2949,6 +5093,11 @@ func (in *SentinelSpec) DeepCopyInto(out *SentinelSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Config != nil {
 		in, out := &in.Config, &out.Config
 		*out = new(SentinelConfig)
@@ -3202,6 +5351,11 @@ func (in *Simple) DeepCopyInto(out *Simple) {
 		*out = new(NetworkLoadBalancerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AWSLoadBalancerConfig != nil {
+		in, out := &in.AWSLoadBalancerConfig, &out.AWSLoadBalancerConfig
+		*out = new(AWSLoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Simple.
@@ -3214,6 +5368,21 @@ func (in *Simple) DeepCopy() *Simple {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubjectAltNameMatch) DeepCopyInto(out *SubjectAltNameMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubjectAltNameMatch.
+func (in *SubjectAltNameMatch) DeepCopy() *SubjectAltNameMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(SubjectAltNameMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *System) DeepCopyInto(out *System) {
 	*out = *in
@@ -3284,6 +5453,11 @@ func (in *SystemAppSpec) DeepCopyInto(out *SystemAppSpec) {
 		*out = new(ResourceRequirementsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(ProbeSpec)
@@ -3306,6 +5480,11 @@ func (in *SystemAppSpec) DeepCopyInto(out *SystemAppSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TerminationGracePeriodSeconds != nil {
 		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
 		*out = new(int64)
@@ -3488,6 +5667,11 @@ func (in *SystemRailsConsoleSpec) DeepCopyInto(out *SystemRailsConsoleSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemRailsConsoleSpec.
@@ -3592,6 +5776,11 @@ func (in *SystemSearchdSpec) DeepCopyInto(out *SystemSearchdSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TerminationGracePeriodSeconds != nil {
 		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
 		*out = new(int64)
@@ -3632,6 +5821,11 @@ func (in *SystemSidekiqSpec) DeepCopyInto(out *SystemSidekiqSpec) {
 		*out = new(HorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KEDA != nil {
+		in, out := &in.KEDA, &out.KEDA
+		*out = new(KEDAScaledObjectSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
@@ -3642,6 +5836,11 @@ func (in *SystemSidekiqSpec) DeepCopyInto(out *SystemSidekiqSpec) {
 		*out = new(ResourceRequirementsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(ProbeSpec)
@@ -3664,6 +5863,11 @@ func (in *SystemSidekiqSpec) DeepCopyInto(out *SystemSidekiqSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Canary != nil {
 		in, out := &in.Canary, &out.Canary
 		*out = new(Canary)
@@ -3989,6 +6193,16 @@ func (in *TwemproxyConfigSpec) DeepCopyInto(out *TwemproxyConfigSpec) {
 		*out = new(GrafanaDashboardSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SentinelEventsDebounce != nil {
+		in, out := &in.SentinelEventsDebounce, &out.SentinelEventsDebounce
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ResyncPeriod != nil {
+		in, out := &in.ResyncPeriod, &out.ResyncPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwemproxyConfigSpec.
@@ -4066,6 +6280,7 @@ func (in *TwemproxyServerPool) DeepCopyInto(out *TwemproxyServerPool) {
 		*out = new(TargetRedisServers)
 		**out = **in
 	}
+	in.TwemproxyServerPoolOptions.DeepCopyInto(&out.TwemproxyServerPoolOptions)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwemproxyServerPool.
@@ -4078,6 +6293,77 @@ func (in *TwemproxyServerPool) DeepCopy() *TwemproxyServerPool {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TwemproxyServerPoolOptions) DeepCopyInto(out *TwemproxyServerPoolOptions) {
+	*out = *in
+	if in.Hash != nil {
+		in, out := &in.Hash, &out.Hash
+		*out = new(string)
+		**out = **in
+	}
+	if in.HashTag != nil {
+		in, out := &in.HashTag, &out.HashTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.Distribution != nil {
+		in, out := &in.Distribution, &out.Distribution
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoEjectHosts != nil {
+		in, out := &in.AutoEjectHosts, &out.AutoEjectHosts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServerRetryTimeout != nil {
+		in, out := &in.ServerRetryTimeout, &out.ServerRetryTimeout
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ServerFailureLimit != nil {
+		in, out := &in.ServerFailureLimit, &out.ServerFailureLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RedisAuth != nil {
+		in, out := &in.RedisAuth, &out.RedisAuth
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RedisDB != nil {
+		in, out := &in.RedisDB, &out.RedisDB
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwemproxyServerPoolOptions.
+func (in *TwemproxyServerPoolOptions) DeepCopy() *TwemproxyServerPoolOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(TwemproxyServerPoolOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TwemproxyServerPoolOverride) DeepCopyInto(out *TwemproxyServerPoolOverride) {
+	*out = *in
+	in.TwemproxyServerPoolOptions.DeepCopyInto(&out.TwemproxyServerPoolOptions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwemproxyServerPoolOverride.
+func (in *TwemproxyServerPoolOverride) DeepCopy() *TwemproxyServerPoolOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(TwemproxyServerPoolOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TwemproxySpec) DeepCopyInto(out *TwemproxySpec) {
 	*out = *in
@@ -4106,6 +6392,13 @@ func (in *TwemproxySpec) DeepCopyInto(out *TwemproxySpec) {
 		*out = new(TwemproxyOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServerPoolOverrides != nil {
+		in, out := &in.ServerPoolOverrides, &out.ServerPoolOverrides
+		*out = make([]TwemproxyServerPoolOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwemproxySpec.
@@ -4133,6 +6426,87 @@ func (in *VaultSecretReference) DeepCopy() *VaultSecretReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerticalPodAutoscalerSpec) DeepCopyInto(out *VerticalPodAutoscalerSpec) {
+	*out = *in
+	if in.UpdateMode != nil {
+		in, out := &in.UpdateMode, &out.UpdateMode
+		*out = new(VPAUpdateMode)
+		**out = **in
+	}
+	if in.MinAllowed != nil {
+		in, out := &in.MinAllowed, &out.MinAllowed
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.MaxAllowed != nil {
+		in, out := &in.MaxAllowed, &out.MaxAllowed
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.ControlledResources != nil {
+		in, out := &in.ControlledResources, &out.ControlledResources
+		*out = make([]v1.ResourceName, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerticalPodAutoscalerSpec.
+func (in *VerticalPodAutoscalerSpec) DeepCopy() *VerticalPodAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalPodAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualHost) DeepCopyInto(out *VirtualHost) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]Route, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualHost.
+func (in *VirtualHost) DeepCopy() *VirtualHost {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedCluster) DeepCopyInto(out *WeightedCluster) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedCluster.
+func (in *WeightedCluster) DeepCopy() *WeightedCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 	*out = *in
@@ -4176,6 +6550,11 @@ func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
 		*out = new(HorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KEDA != nil {
+		in, out := &in.KEDA, &out.KEDA
+		*out = new(KEDAScaledObjectSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
@@ -4186,6 +6565,11 @@ func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
 		*out = new(ResourceRequirementsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VerticalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(ProbeSpec)
@@ -4208,6 +6592,11 @@ func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Canary != nil {
 		in, out := &in.Canary, &out.Canary
 		*out = new(Canary)
@@ -4238,6 +6627,13 @@ func (in *WorkloadStatus) DeepCopyInto(out *WorkloadStatus) {
 		*out = new(appsv1.StatefulSetStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResourceRecommendations != nil {
+		in, out := &in.ResourceRecommendations, &out.ResourceRecommendations
+		*out = make([]ContainerResourceRecommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatus.
@@ -4368,6 +6764,11 @@ func (in *ZyncRailsConsoleSpec) DeepCopyInto(out *ZyncRailsConsoleSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZyncRailsConsoleSpec.
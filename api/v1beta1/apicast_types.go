@@ -0,0 +1,160 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApicastSpec defines the desired state of Apicast
+type ApicastSpec struct {
+	// Configures the staging Apicast environment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Staging ApicastEnvironmentSpec `json:"staging"`
+	// Configures the production Apicast environment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Production ApicastEnvironmentSpec `json:"production"`
+	// Configures the Grafana Dashboard for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+}
+
+// ApicastEnvironmentSpec is the configuration for an Apicast environment
+type ApicastEnvironmentSpec struct {
+	// Image specification for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *ImageSpec `json:"image,omitempty"`
+	// Pod Disruption Budget for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PDB *PodDisruptionBudgetSpec `json:"pdb,omitempty"`
+	// Horizontal Pod Autoscaler for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Number of replicas (ignored if hpa is enabled) for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resource requirements for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
+	// Liveness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LivenessProbe *ProbeSpec `json:"livenessProbe,omitempty"`
+	// Readiness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ReadinessProbe *ProbeSpec `json:"readinessProbe,omitempty"`
+	// Application specific configuration options for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Config ApicastConfig `json:"config"`
+	// Describes node affinity scheduling rules for the pod.
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
+	// If specified, the pod's tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+	// Canary defines spec changes for the canary Deployment. If
+	// left unset the canary Deployment wil not be created.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Canary *Canary `json:"canary,omitempty"`
+	// Describes how the services provided by this workload are exposed to its consumers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PublishingStrategies *PublishingStrategies `json:"publishingStrategies,omitempty"`
+	// The external endpoint/s for the component
+	// DEPRECATED
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Endpoint *Endpoint `json:"endpoint,omitempty"`
+	// Marin3r configures the Marin3r sidecars for the component
+	// DEPRECATED
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Marin3r *Marin3rSidecarSpec `json:"marin3r,omitempty"`
+	// Configures the AWS load balancer for the component
+	// DEPRECATED
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LoadBalancer *ElasticLoadBalancerSpec `json:"loadBalancer,omitempty"`
+}
+
+// ApicastConfig configures app behavior for Apicast
+type ApicastConfig struct {
+	// Apicast configurations cache TTL
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ConfigurationCache int32 `json:"configurationCache"`
+	// Endpoint to request proxy configurations to
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ThreescalePortalEndpoint string `json:"threescalePortalEndpoint"`
+	// Openresty log level
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=debug;info;notice;warn;error;crit;alert;emerg
+	// +optional
+	LogLevel *string `json:"logLevel,omitempty"`
+	// OpenID Connect integration log level
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=debug;info;notice;warn;error;crit;alert;emerg
+	// +optional
+	OIDCLogLevel *string `json:"oidcLogLevel,omitempty"`
+}
+
+// ApicastStatus defines the observed state of Apicast
+type ApicastStatus struct {
+	AggregatedStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Apicast is the Schema for the apicasts API
+type Apicast struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApicastSpec   `json:"spec,omitempty"`
+	Status ApicastStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApicastList contains a list of Apicast
+type ApicastList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Apicast `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Apicast{}, &ApicastList{})
+}
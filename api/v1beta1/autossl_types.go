@@ -0,0 +1,162 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AutoSSLSpec defines the desired state of AutoSSL
+type AutoSSLSpec struct {
+	// Image specification for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *ImageSpec `json:"image,omitempty"`
+	// Pod Disruption Budget for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PDB *PodDisruptionBudgetSpec `json:"pdb,omitempty"`
+	// Horizontal Pod Autoscaler for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Number of replicas (ignored if hpa is enabled) for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resource requirements for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
+	// Liveness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LivenessProbe *ProbeSpec `json:"livenessProbe,omitempty"`
+	// Readiness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ReadinessProbe *ProbeSpec `json:"readinessProbe,omitempty"`
+	// Configures the Grafana Dashboard for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Application specific configuration options for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Config AutoSSLConfig `json:"config"`
+	// Describes node affinity scheduling rules for the pod.
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
+	// If specified, the pod's tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+	// Canary defines spec changes for the canary Deployment. If
+	// left unset the canary Deployment wil not be created.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Canary *Canary `json:"canary,omitempty"`
+	// Describes how the services provided by this workload are exposed to its consumers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PublishingStrategies *PublishingStrategies `json:"publishingStrategies,omitempty"`
+	// The external endpoint/s for the component
+	// DEPRECATED
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Endpoint *Endpoint `json:"endpoint,omitempty"`
+	// Configures the AWS load balancer for the component
+	// DEPRECATED
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LoadBalancer *ElasticLoadBalancerSpec `json:"loadBalancer,omitempty"`
+}
+
+// AutoSSLConfig defines configuration options for the component
+type AutoSSLConfig struct {
+	// Sets the nginx log level
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LogLevel *string `json:"logLevel,omitempty"`
+	// Enables/disables the Let's Encrypt staging ACME endpoint
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ACMEStaging *bool `json:"acmeStaging,omitempty"`
+	// Defines an email address for Let's Encrypt notifications
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ContactEmail string `json:"contactEmail"`
+	// The endpoint to proxy_pass requests to
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ProxyEndpoint string `json:"proxyEndpoint"`
+	// The endpoint used to validate if certificate generation is allowed
+	// for the domain
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	VerificationEndpoint string `json:"verificationEndpoint"`
+	// List of domains that will bypass domain verification
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DomainWhitelist []string `json:"domainWhitelist,omitempty"`
+	// List of domains that will never get autogenerated certificates
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DomainBlacklist []string `json:"domainBlacklist,omitempty"`
+	// Host for the redis database to store certificates
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	RedisHost string `json:"redisHost"`
+	// Port for the redis database to store certificates
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RedisPort *int32 `json:"redisPort,omitempty"`
+}
+
+// AutoSSLStatus defines the observed state of AutoSSL
+type AutoSSLStatus struct {
+	AggregatedStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AutoSSL is the Schema for the autossls API
+type AutoSSL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AutoSSLSpec   `json:"spec,omitempty"`
+	Status AutoSSLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AutoSSLList contains a list of AutoSSL
+type AutoSSLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AutoSSL `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AutoSSL{}, &AutoSSLList{})
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackendSpec defines the desired state of Backend
+type BackendSpec struct {
+	// Image specification for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *ImageSpec `json:"image,omitempty"`
+	// Application specific configuration options for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Config BackendConfig `json:"config"`
+	// Configures the Grafana Dashboard for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Configures the backend listener
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Listener ListenerSpec `json:"listener"`
+	// Configures the backend worker
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Worker *WorkerSpec `json:"worker,omitempty"`
+	// Configures the backend cron
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Cron *CronSpec `json:"cron,omitempty"`
+	// Configures twemproxy
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Twemproxy *TwemproxySpec `json:"twemproxy,omitempty"`
+}
+
+// ListenerSpec is the configuration for Backend Listener
+type ListenerSpec struct {
+	// Listener specific configuration options for the component element
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Config *ListenerConfig `json:"config,omitempty"`
+	// Pod Disruption Budget for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PDB *PodDisruptionBudgetSpec `json:"pdb,omitempty"`
+	// Horizontal Pod Autoscaler for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Number of replicas (ignored if hpa is enabled) for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resource requirements for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
+	// Liveness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LivenessProbe *ProbeSpec `json:"livenessProbe,omitempty"`
+	// Readiness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ReadinessProbe *ProbeSpec `json:"readinessProbe,omitempty"`
+	// Describes node affinity scheduling rules for the pod.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
+	// If specified, the pod's tolerations.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+	// Canary defines spec changes for the canary Deployment. If
+	// left unset the canary Deployment wil not be created.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Canary *Canary `json:"canary,omitempty"`
+	// Describes how the services provided by this workload are exposed to its consumers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PublishingStrategies *PublishingStrategies `json:"publishingStrategies,omitempty"`
+	// The external endpoint/s for the component
+	// DEPRECATED
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Endpoint *Endpoint `json:"endpoint,omitempty"`
+	// Marin3r configures the Marin3r sidecars for the component
+	// DEPRECATED
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Marin3r *Marin3rSidecarSpec `json:"marin3r,omitempty"`
+	// Configures the AWS load balancer for the component
+	// DEPRECATED
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LoadBalancer *NetworkLoadBalancerSpec `json:"loadBalancer,omitempty"`
+}
+
+// WorkerSpec is the configuration for Backend Worker
+type WorkerSpec struct {
+	// Listener specific configuration options for the component element
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Config *WorkerConfig `json:"config,omitempty"`
+	// Pod Disruption Budget for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PDB *PodDisruptionBudgetSpec `json:"pdb,omitempty"`
+	// Horizontal Pod Autoscaler for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// KEDA ScaledObject for the component. When set, it replaces the
+	// Horizontal Pod Autoscaler to scale on external events like queue depth.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	KEDA *KEDAScaledObjectSpec `json:"keda,omitempty"`
+	// Number of replicas (ignored if hpa is enabled) for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resource requirements for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
+	// Liveness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LivenessProbe *ProbeSpec `json:"livenessProbe,omitempty"`
+	// Readiness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ReadinessProbe *ProbeSpec `json:"readinessProbe,omitempty"`
+	// Describes node affinity scheduling rules for the pod.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
+	// If specified, the pod's tolerations.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+	// Canary defines spec changes for the canary Deployment. If
+	// left unset the canary Deployment wil not be created.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Canary *Canary `json:"canary,omitempty"`
+}
+
+// CronSpec is the configuration for Backend Cron
+type CronSpec struct {
+	// Number of replicas for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resource requirements for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
+	// Describes node affinity scheduling rules for the pod.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
+	// If specified, the pod's tolerations.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+}
+
+// BackendConfig configures app behavior for Backend
+type BackendConfig struct {
+	// Rack environment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RackEnv *string `json:"rackEnv,omitempty"`
+	// Master service account ID in Porta
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MasterServiceID *int32 `json:"masterServiceID,omitempty"`
+	// Redis Storage DSN
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	RedisStorageDSN string `json:"redisStorageDSN"`
+	// Redis Queues DSN
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	RedisQueuesDSN string `json:"redisQueuesDSN"`
+	// External Secret common configuration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExternalSecret ExternalSecret `json:"externalSecret,omitempty"`
+	// A reference to the secret holding the backend-system-events-hook URL
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	SystemEventsHookURL SecretReference `json:"systemEventsHookURL"`
+	// A reference to the secret holding the backend-system-events-hook password
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	SystemEventsHookPassword SecretReference `json:"systemEventsHookPassword"`
+	// A reference to the secret holding the backend-internal-api user
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	InternalAPIUser SecretReference `json:"internalAPIUser"`
+	// A reference to the secret holding the backend-internal-api password
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	InternalAPIPassword SecretReference `json:"internalAPIPassword"`
+	// A reference to the secret holding the backend-error-monitoring service
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ErrorMonitoringService *SecretReference `json:"errorMonitoringService,omitempty"`
+	// A reference to the secret holding the backend-error-monitoring key
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ErrorMonitoringKey *SecretReference `json:"errorMonitoringKey,omitempty"`
+}
+
+// ListenerConfig configures app behavior for Backend Listener
+type ListenerConfig struct {
+	// Listener log format
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=test;json
+	// +optional
+	LogFormat *string `json:"logFormat,omitempty"`
+	// Enable (true) or disable (false) listener redis async mode
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RedisAsync *bool `json:"redisAsync,omitempty"`
+	// Number of worker processes per listener pod
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ListenerWorkers *int32 `json:"listenerWorkers,omitempty"`
+	// Enable (true) or disable (false) Legacy Referrer Filters
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LegacyReferrerFilters *bool `json:"legacyReferrerFilters,omitempty"`
+}
+
+// WorkerConfig configures app behavior for Backend Worker
+type WorkerConfig struct {
+	// Worker log format
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=test;json
+	// +optional
+	LogFormat *string `json:"logFormat,omitempty"`
+	// Enable (true) or disable (false) worker redis async mode
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RedisAsync *bool `json:"redisAsync,omitempty"`
+}
+
+// BackendStatus defines the observed state of Backend
+type BackendStatus struct {
+	AggregatedStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Backend is the Schema for the backends API
+type Backend struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackendSpec   `json:"spec,omitempty"`
+	Status BackendStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackendList contains a list of Backend
+type BackendList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Backend `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Backend{}, &BackendList{})
+}
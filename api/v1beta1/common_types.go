@@ -0,0 +1,1077 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ImageSpec defines the image for the component
+type ImageSpec struct {
+	// Docker repository of the image
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// Image tag
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Tag *string `json:"tag,omitempty"`
+	// Name of the Secret that holds quay.io credentials to access
+	// the image repository
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PullSecretName *string `json:"pullSecretName,omitempty"`
+	// Pull policy for the image
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PullPolicy *corev1.PullPolicy `json:"pullPolicy,omitempty"`
+}
+
+// ProbeSpec specifies configuration for a probe
+type ProbeSpec struct {
+	// Number of seconds after the container has started before liveness probes are initiated
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+	// Number of seconds after which the probe times out
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+	// How often (in seconds) to perform the probe
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+	// Minimum consecutive successes for the probe to be considered successful after having failed
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SuccessThreshold *int32 `json:"successThreshold,omitempty"`
+	// Minimum consecutive failures for the probe to be considered failed after having succeeded
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+}
+
+// ElasticLoadBalancerSpec configures the AWS load balancer for the component
+type ElasticLoadBalancerSpec struct {
+	// Enables/disbles use of proxy protocol in the load balancer
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ProxyProtocol *bool `json:"proxyProtocol,omitempty"`
+	// Enables/disables cross zone load balancing
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CrossZoneLoadBalancingEnabled *bool `json:"crossZoneLoadBalancingEnabled,omitempty"`
+	// Enables/disables connection draining
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ConnectionDrainingEnabled *bool `json:"connectionDrainingEnabled,omitempty"`
+	// Sets the timeout for connection draining
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ConnectionDrainingTimeout *int32 `json:"connectionDrainingTimeout,omitempty"`
+	// Sets the healthy threshold for the load balancer
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HealthcheckHealthyThreshold *int32 `json:"healthcheckHealthyThreshold,omitempty"`
+	// Sets the unhealthy threshold for the load balancer
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HealthcheckUnhealthyThreshold *int32 `json:"healthcheckUnhealthyThreshold,omitempty"`
+	// Sets the interval between health checks
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HealthcheckInterval *int32 `json:"healthcheckInterval,omitempty"`
+	// Sets the timeout for the health check
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HealthcheckTimeout *int32 `json:"healthcheckTimeout,omitempty"`
+}
+
+// NetworkLoadBalancerSpec configures the AWS NLB load balancer for the component
+type NetworkLoadBalancerSpec struct {
+	// Enables/disbles use of proxy protocol in the load balancer
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ProxyProtocol *bool `json:"proxyProtocol,omitempty"`
+	// Enables/disables cross zone load balancing
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CrossZoneLoadBalancingEnabled *bool `json:"crossZoneLoadBalancingEnabled,omitempty"`
+	// The list of optional Elastic IPs allocations
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	EIPAllocations []string `json:"eipAllocations,omitempty"`
+	// Optionally specify the load balancer name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LoadBalancerName *string `json:"loadBalancerName,omitempty"`
+	// Deletion protection setting
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+}
+
+type AWSLoadBalancerTargetType string
+
+const (
+	AWSLoadBalancerTargetTypeIP       AWSLoadBalancerTargetType = "ip"
+	AWSLoadBalancerTargetTypeInstance AWSLoadBalancerTargetType = "instance"
+)
+
+type AWSLoadBalancerScheme string
+
+const (
+	AWSLoadBalancerSchemeInternetFacing AWSLoadBalancerScheme = "internet-facing"
+	AWSLoadBalancerSchemeInternal       AWSLoadBalancerScheme = "internal"
+)
+
+// AWSLoadBalancerSpec configures the NLB that the aws-load-balancer-controller
+// provisions for the component
+type AWSLoadBalancerSpec struct {
+	// The type of target the load balancer sends traffic to. With "ip" the
+	// traffic goes directly to the Pod IPs. Defaults to "ip".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=ip;instance
+	// +optional
+	TargetType *AWSLoadBalancerTargetType `json:"targetType,omitempty"`
+	// Whether the load balancer is internet-facing or internal.
+	// Defaults to "internet-facing".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=internet-facing;internal
+	// +optional
+	Scheme *AWSLoadBalancerScheme `json:"scheme,omitempty"`
+	// Enables/disbles use of proxy protocol v2 in the target groups
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ProxyProtocol *bool `json:"proxyProtocol,omitempty"`
+	// Enables/disables cross zone load balancing
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CrossZoneLoadBalancingEnabled *bool `json:"crossZoneLoadBalancingEnabled,omitempty"`
+	// Deletion protection setting
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+	// Optionally specify the load balancer name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LoadBalancerName *string `json:"loadBalancerName,omitempty"`
+	// The list of optional Elastic IPs allocations
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	EIPAllocations []string `json:"eipAllocations,omitempty"`
+	// The security groups attached to the load balancer. The controller
+	// creates a frontend security group if none is specified.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+	// Whether the controller manages the backend security group rules
+	// that allow the load balancer to reach the targets
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ManageBackendSecurityGroupRules *bool `json:"manageBackendSecurityGroupRules,omitempty"`
+	// TLS configures TLS listeners using ACM certificates
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TLS *AWSLoadBalancerTLSSpec `json:"tls,omitempty"`
+	// AccessLogs configures the access logs of the load balancer
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AccessLogs *AWSLoadBalancerAccessLogsSpec `json:"accessLogs,omitempty"`
+	// HealthCheck configures the health checks of the target groups.
+	// The aws-load-balancer-controller defaults are used if unset.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HealthCheck *AWSLoadBalancerHealthCheckSpec `json:"healthCheck,omitempty"`
+	// Sets the time to wait for in-flight requests to complete
+	// before deregistering a target
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DeregistrationDelayTimeout *int32 `json:"deregistrationDelayTimeout,omitempty"`
+	// Whether the client IP is preserved when sending traffic to the targets
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PreserveClientIP *bool `json:"preserveClientIP,omitempty"`
+	// Extra target group attributes, in "key=value" format
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExtraTargetGroupAttributes []string `json:"extraTargetGroupAttributes,omitempty"`
+}
+
+// AWSLoadBalancerTLSSpec configures the TLS listeners of the load balancer
+type AWSLoadBalancerTLSSpec struct {
+	// The ARNs of the ACM certificates of the TLS listeners
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems=1
+	CertificateARNs []string `json:"certificateARNs"`
+	// The Service ports, by name or number, that use TLS
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems=1
+	Ports []string `json:"ports"`
+	// The TLS negotiation policy of the listeners
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+}
+
+// AWSLoadBalancerAccessLogsSpec configures the S3 bucket where
+// the load balancer stores the access logs
+type AWSLoadBalancerAccessLogsSpec struct {
+	// The name of the S3 bucket
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	S3Bucket string `json:"s3Bucket"`
+	// The prefix for the access log objects
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	S3Prefix *string `json:"s3Prefix,omitempty"`
+}
+
+// AWSLoadBalancerHealthCheckSpec configures the health checks of the target groups
+type AWSLoadBalancerHealthCheckSpec struct {
+	// The protocol of the health checks
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=TCP;HTTP;HTTPS
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+	// The port of the health checks, either a port number
+	// or "traffic-port" to use the port of the target
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Port *string `json:"port,omitempty"`
+	// The path of the HTTP/HTTPS health checks
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// Sets the healthy threshold for the load balancer
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HealthyThreshold *int32 `json:"healthyThreshold,omitempty"`
+	// Sets the unhealthy threshold for the load balancer
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	UnhealthyThreshold *int32 `json:"unhealthyThreshold,omitempty"`
+	// Sets the interval between health checks
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Interval *int32 `json:"interval,omitempty"`
+	// Sets the timeout for the health check
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Timeout *int32 `json:"timeout,omitempty"`
+	// The HTTP codes that mark a target as healthy, like "200-399"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SuccessCodes *string `json:"successCodes,omitempty"`
+}
+
+// GrafanaDashboardSpec configures the Grafana Dashboard for the component
+type GrafanaDashboardSpec struct {
+	// Label key used by grafana-operator for dashboard discovery
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SelectorKey *string `json:"selectorKey,omitempty"`
+	// Label value used by grafana-operator for dashboard discovery
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SelectorValue *string `json:"selectorValue,omitempty"`
+}
+
+// Endpoint sets the external endpoint for the component
+type Endpoint struct {
+	// The list of dns records that will point to the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	DNS []string `json:"dns"`
+}
+
+// PodDisruptionBudgetSpec defines the PDB for the component
+type PodDisruptionBudgetSpec struct {
+	// An eviction is allowed if at least "minAvailable" pods selected by
+	// "selector" will still be available after the eviction, i.e. even in the
+	// absence of the evicted pod.  So for example you can prevent all voluntary
+	// evictions by specifying "100%".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// An eviction is allowed if at most "maxUnavailable" pods selected by
+	// "selector" are unavailable after the eviction, i.e. even in absence of
+	// the evicted pod. For example, one can prevent all voluntary evictions
+	// by specifying 0. This is a mutually exclusive setting with "minAvailable".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// HorizontalPodAutoscalerSpec defines the HPA for the component
+type HorizontalPodAutoscalerSpec struct {
+	// Lower limit for the number of replicas to which the autoscaler
+	// can scale down.  It defaults to 1 pod.  minReplicas is allowed to be 0 if the
+	// alpha feature gate HPAScaleToZero is enabled and at least one Object or External
+	// metric is configured.  Scaling is active as long as at least one metric value is
+	// available.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// Upper limit for the number of replicas to which the autoscaler can scale up.
+	// It cannot be less that minReplicas.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// Target resource used to autoscale (cpu/memory)
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=cpu;memory
+	// +optional
+	ResourceName *string `json:"resourceName,omitempty"`
+	// A percentage indicating the target resource consumption used to autoscale
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ResourceUtilization *int32 `json:"resourceUtilization,omitempty"`
+	// Behavior configures the scaling behavior of the target
+	// in both Up and Down directions (scaleUp and scaleDown fields respectively).
+	// If not set, the default HPAScalingRules for scale up and scale down are used.
+	// +optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+	// Additional metrics used to autoscale, like external metrics or custom metrics
+	// served from Prometheus by prometheus-adapter. The number of replicas is the
+	// highest of the ones calculated for each metric, including the target resource.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+}
+
+// KEDAScaledObjectSpec defines a KEDA ScaledObject to autoscale the component. When set,
+// it replaces the HorizontalPodAutoscaler of the component, as KEDA manages its own one.
+type KEDAScaledObjectSpec struct {
+	// Lower limit for the number of replicas to which the autoscaler
+	// can scale down
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// Upper limit for the number of replicas to which the autoscaler can scale up
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// Number of replicas when none of the triggers is active. It must be lower
+	// than minReplicas. If unset, the component does not scale below minReplicas.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	IdleReplicas *int32 `json:"idleReplicas,omitempty"`
+	// Interval in seconds to check each trigger. Defaults to KEDA's default (30s).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+	// Period in seconds to wait after the last trigger reported active before
+	// scaling to idleReplicas. Defaults to KEDA's default (300s).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+	// Behavior configures the scaling behavior of the HorizontalPodAutoscaler
+	// managed by KEDA in both Up and Down directions
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+	// The list of triggers that activate the scaling of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinItems=1
+	Triggers []KEDATriggerSpec `json:"triggers"`
+}
+
+// KEDATriggerSpec defines a KEDA trigger. Exactly one of the scalers must be set.
+type KEDATriggerSpec struct {
+	// Name of the trigger
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// Name of the TriggerAuthentication holding the credentials of the scaler
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AuthenticationRef *string `json:"authenticationRef,omitempty"`
+	// Scales on the length of a Redis list
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RedisListLength *KEDARedisListLengthTrigger `json:"redisListLength,omitempty"`
+	// Scales on the result of a Postgres query
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Postgres *KEDAPostgresTrigger `json:"postgres,omitempty"`
+}
+
+// KEDARedisListLengthTrigger scales on the length of a Redis list, like a sidekiq queue
+type KEDARedisListLengthTrigger struct {
+	// Address of the Redis server, in host:port format
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Address string `json:"address"`
+	// Name of the Redis list
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ListName string `json:"listName"`
+	// Average target length of the list per replica. Defaults to KEDA's default (5).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ListLength *int32 `json:"listLength,omitempty"`
+	// Length of the list above which the trigger is active
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ActivationListLength *int32 `json:"activationListLength,omitempty"`
+	// Index of the Redis database
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DatabaseIndex *int32 `json:"databaseIndex,omitempty"`
+	// Enables TLS to connect to Redis
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	EnableTLS *bool `json:"enableTLS,omitempty"`
+}
+
+// KEDAPostgresTrigger scales on the result of a Postgres query, like the number of pending jobs
+type KEDAPostgresTrigger struct {
+	// Query that returns the metric value
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Query string `json:"query"`
+	// Average target value of the query result per replica
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	TargetQueryValue string `json:"targetQueryValue"`
+	// Value of the query result above which the trigger is active
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ActivationTargetQueryValue *string `json:"activationTargetQueryValue,omitempty"`
+	// Name of an environment variable of the component that holds the
+	// Postgres connection string
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ConnectionFromEnv *string `json:"connectionFromEnv,omitempty"`
+	// Postgres host. Credentials are read from the authenticationRef.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Host *string `json:"host,omitempty"`
+	// Postgres port
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Port *string `json:"port,omitempty"`
+	// Postgres database name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DBName *string `json:"dbName,omitempty"`
+	// Postgres user name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	UserName *string `json:"userName,omitempty"`
+	// SSL mode used to connect to Postgres
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SSLMode *string `json:"sslMode,omitempty"`
+}
+
+// VPAUpdateMode controls when the VerticalPodAutoscaler applies its recommendations
+// +kubebuilder:validation:Enum=Off;Initial;Recreate;Auto
+type VPAUpdateMode string
+
+const (
+	// VPAUpdateModeOff only computes recommendations, which are never applied
+	VPAUpdateModeOff VPAUpdateMode = "Off"
+	// VPAUpdateModeInitial applies the recommendations when the pods are created
+	VPAUpdateModeInitial VPAUpdateMode = "Initial"
+	// VPAUpdateModeRecreate applies the recommendations when the pods are created
+	// and evicts the running pods that deviate significantly from them
+	VPAUpdateModeRecreate VPAUpdateMode = "Recreate"
+	// VPAUpdateModeAuto applies the recommendations using the best update
+	// mechanism available, which currently is the same as Recreate
+	VPAUpdateModeAuto VPAUpdateMode = "Auto"
+)
+
+// VerticalPodAutoscalerSpec defines the VPA for the component
+type VerticalPodAutoscalerSpec struct {
+	// UpdateMode controls when the recommendations are applied to the pods. "Off"
+	// only computes recommendations. Defaults to "Off". Modes other than "Off" are
+	// rejected while the HPA scales on any of the controlled resources.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	UpdateMode *VPAUpdateMode `json:"updateMode,omitempty"`
+	// Lower limit of the recommendations for each container
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+	// Upper limit of the recommendations for each container
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
+	// Resources for which recommendations are computed. Defaults to cpu and memory.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ControlledResources []corev1.ResourceName `json:"controlledResources,omitempty"`
+}
+
+type DeploymentStrategySpec struct {
+	// Type of deployment. Can be "Recreate" or "RollingUpdate". Default is RollingUpdate.
+	// +optional
+	Type appsv1.DeploymentStrategyType `json:"type,omitempty"`
+	// Rolling update config params. Present only if DeploymentStrategyType =
+	// RollingUpdate.
+	// +optional
+	RollingUpdate *appsv1.RollingUpdateDeployment `json:"rollingUpdate,omitempty"`
+}
+
+// PodAntiAffinityPreset selects the pod anti-affinity rules used to spread
+// the pods of a component across nodes and zones
+// +kubebuilder:validation:Enum=Soft;Hard;None
+type PodAntiAffinityPreset string
+
+const (
+	// PodAntiAffinityPresetSoft prefers to schedule the pods of the component
+	// in different nodes and zones
+	PodAntiAffinityPresetSoft PodAntiAffinityPreset = "Soft"
+	// PodAntiAffinityPresetHard requires the pods of the component to be scheduled
+	// in different nodes and prefers to schedule them in different zones
+	PodAntiAffinityPresetHard PodAntiAffinityPreset = "Hard"
+	// PodAntiAffinityPresetNone does not set any pod anti-affinity rule
+	PodAntiAffinityPresetNone PodAntiAffinityPreset = "None"
+)
+
+// SchedulingSpec defines how the pods of the component are scheduled and run
+type SchedulingSpec struct {
+	// TopologySpreadConstraints describes how the pods of the component ought to spread
+	// across topology domains. Constraints without a labelSelector select the pods of
+	// the component. Defaults to spreading the pods across zones whenever possible.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// PodAntiAffinity selects the pod anti-affinity rules of the component. "Soft" prefers to
+	// schedule the pods in different nodes and zones, "Hard" requires them to be scheduled
+	// in different nodes and "None" disables pod anti-affinity. Defaults to "Soft".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PodAntiAffinity *PodAntiAffinityPreset `json:"podAntiAffinity,omitempty"`
+	// If specified, indicates the pod's priority. If not specified, the pod priority
+	// will be default or zero if there is no default.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+	// RuntimeClassName refers to a RuntimeClass object in the node.k8s.io group,
+	// which should be used to run the pods of the component.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+	// SecurityContext holds pod-level security attributes and common container settings.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecurityContext *corev1.PodSecurityContext `json:"securityContext,omitempty"`
+	// ServiceAccountName is the name of the ServiceAccount used to run the pods
+	// of the component. Defaults to the namespace's default ServiceAccount.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+}
+
+// ResourceRequirementsSpec defines the resource requirements for the component
+type ResourceRequirementsSpec struct {
+	// Limits describes the maximum amount of compute resources allowed.
+	// More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Limits corev1.ResourceList `json:"limits,omitempty"`
+	// Requests describes the minimum amount of compute resources required.
+	// If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+	// otherwise to an implementation-defined value.
+	// More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Requests corev1.ResourceList `json:"requests,omitempty"`
+	// Claims lists the names of resources, defined in spec.resourceClaims,
+	// that are used by this container.
+	//
+	// This is an alpha field and requires enabling the
+	// DynamicResourceAllocation feature gate.
+	//
+	// This field is immutable.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +featureGate=DynamicResourceAllocation
+	// +optional
+	Claims []corev1.ResourceClaim `json:"claims,omitempty" protobuf:"bytes,3,opt,name=claims"`
+}
+
+// ExternalSecret is a reference to the ExternalSecret common configuration
+type ExternalSecret struct {
+	// SecretStoreRef defines which SecretStore to use when fetching the secret data
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SecretStoreRef *ExternalSecretSecretStoreReferenceSpec `json:"secretStoreRef,omitempty"`
+	// RefreshInterval is the amount of time before the values reading again from the SecretStore provider (duration)
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// SecretReference is a reference to a secret stored in some secrets engine
+type SecretReference struct {
+	// FromVault is a reference to a secret key/value stored in a Hashicorp Vault
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	FromVault *VaultSecretReference `json:"fromVault,omitempty"`
+	// Override allows to directly specify a string value.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Override *string `json:"override,omitempty"`
+	// FromSeed will try to retrieve the secret value from
+	// the default seed Secret.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	FromSeed *SeedSecretReference `json:"fromSeed,omitempty"`
+}
+
+// VaultSecretReference is a reference to a secret stored in
+// a Hashicorp Vault
+type VaultSecretReference struct {
+	// The Vault path where the secret is located
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Path string `json:"path"`
+	// The Vault key of the secret
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Key string `json:"key"`
+}
+
+// SeedSecretReference represents options to
+// retrieve the secret value from the default seed Secret.
+// There are no configurable options at this point.
+type SeedSecretReference struct{}
+
+// ExternalSecretSecretStoreReferenceSpec is a reference to a secret store
+type ExternalSecretSecretStoreReferenceSpec struct {
+	// The Vault secret store reference name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// The Vault secret store reference kind
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Kind *string `json:"kind,omitempty"`
+}
+
+// BugsnagSpec has configuration for Bugsnag integration
+type BugsnagSpec struct {
+	// Release Stage to identify environment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ReleaseStage *string `json:"releaseStage,omitempty"`
+	// API key
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	APIKey SecretReference `json:"apiKey"`
+}
+
+// AddressSpec allows the definition of an address
+type AddressSpec struct {
+	// Defines the address host
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Host *string `json:"host,omitempty"`
+	// Defines the address port
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+}
+
+// Canary allows the definition of a canary Deployment
+type Canary struct {
+	// SendTraffic controls if traffic is sent to the canary
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	SendTraffic bool `json:"sendTraffic"`
+	// ImageName to use for the canary Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ImageName *string `json:"imageName,omitempty"`
+	// ImageTag to use for the canary Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ImageTag *string `json:"imageTag,omitempty"`
+	// Number of replicas for the canary Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Patches to apply for the canary Deployment. Patches are expected
+	// to be JSON documents as an RFC 6902 patches.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Patches []string `json:"patches,omitempty"`
+	// Weight is the percentage of requests sent to the canary. When set, the
+	// envoy sidecars of the main Deployment split the traffic between the main
+	// and the canary Deployments instead of relying on the Service selector.
+	// Only used by workloads with a Marin3rSidecar publishing strategy, which
+	// ignore SendTraffic when Weight is set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+	// Steps is a schedule that automatically ramps the canary weight. Each
+	// step sets the weight and keeps it for the given duration before moving to
+	// the next one. The weight of the last step is kept once the schedule ends.
+	// Steps take precedence over Weight.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Steps []CanaryStep `json:"steps,omitempty"`
+	// ErrorRateThreshold pauses the step schedule while the error rate
+	// of the requests sent to the canary is above the threshold.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ErrorRateThreshold *CanaryErrorRateThreshold `json:"errorRateThreshold,omitempty"`
+	// Analysis configures the automated analysis of the canary. The canary is
+	// promoted if its metrics stay within the thresholds for the whole interval
+	// and aborted otherwise.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Analysis *CanaryAnalysis `json:"analysis,omitempty"`
+}
+
+// CanaryStep is one of the steps of the canary weight schedule
+type CanaryStep struct {
+	// Weight is the percentage of requests sent to the canary during this step
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+	// Duration of the step
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Format:=duration
+	Duration metav1.Duration `json:"duration"`
+}
+
+// CanaryErrorRateThreshold configures the error rate above which
+// the canary step schedule is paused
+type CanaryErrorRateThreshold struct {
+	// PrometheusURL is the address of the Prometheus server that
+	// scrapes the envoy metrics of the workload
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	PrometheusURL string `json:"prometheusURL"`
+	// MaxErrorRatePercent is the maximum percentage of 5xx responses
+	// returned by the canary
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxErrorRatePercent int32 `json:"maxErrorRatePercent"`
+	// Window is the time window used to compute the error rate.
+	// Defaults to 1m.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Format:=duration
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+}
+
+// CanaryAnalysis configures the automated analysis of a canary. The queries
+// can use the $namespace, $deployment, $main and $interval placeholders, which
+// are replaced by the namespace, the name of the canary Deployment, the name of
+// the main Deployment and the time window used to compute rates. The default
+// queries use the metrics of the envoy sidecars of the canary pods or, for
+// canaries that receive traffic by weight, the metrics of the canary upstream
+// clusters in the envoy sidecars of the main pods. Canaries that do not serve
+// traffic through envoy, like workers, need custom queries, as the canary is
+// aborted if the queries return no data for NoDataChecksLimit consecutive checks.
+type CanaryAnalysis struct {
+	// PrometheusURL is the address of the Prometheus server
+	// that scrapes the metrics of the canary
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	PrometheusURL string `json:"prometheusURL"`
+	// Interval is the time during which the canary is analysed
+	// before a decision is made
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Format:=duration
+	Interval metav1.Duration `json:"interval"`
+	// MinSuccessRatePercent is the minimum percentage of non 5xx
+	// responses returned by the canary
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MinSuccessRatePercent *int32 `json:"minSuccessRatePercent,omitempty"`
+	// MaxLatencyMilliseconds is the maximum 99th percentile
+	// latency of the responses returned by the canary
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxLatencyMilliseconds *int32 `json:"maxLatencyMilliseconds,omitempty"`
+	// FailedChecksLimit is the number of failed checks after which
+	// the canary is aborted. Defaults to 1.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FailedChecksLimit *int32 `json:"failedChecksLimit,omitempty"`
+	// NoDataChecksLimit is the number of consecutive checks without
+	// metrics after which the canary is aborted. Defaults to 10.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	NoDataChecksLimit *int32 `json:"noDataChecksLimit,omitempty"`
+	// SuccessRateQuery overrides the query used to compute
+	// the success rate percentage of the canary
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SuccessRateQuery *string `json:"successRateQuery,omitempty"`
+	// LatencyQuery overrides the query used to compute
+	// the latency of the canary in milliseconds
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LatencyQuery *string `json:"latencyQuery,omitempty"`
+}
+
+type WorkloadStatus struct {
+	// HealthStatus holds the status of the individual workload
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	HealthStatus string `json:"healthStatus,omitempty"`
+	// HealthMessage holds the message describing the health status
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	HealthMessage string `json:"healthMessage,omitempty"`
+	// DeploymentStatus is a copy of the status of the owned Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	DeploymentStatus *appsv1.DeploymentStatus `json:"deploymentStatus,omitempty"`
+	// StatefulSetStatus is a copy of the status of the owned Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	StatefulSetStatus *appsv1.StatefulSetStatus `json:"statefulsetStatus,omitempty"`
+	// ResourceRecommendations holds the recommendations of the VerticalPodAutoscaler
+	// of the workload, next to the configured resource requests of each container
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	ResourceRecommendations []ContainerResourceRecommendation `json:"resourceRecommendations,omitempty"`
+}
+
+// ContainerResourceRecommendation reports the resources recommended for a container
+type ContainerResourceRecommendation struct {
+	// Container is the name of the container
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Container string `json:"container"`
+	// Requests are the resource requests configured for the container
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Requests corev1.ResourceList `json:"requests,omitempty"`
+	// Target is the recommended amount of resources
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Target corev1.ResourceList `json:"target,omitempty"`
+	// LowerBound is the minimum recommended amount of resources
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	LowerBound corev1.ResourceList `json:"lowerBound,omitempty"`
+	// UpperBound is the maximum recommended amount of resources
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	UpperBound corev1.ResourceList `json:"upperBound,omitempty"`
+}
+
+type AggregatedStatus struct {
+	// Health is the overall health of the custom resource
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Health string `json:"health,omitempty"`
+	// OwnedWorkloads is a map with the health statuses of individual owned workloads
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	OwnedWorkloads map[string]*WorkloadStatus `json:"ownedWorkloads,omitempty"`
+	// Canaries holds the status of the weighted canaries of the custom resource
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Canaries map[string]*CanaryStatus `json:"canaries,omitempty"`
+	// CanaryAnalyses holds the status of the canary analyses of the custom
+	// resource, indexed by the name of the main Deployment. The last decision
+	// is kept after the canary is removed.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	CanaryAnalyses map[string]*CanaryAnalysisStatus `json:"canaryAnalyses,omitempty"`
+	// Endpoints lists the endpoints of the workloads and where they are published
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Endpoints []EndpointStatus `json:"endpoints,omitempty"`
+	// PendingDeletions lists the resources that are no longer generated but
+	// whose deletion is blocked by the deletion protection
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	PendingDeletions []PendingDeletionStatus `json:"pendingDeletions,omitempty"`
+	// Conditions represent the latest available observations of the custom resource
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// EndpointStatus reports where an endpoint of a workload is published
+type EndpointStatus struct {
+	// Workload is the name of the Deployment that serves the endpoint
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Workload string `json:"workload"`
+	// Name of the endpoint
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Name string `json:"name"`
+	// Strategy is the publishing strategy of the endpoint
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Strategy Strategy `json:"strategy"`
+	// Service is the name of the Service that publishes the endpoint
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Service string `json:"service"`
+	// Ports of the Service
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Ports []EndpointPortStatus `json:"ports,omitempty"`
+	// Addresses are the hostnames or IPs of the load balancers, Ingresses
+	// or Routes that publish the endpoint. Empty until they are provisioned.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
+	// Hostnames are the external DNS hostnames of the endpoint. They are only
+	// reported once the endpoint has addresses to check their resolution against,
+	// so they are never reported for Gateway API routes.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Hostnames []EndpointHostnameStatus `json:"hostnames,omitempty"`
+}
+
+// EndpointPortStatus is a port of a published endpoint
+type EndpointPortStatus struct {
+	// Name of the port
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Port number
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Port int32 `json:"port"`
+	// Protocol of the port
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Protocol corev1.Protocol `json:"protocol,omitempty"`
+}
+
+// EndpointHostnameStatus reports whether an external DNS hostname of an endpoint
+// resolves to the addresses the endpoint is published at
+type EndpointHostnameStatus struct {
+	// Hostname is the external DNS hostname
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Hostname string `json:"hostname"`
+	// Resolves is true when the hostname resolves to
+	// any of the addresses of the endpoint
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Resolves bool `json:"resolves"`
+}
+
+// PendingDeletionStatus is a resource whose deletion is blocked
+type PendingDeletionStatus struct {
+	// Kind of the resource
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Kind string `json:"kind"`
+	// Name of the resource
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Name string `json:"name"`
+	// Message explains how to unblock the deletion
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Message string `json:"message"`
+}
+
+// CanaryStatus holds the progress of the step schedule of a weighted canary
+type CanaryStatus struct {
+	// Revision identifies the canary version the schedule applies to. The
+	// schedule restarts when the canary image or patches change.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Revision string `json:"revision"`
+	// Weight is the percentage of requests currently sent to the canary
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Weight int32 `json:"weight"`
+	// Step is the index of the current step of the schedule
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Step int32 `json:"step"`
+	// StepStartTime is the time the current step started
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	StepStartTime metav1.Time `json:"stepStartTime"`
+	// Paused is true while the error rate is above the threshold
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+	// Message describes the reason the schedule is paused
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type CanaryAnalysisPhase string
+
+const (
+	// CanaryAnalysisProgressing is used while the canary is being analysed
+	CanaryAnalysisProgressing CanaryAnalysisPhase = "Progressing"
+	// CanaryAnalysisSucceeded is used when the analysis passes but the canary
+	// cannot be promoted automatically because it patches the spec
+	CanaryAnalysisSucceeded CanaryAnalysisPhase = "Succeeded"
+	// CanaryAnalysisPromoted is used when the canary image has
+	// been promoted to the main Deployment
+	CanaryAnalysisPromoted CanaryAnalysisPhase = "Promoted"
+	// CanaryAnalysisAborted is used when the canary does not pass
+	// the analysis and has been scaled to zero
+	CanaryAnalysisAborted CanaryAnalysisPhase = "Aborted"
+)
+
+// CanaryAnalysisStatus holds the progress and the decision of a canary analysis
+type CanaryAnalysisStatus struct {
+	// Canary is the name of the canary Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Canary string `json:"canary"`
+	// Revision identifies the canary spec that is analysed. Any change
+	// to the canary spec starts a new analysis. It is cleared once the
+	// canary is removed from the spec, so adding the same canary again
+	// also starts a new analysis.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Revision string `json:"revision,omitempty"`
+	// Phase of the analysis
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Phase CanaryAnalysisPhase `json:"phase"`
+	// StartTime is the time the analysis started
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	StartTime metav1.Time `json:"startTime"`
+	// DecisionTime is the time the canary was promoted or aborted
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	DecisionTime *metav1.Time `json:"decisionTime,omitempty"`
+	// LastCheckTime is the time the metrics of the canary were last checked
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+	// Checks is the number of checks that returned metrics
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Checks int32 `json:"checks,omitempty"`
+	// FailedChecks is the number of checks with metrics above the thresholds
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	FailedChecks int32 `json:"failedChecks,omitempty"`
+	// NoDataChecks is the number of consecutive checks without metrics
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	NoDataChecks int32 `json:"noDataChecks,omitempty"`
+	// SuccessRate is the last success rate percentage of the canary
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	SuccessRate string `json:"successRate,omitempty"`
+	// Latency is the last latency of the canary
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Latency string `json:"latency,omitempty"`
+	// Message describes the last decision or check
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Message string `json:"message,omitempty"`
+}
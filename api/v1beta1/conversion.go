@@ -0,0 +1,355 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// convert copies src into dst through their JSON representation. The v1beta1
+// types share the JSON representation of their v1alpha1 counterparts except
+// for the fields that v1beta1 renames, which the callers have to copy over.
+func convert(src, dst any) error {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return fmt.Errorf("unable to convert %T: %w", src, err)
+	}
+
+	if err := json.Unmarshal(b, dst); err != nil {
+		return fmt.Errorf("unable to convert %T into %T: %w", src, dst, err)
+	}
+
+	return nil
+}
+
+// convertTo copies the fields renamed in v1beta1 into the hub version
+func (src *TwemproxySpec) convertTo(dst *v1alpha1.TwemproxySpec) {
+	if src == nil || src.Options == nil {
+		return
+	}
+
+	dst.Options.MetricsPort = src.Options.MetricsPort
+}
+
+// convertFrom copies the fields renamed in v1beta1 from the hub version
+func (dst *TwemproxySpec) convertFrom(src *v1alpha1.TwemproxySpec) {
+	if src == nil || src.Options == nil {
+		return
+	}
+
+	dst.Options.MetricsPort = src.Options.MetricsPort
+}
+
+// ConvertTo converts this Apicast to the hub version
+func (src *Apicast) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.Apicast)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertFrom converts the hub version to this Apicast
+func (dst *Apicast) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.Apicast)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertTo converts this AutoSSL to the hub version
+func (src *AutoSSL) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.AutoSSL)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertFrom converts the hub version to this AutoSSL
+func (dst *AutoSSL) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.AutoSSL)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertTo converts this Backend to the hub version
+func (src *Backend) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.Backend)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	src.Spec.Twemproxy.convertTo(dst.Spec.Twemproxy)
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertFrom converts the hub version to this Backend
+func (dst *Backend) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.Backend)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	dst.Spec.Twemproxy.convertFrom(src.Spec.Twemproxy)
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertTo converts this CORSProxy to the hub version
+func (src *CORSProxy) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.CORSProxy)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertFrom converts the hub version to this CORSProxy
+func (dst *CORSProxy) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.CORSProxy)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertTo converts this EchoAPI to the hub version
+func (src *EchoAPI) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.EchoAPI)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertFrom converts the hub version to this EchoAPI
+func (dst *EchoAPI) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.EchoAPI)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertTo converts this MappingService to the hub version
+func (src *MappingService) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.MappingService)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertFrom converts the hub version to this MappingService
+func (dst *MappingService) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.MappingService)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertTo converts this RedisShard to the hub version
+func (src *RedisShard) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.RedisShard)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertFrom converts the hub version to this RedisShard
+func (dst *RedisShard) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.RedisShard)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertTo converts this Sentinel to the hub version
+func (src *Sentinel) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.Sentinel)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertFrom converts the hub version to this Sentinel
+func (dst *Sentinel) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.Sentinel)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertTo converts this ShardedRedisBackup to the hub version
+func (src *ShardedRedisBackup) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.ShardedRedisBackup)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertFrom converts the hub version to this ShardedRedisBackup
+func (dst *ShardedRedisBackup) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.ShardedRedisBackup)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertTo converts this System to the hub version
+func (src *System) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.System)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	src.Spec.Twemproxy.convertTo(dst.Spec.Twemproxy)
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertFrom converts the hub version to this System
+func (dst *System) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.System)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	dst.Spec.Twemproxy.convertFrom(src.Spec.Twemproxy)
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertTo converts this TwemproxyConfig to the hub version
+func (src *TwemproxyConfig) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.TwemproxyConfig)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertFrom converts the hub version to this TwemproxyConfig
+func (dst *TwemproxyConfig) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.TwemproxyConfig)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertTo converts this Zync to the hub version
+func (src *Zync) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.Zync)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
+
+// ConvertFrom converts the hub version to this Zync
+func (dst *Zync) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.Zync)
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convert(src.Spec, &dst.Spec); err != nil {
+		return err
+	}
+
+	return convert(src.Status, &dst.Status)
+}
@@ -0,0 +1,148 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/randfill"
+)
+
+const fuzzIterations = 50
+
+// filler returns a randfill.Filler that only generates values that
+// survive the serialization of the objects to JSON
+func filler(seed int64) *randfill.Filler {
+	return randfill.NewWithSeed(seed).NilChance(.3).NumElements(1, 2).Funcs(
+		func(obj *metav1.TypeMeta, c randfill.Continue) {
+			// the TypeMeta is owned by the conversion webhook
+			*obj = metav1.TypeMeta{}
+		},
+		func(t *metav1.Time, c randfill.Continue) {
+			*t = metav1.Unix(c.Int63n(1<<32), 0).Rfc3339Copy()
+		},
+		func(q *resource.Quantity, c randfill.Continue) {
+			*q = *resource.NewQuantity(c.Int63n(1<<20), resource.DecimalSI)
+		},
+		func(v *intstr.IntOrString, c randfill.Continue) {
+			if c.Bool() {
+				*v = intstr.FromInt32(c.Int31())
+			} else {
+				*v = intstr.FromString(c.String(0))
+			}
+		},
+		func(raw *runtime.RawExtension, c randfill.Continue) {
+			*raw = runtime.RawExtension{Raw: []byte(fmt.Sprintf(`{"value":%d}`, c.Int63()))}
+		},
+		func(config *v1alpha1.EnvoyDynamicConfig, c randfill.Continue) {
+			c.FillNoCustom(config)
+			// the name is populated from the map key
+			config.Name = ""
+		},
+		func(config *EnvoyDynamicConfig, c randfill.Continue) {
+			c.FillNoCustom(config)
+			// the name is populated from the map key
+			config.Name = ""
+		},
+	)
+}
+
+type convertible interface {
+	runtime.Object
+	conversion.Convertible
+}
+
+type hub interface {
+	runtime.Object
+	conversion.Hub
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		hub   func() hub
+		spoke func() convertible
+	}{
+		{hub: func() hub { return &v1alpha1.Apicast{} }, spoke: func() convertible { return &Apicast{} }},
+		{hub: func() hub { return &v1alpha1.AutoSSL{} }, spoke: func() convertible { return &AutoSSL{} }},
+		{hub: func() hub { return &v1alpha1.Backend{} }, spoke: func() convertible { return &Backend{} }},
+		{hub: func() hub { return &v1alpha1.CORSProxy{} }, spoke: func() convertible { return &CORSProxy{} }},
+		{hub: func() hub { return &v1alpha1.EchoAPI{} }, spoke: func() convertible { return &EchoAPI{} }},
+		{hub: func() hub { return &v1alpha1.MappingService{} }, spoke: func() convertible { return &MappingService{} }},
+		{hub: func() hub { return &v1alpha1.RedisShard{} }, spoke: func() convertible { return &RedisShard{} }},
+		{hub: func() hub { return &v1alpha1.Sentinel{} }, spoke: func() convertible { return &Sentinel{} }},
+		{hub: func() hub { return &v1alpha1.ShardedRedisBackup{} }, spoke: func() convertible { return &ShardedRedisBackup{} }},
+		{hub: func() hub { return &v1alpha1.System{} }, spoke: func() convertible { return &System{} }},
+		{hub: func() hub { return &v1alpha1.TwemproxyConfig{} }, spoke: func() convertible { return &TwemproxyConfig{} }},
+		{hub: func() hub { return &v1alpha1.Zync{} }, spoke: func() convertible { return &Zync{} }},
+	}
+	for _, tt := range tests {
+		name := fmt.Sprintf("%T", tt.spoke())[len("*v1beta1."):]
+
+		t.Run(name+" hub-spoke-hub", func(t *testing.T) {
+			f := filler(0)
+			for range fuzzIterations {
+				src := tt.hub()
+				f.Fill(src)
+
+				spoke := tt.spoke()
+				if err := spoke.ConvertFrom(src); err != nil {
+					t.Fatalf("ConvertFrom() error = %v", err)
+				}
+				got := tt.hub()
+				if err := spoke.ConvertTo(got); err != nil {
+					t.Fatalf("ConvertTo() error = %v", err)
+				}
+				if diff := cmp.Diff(src, got); len(diff) > 0 {
+					t.Fatalf("round trip got diff %v", diff)
+				}
+			}
+		})
+
+		t.Run(name+" spoke-hub-spoke", func(t *testing.T) {
+			f := filler(0)
+			for range fuzzIterations {
+				src := tt.spoke()
+				f.Fill(src)
+
+				hub := tt.hub()
+				if err := src.ConvertTo(hub); err != nil {
+					t.Fatalf("ConvertTo() error = %v", err)
+				}
+				got := tt.spoke()
+				if err := got.ConvertFrom(hub); err != nil {
+					t.Fatalf("ConvertFrom() error = %v", err)
+				}
+				if diff := cmp.Diff(src, got); len(diff) > 0 {
+					t.Fatalf("round trip got diff %v", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertRenamedFields(t *testing.T) {
+	hub := &v1alpha1.System{Spec: v1alpha1.SystemSpec{
+		Twemproxy: &v1alpha1.TwemproxySpec{Options: &v1alpha1.TwemproxyOptions{MetricsPort: ptr.To[int32](9999)}},
+	}}
+
+	spoke := &System{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+
+	got, err := json.Marshal(spoke.Spec.Twemproxy.Options)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `{"metricsPort":9999}`; string(got) != want {
+		t.Errorf("ConvertFrom() got twemproxy options %s, want %s", got, want)
+	}
+}
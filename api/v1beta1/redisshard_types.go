@@ -0,0 +1,149 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RedisShardSpec defines the desired state of RedisShard
+type RedisShardSpec struct {
+	// Image specification for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *ImageSpec `json:"image,omitempty"`
+	// MasterIndex is the StatefulSet Pod index of the redis server
+	// with the master role. The other Pods are slaves of the master one.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MasterIndex *int32 `json:"masterIndex,omitempty"`
+	// SlaveCount is the number of redis slaves
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SlaveCount *int32 `json:"slaveCount,omitempty"`
+	// Command overrides the redis container command
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Command *string `json:"command,omitempty"`
+	// StorageClass is the storage class to be used for the redis
+	// data volume. Only used if StorageSize is set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	StorageClass *string `json:"storageClass,omitempty"`
+	// StorageSize is the storage size to provision for the redis data
+	// volume of each redis server. If unset, an ephemeral emptyDir volume
+	// is used instead. Note that the StatefulSet volume claim templates are
+	// immutable, so this cannot be changed once the shard has been created.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	StorageSize *resource.Quantity `json:"storageSize,omitempty"`
+	// Config is a map of redis.conf directives that are added to the
+	// redis servers configuration file. The 'slaveof' and 'replicaof' directives
+	// are managed by the operator and cannot be set. Changes are only picked up
+	// by the redis servers when their Pods are restarted.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+	// Pod Disruption Budget for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PDB *PodDisruptionBudgetSpec `json:"pdb,omitempty"`
+	// Resource requirements for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Describes node affinity scheduling rules for the pod.
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
+	// If specified, the pod's tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+}
+
+type RedisShardNodes struct {
+	// Master is the node that acts as master role in the redis shard
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Master map[string]string `json:"master,omitempty"`
+	// Slaves are the nodes that act as master role in the redis shard
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Slaves map[string]string `json:"slaves,omitempty"`
+}
+
+// RedisShardStatus defines the observed state of RedisShard
+type RedisShardStatus struct {
+	AggregatedStatus `json:",inline"`
+	// ShardNodes describes the nodes in the redis shard
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	ShardNodes *RedisShardNodes `json:"shardNodes,omitempty"`
+	// Replication reports the status of the replication link
+	// of each slave in the redis shard, keyed by the slave alias
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Replication map[string]RedisShardReplicationStatus `json:"replication,omitempty"`
+}
+
+// RedisShardReplicationStatus describes the replication link of a slave
+type RedisShardReplicationStatus struct {
+	// Master is the address of the master the slave replicates from
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Master string `json:"master,omitempty"`
+	// LinkStatus is the status of the replication link with the master
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	LinkStatus string `json:"linkStatus,omitempty"`
+	// SyncInProgress is true while the slave is performing a full sync
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	SyncInProgress bool `json:"syncInProgress,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// RedisShard is the Schema for the redisshards API
+// +kubebuilder:printcolumn:JSONPath=".status.shardNodes.master",name=Master,type=string
+// +kubebuilder:printcolumn:JSONPath=".status.shardNodes.slaves",name=Slaves,type=string
+type RedisShard struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RedisShardSpec   `json:"spec,omitempty"`
+	Status RedisShardStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RedisShardList contains a list of RedisShard
+type RedisShardList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RedisShard `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RedisShard{}, &RedisShardList{})
+}
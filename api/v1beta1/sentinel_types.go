@@ -0,0 +1,181 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"time"
+
+	"github.com/3scale-sre/saas-operator/internal/pkg/redis/client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SentinelConfig defines configuration options for the component
+type SentinelConfig struct {
+	// Monitored shards indicates the redis servers that form
+	// part of each shard monitored by sentinel
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MonitoredShards map[string][]string `json:"monitoredShards"`
+	// ClusterTopology indicates the redis servers that form
+	// part of each shard monitored by sentinel
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ClusterTopology map[string]map[string]string `json:"clusterTopology"`
+	// RedisShardRefs is a list of names of RedisShard resources, in the same
+	// namespace, that sentinel should monitor. The redis servers of each shard are
+	// obtained from the RedisShard status and kept up to date automatically.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RedisShardRefs []string `json:"redisShardRefs,omitempty"`
+	// RedisShardSelector selects the RedisShard resources, in the same namespace,
+	// that sentinel should monitor, in addition to the ones in RedisShardRefs.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RedisShardSelector *metav1.LabelSelector `json:"redisShardSelector,omitempty"`
+	// StorageClass is the storage class to be used for
+	// the persistent sentinel config file where the shards
+	// state is stored
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	StorageClass *string `json:"storageClass,omitempty"`
+	// StorageSize is the storage size to  provision for
+	// the persistent sentinel config file where the shards
+	// state is stored
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	StorageSize *resource.Quantity `json:"storageSize,omitempty"`
+	// MetricsRefreshInterval determines the refresh interval for gahtering
+	// metrics from sentinel
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MetricsRefreshInterval *time.Duration `json:"metricsRefreshInterval,omitempty"`
+}
+
+// SentinelSpec defines the desired state of Sentinel
+type SentinelSpec struct {
+	// Image specification for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *ImageSpec `json:"image,omitempty"`
+	// Number of replicas (ignored if hpa is enabled) for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Pod Disruption Budget for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PDB *PodDisruptionBudgetSpec `json:"pdb,omitempty"`
+	// Resource requirements for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Liveness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LivenessProbe *ProbeSpec `json:"livenessProbe,omitempty"`
+	// Readiness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ReadinessProbe *ProbeSpec `json:"readinessProbe,omitempty"`
+	// Configures the Grafana Dashboard for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Describes node affinity scheduling rules for the pod.
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
+	// If specified, the pod's tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+	// Config configures the sentinel process
+	Config *SentinelConfig `json:"config"`
+}
+
+// SentinelStatus defines the observed state of Sentinel
+type SentinelStatus struct {
+	AggregatedStatus `json:",inline"`
+	// Addresses of the sentinel instances currently running
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Sentinels []string `json:"sentinels,omitempty"`
+	// MonitoredShards is the list of shards that the Sentinel
+	// resource is currently monitoring
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	MonitoredShards MonitoredShards `json:"monitoredShards,omitempty"`
+}
+
+type MonitoredShards []MonitoredShard
+
+// MonitoredShard contains information of one of the shards
+// monitored by the Sentinel resource
+type MonitoredShard struct {
+	// Name is the name of the redis shard
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Name string `json:"name"`
+	// Server is a map intended to store configuration information
+	// of each of the RedisServer instances that belong to the MonitoredShard
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Servers map[string]RedisServerDetails `json:"servers,omitempty"`
+}
+
+type RedisServerDetails struct {
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Role client.Role `json:"role"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Address string `json:"address,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	Info map[string]string `json:"info,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".status.sentinels",name=Sentinels,type=string
+// +kubebuilder:printcolumn:JSONPath=".status.monitoredShards",name=Shards,type=string
+// Sentinel is the Schema for the sentinels API
+type Sentinel struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SentinelSpec   `json:"spec,omitempty"`
+	Status SentinelStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SentinelList contains a list of Sentinel
+type SentinelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Sentinel `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Sentinel{}, &SentinelList{})
+}
@@ -0,0 +1,661 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SystemSpec defines the desired state of System
+type SystemSpec struct {
+	// Application specific configuration options for System components
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Config SystemConfig `json:"config"`
+	// Image specification for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *ImageSpec `json:"image,omitempty"`
+	// Application specific configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	App *SystemAppSpec `json:"app,omitempty"`
+	// Sidekiq Default specific configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SidekiqDefault *SystemSidekiqSpec `json:"sidekiqDefault,omitempty"`
+	// Sidekiq Billing specific configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SidekiqBilling *SystemSidekiqSpec `json:"sidekiqBilling,omitempty"`
+	// Sidekiq Low specific configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SidekiqLow *SystemSidekiqSpec `json:"sidekiqLow,omitempty"`
+	// Searchd specific configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Searchd *SystemSearchdSpec `json:"searchd,omitempty"`
+	// Console specific configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Console *SystemRailsConsoleSpec `json:"console,omitempty"`
+	// Configures the Tekton Tasks for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Tasks []SystemTektonTaskSpec `json:"tasks,omitempty"`
+	// Configures the Grafana Dashboard for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// Configures twemproxy
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Twemproxy *TwemproxySpec `json:"twemproxy,omitempty"`
+}
+
+type SearchServerSpec struct {
+	AddressSpec `json:",inline"`
+	// Defines the batch size
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	BatchSize *int32 `json:"batchSize,omitempty"`
+}
+
+// SystemConfig holds configuration for SystemApp component
+type SystemConfig struct {
+	// Rails configuration options for system components
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Rails *SystemRailsSpec `json:"rails,omitempty"`
+	// OpenSSL verification mode for sandbox proxy
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SandboxProxyOpensslVerifyMode *string `json:"sandboxProxyOpensslVerifyMode,omitempty"`
+	// Enable (true) or disable (false) enforcing SSL
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ForceSSL *bool `json:"forceSSL,omitempty"`
+	// SSL certificates path
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SSLCertsDir *string `json:"sslCertsDir,omitempty"`
+	// Search service options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SearchServer SearchServerSpec `json:"searchServer,omitempty"`
+	// 3scale provider plan
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ThreescaleProviderPlan *string `json:"threescaleProviderPlan,omitempty"`
+	// 3scale superdomain
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ThreescaleSuperdomain *string `json:"threescaleSuperdomain,omitempty"`
+	// Secret containging system configuration files to be mounted in the pods
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ConfigFilesSecret *string `json:"configFilesSecret,omitempty"`
+	// External Secret common configuration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExternalSecret ExternalSecret `json:"externalSecret,omitempty"`
+	// DSN of system's main database
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	DatabaseDSN SecretReference `json:"databaseDSN"`
+	// EventsSharedSecret is a password that protects System's event
+	// hooks endpoint.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	EventsSharedSecret SecretReference `json:"eventsSharedSecret"`
+	// Holds recaptcha configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Recaptcha SystemRecaptchaSpec `json:"recaptcha"`
+	// SecretKeyBase: https://api.rubyonrails.org/classes/Rails/Application.html#method-i-secret_key_base
+	// You can generate one random key using 'bundle exec rake secret'
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	SecretKeyBase SecretReference `json:"secretKeyBase"`
+	// AccessCode to protect admin urls
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AccessCode *SecretReference `json:"accessCode,omitempty"`
+	// Options for Segment integration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Segment SegmentSpec `json:"segment"`
+	// Options for Github integration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Github GithubSpec `json:"github"`
+	// Options for configuring RH Customer Portal integration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	RedHatCustomerPortal RedHatCustomerPortalSpec `json:"redhatCustomerPortal"`
+	// Options for configuring Bugsnag integration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Bugsnag *BugsnagSpec `json:"bugsnag,omitempty"`
+	// DatabaseSecret is a site key stored off-database for improved more secure password hashing
+	// See https://github.com/3scale/porta/blob/ae498814cef3d856613f60d29330882fa870271d/config/initializers/site_keys.rb#L2-L19
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	DatabaseSecret SecretReference `json:"databaseSecret"`
+	// Memcached servers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	MemcachedServers string `json:"memcachedServers"`
+	// Redis configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Redis RedisSpec `json:"redis"`
+	// SMTP configuration options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	SMTP SMTPSpec `json:"smtp"`
+	// Mapping Service access token
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	MappingServiceAccessToken SecretReference `json:"mappingServiceAccessToken"`
+	// Zync has configuration options for system to contact zync
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Zync SystemZyncSpec `json:"zync,omitempty"`
+	// Backend has configuration options for system to contact backend
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Backend SystemBackendSpec `json:"backend"`
+	// Assets has configuration to access assets in AWS s3
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Assets AssetsSpec `json:"assets"`
+	// Apicast can be used to pass down apicast endpoints configuration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Apicast *SystemApicastEndpointsSpec `json:"apicast,omitempty"`
+}
+
+// SystemRecaptchaSpec holds recaptcha configurations
+type SystemRecaptchaSpec struct {
+	// Public key
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	PublicKey SecretReference `json:"publicKey"`
+	// Private key
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	PrivateKey SecretReference `json:"privateKey"`
+}
+
+// SegmentSpec has configuration for Segment integration
+type SegmentSpec struct {
+	// Deletion workspace
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	DeletionWorkspace string `json:"deletionWorkspace"`
+	// Deletion token
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	DeletionToken SecretReference `json:"deletionToken"`
+	// Write key
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	WriteKey SecretReference `json:"writeKey"`
+}
+
+// GithubSpec has configuration for Github integration
+type GithubSpec struct {
+	// Client ID
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ClientID SecretReference `json:"clientID"`
+	// Client secret
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ClientSecret SecretReference `json:"clientSecret"`
+}
+
+// RedHatCustomerPortalSpec has configuration for integration with
+// Red Hat Customer Portal
+type RedHatCustomerPortalSpec struct {
+	// Client ID
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ClientID SecretReference `json:"clientID"`
+	// Client secret
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ClientSecret SecretReference `json:"clientSecret"`
+	// Realm
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Realm *string `json:"realm,omitempty"`
+}
+
+// RedisSpec holds redis configuration
+type RedisSpec struct {
+	// Data source name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	QueuesDSN string `json:"queuesDSN"`
+}
+
+// SMTPSpec has options to configure system's SMTP
+type SMTPSpec struct {
+	// Address
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Address string `json:"address"`
+	// User
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	User SecretReference `json:"user"`
+	// Password
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Password SecretReference `json:"password"`
+	// Port
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Port int32 `json:"port"`
+	// Authentication protocol
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	AuthProtocol string `json:"authProtocol"`
+	// OpenSSL verify mode
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	OpenSSLVerifyMode string `json:"opensslVerifyMode"`
+	// Enable/disable STARTTLS
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	STARTTLS *bool `json:"starttls,omitempty"`
+	// Enable/disable auto STARTTLS
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	STARTTLSAuto *bool `json:"starttlsAuto,omitempty"`
+}
+
+// SystemZyncSpec has configuration options for zync
+type SystemZyncSpec struct {
+	// Zync authentication token
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	AuthToken SecretReference `json:"authToken"`
+	// Zync endpoint
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Endpoint string `json:"endpoint"`
+}
+
+// SystemBackendSpec has configuration options for backend
+type SystemBackendSpec struct {
+	// External endpoint
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ExternalEndpoint string `json:"externalEndpoint"`
+	// Internal endpoint
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	InternalEndpoint string `json:"internalEndpoint"`
+	// Internal API user
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	InternalAPIUser SecretReference `json:"internalAPIUser"`
+	// Internal API password
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	InternalAPIPassword SecretReference `json:"internalAPIPassword"`
+	// Redis data source name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	RedisDSN string `json:"redisDSN"`
+}
+
+// AssetsSpec has configuration to access assets in AWS s3
+type AssetsSpec struct {
+	// AWS S3 bucket name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Bucket string `json:"bucket"`
+	// AWS S3 region
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Region string `json:"region"`
+	// AWS access key
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	AccessKey SecretReference `json:"accessKey"`
+	// AWS secret access key
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	SecretKey SecretReference `json:"secretKey"`
+	// Assets host (CDN)
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Host *string `json:"host,omitempty"`
+	// Assets custom S3 endpoint
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	S3Endpoint *string `json:"s3Endpoint,omitempty"`
+}
+
+// SystemRailsSpec configures rails for system components
+type SystemRailsSpec struct {
+	// Rails Console
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Console *bool `json:"console,omitempty"`
+	// Rails environment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Environment *string `json:"environment,omitempty"`
+	// Rails log level (debug, info, warn, error, fatal or unknown)
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=debug;info;warn;error;fatal;unknown
+	// +optional
+	LogLevel *string `json:"logLevel,omitempty"`
+}
+
+// ApicastSpec holds properties to configure Apicast endpoints
+type SystemApicastEndpointsSpec struct {
+	// Apicast Staging endpoint
+	StagingDomain string `json:"stagingDomain"`
+	// Apicast Production endpoint
+	ProductionDomain string `json:"productionDomain"`
+	// Policies registry URL for Apicast Cloud Hosteed
+	CloudHostedRegistryURL string `json:"cloudHostedRegistryURL"`
+	// Policies registry URL for Apicast Self Managed (on-prem)
+	SelfManagedRegistryURL string `json:"selfManagedRegistryURL"`
+}
+
+// SystemAppSpec configures the App component of System
+type SystemAppSpec struct {
+	// The deployment strategy to use to replace existing pods with new ones.
+	// +optional
+	// +patchStrategy=retainKeys
+	DeploymentStrategy *DeploymentStrategySpec `json:"deploymentStrategy,omitempty"`
+	// Pod Disruption Budget for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PDB *PodDisruptionBudgetSpec `json:"pdb,omitempty"`
+	// Horizontal Pod Autoscaler for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// Number of replicas (ignored if hpa is enabled) for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resource requirements for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
+	// Liveness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LivenessProbe *ProbeSpec `json:"livenessProbe,omitempty"`
+	// Readiness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ReadinessProbe *ProbeSpec `json:"readinessProbe,omitempty"`
+	// Describes node affinity scheduling rules for the pod.
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
+	// If specified, the pod's tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+	// Configures the TerminationGracePeriodSeconds
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// Canary defines spec changes for the canary Deployment. If
+	// left unset the canary Deployment wil not be created.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Canary *Canary `json:"canary,omitempty"`
+	// Describes how the services provided by this workload are exposed to its consumers
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PublishingStrategies *PublishingStrategies `json:"publishingStrategies,omitempty"`
+}
+
+// SystemSidekiqSpec configures the Sidekiq component of System
+type SystemSidekiqSpec struct {
+	// The deployment strategy to use to replace existing pods with new ones.
+	// +optional
+	// +patchStrategy=retainKeys
+	DeploymentStrategy *DeploymentStrategySpec `json:"deploymentStrategy,omitempty"`
+	// Sidekiq specific configuration options for the component element
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Config *SidekiqConfig `json:"config,omitempty"`
+	// Pod Disruption Budget for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	PDB *PodDisruptionBudgetSpec `json:"pdb,omitempty"`
+	// Horizontal Pod Autoscaler for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	HPA *HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	// KEDA ScaledObject for the component. When set, it replaces the
+	// Horizontal Pod Autoscaler to scale on external events like queue depth.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	KEDA *KEDAScaledObjectSpec `json:"keda,omitempty"`
+	// Number of replicas (ignored if hpa is enabled) for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resource requirements for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Vertical Pod Autoscaler for the component. Its recommendations
+	// are reported in the status of the workload.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	VPA *VerticalPodAutoscalerSpec `json:"vpa,omitempty"`
+	// Liveness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LivenessProbe *ProbeSpec `json:"livenessProbe,omitempty"`
+	// Readiness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ReadinessProbe *ProbeSpec `json:"readinessProbe,omitempty"`
+	// Describes node affinity scheduling rules for the pod.
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
+	// If specified, the pod's tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+	// Canary defines spec changes for the canary Deployment. If
+	// left unset the canary Deployment wil not be created.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Canary *Canary `json:"canary,omitempty"`
+	// Configures the TerminationGracePeriodSeconds
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+}
+
+// SidekiqConfig configures app behavior for System Sidekiq
+type SidekiqConfig struct {
+	// List of queues to be consumed by sidekiq. Format: queue[,Priority]
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Queues []string `json:"queues,omitempty"`
+	// Number of rails max threads per sidekiq pod
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MaxThreads *int32 `json:"maxThreads,omitempty"`
+}
+
+// SystemSearchdSpec configures the App component of System
+type SystemSearchdSpec struct {
+	// Deploy searchd instance
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Image specification for the Searchd component.
+	// Defaults to system image if not defined.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *ImageSpec `json:"image,omitempty"`
+	// Configuration options for System's Searchd
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Config *SearchdConfig `json:"config,omitempty"`
+	// Resource requirements for the Searchd component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Liveness probe for the Searchd component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LivenessProbe *ProbeSpec `json:"livenessProbe,omitempty"`
+	// Readiness probe for the Searchd component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ReadinessProbe *ProbeSpec `json:"readinessProbe,omitempty"`
+	// Describes node affinity scheduling rules for the Searchd pod
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
+	// If specified, the Searchd pod's tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+	// Configures the TerminationGracePeriodSeconds for Searchd
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+}
+
+// SearchdConfig has configuration options for System's searchd
+type SearchdConfig struct {
+	// Allows setting the service name for Searchd
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ServiceName *string `json:"serviceName,omitempty"`
+	// The TCP port Searchd will run its daemon on
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// Searchd database path
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DatabasePath *string `json:"databasePath,omitempty"`
+	// Searchd database storage size
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DatabaseStorageSize *resource.Quantity `json:"databaseStorageSize,omitempty"`
+	// Searchd database storage type
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	DatabaseStorageClass *string `json:"databaseStorageClass,omitempty"`
+}
+
+// SystemRailsConsoleSpec configures the App component of System
+type SystemRailsConsoleSpec struct {
+	// Image specification for the Console component.
+	// Defaults to system image if not defined.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *ImageSpec `json:"image,omitempty"`
+	// Resource requirements for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Describes node affinity scheduling rules for the pod.
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
+	// If specified, the pod's tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Scheduling options for the pods of the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+}
+
+// SystemStatus defines the observed state of System
+type SystemStatus struct {
+	AggregatedStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// System is the Schema for the systems API
+type System struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SystemSpec   `json:"spec,omitempty"`
+	Status SystemStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SystemList contains a list of System
+type SystemList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []System `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&System{}, &SystemList{})
+}
+
+// SystemTektonTaskSpec configures the Sidekiq component of System
+type SystemTektonTaskSpec struct {
+	// Deploy task instance
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Name for the Tekton task and pipeline
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// Description for the Tekton task and pipeline
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Description *string `json:"description,omitempty"`
+	// System Tekton Task specific configuration options for the component element
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Config *SystemTektonTaskConfig `json:"config,omitempty"`
+	// Pod Disruption Budget for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Describes node affinity scheduling rules for the pod.
+	// +optional
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAffinity"`
+	// If specified, the pod's tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	// Configures the TerminationGracePeriodSeconds
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+}
+
+// SystemTektonTaskConfig configures app behavior for System SystemTektonTask
+type SystemTektonTaskConfig struct {
+	// Image specification for the Console component.
+	// Defaults to system image if not defined.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *ImageSpec `json:"image,omitempty"`
+	// List of commands to be consumed by the task.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// List of args to be consumed by the task.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// List of extra evironment variables to be consumed by the task.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ExtraEnv []corev1.EnvVar `json:"extraEnv,omitempty"`
+	// Timeout for the Tekton task
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TwemproxySpec configures twemproxy sidecars
+// to access a sharded redis
+type TwemproxySpec struct {
+	// Image specification for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Image *ImageSpec `json:"image,omitempty"`
+	// Resource requirements for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Resources *ResourceRequirementsSpec `json:"resources,omitempty"`
+	// Liveness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LivenessProbe *ProbeSpec `json:"livenessProbe,omitempty"`
+	// Readiness probe for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ReadinessProbe *ProbeSpec `json:"readinessProbe,omitempty"`
+	// TwemproxyConfigRef is a reference to a TwemproxyConfig
+	// resource in the same Namespace
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	TwemproxyConfigRef string `json:"twemproxyConfigRef"`
+	// Options
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	Options *TwemproxyOptions `json:"options,omitempty"`
+	// ServerPoolOverrides allows to override, for this workload only, the options
+	// of the server pools defined in the referenced TwemproxyConfig. When set, a copy
+	// of the referenced TwemproxyConfig with the overrides applied is created and used
+	// instead. Note that changes to the referenced TwemproxyConfig are only propagated
+	// to the copy when the workload's custom resource is reconciled.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ServerPoolOverrides []TwemproxyServerPoolOverride `json:"serverPoolOverrides,omitempty"`
+}
+
+// TwemproxyServerPoolOverride overrides the options of one of
+// the server pools of a TwemproxyConfig
+type TwemproxyServerPoolOverride struct {
+	// The name of the server pool to override
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The options to override
+	TwemproxyServerPoolOptions `json:",inline"`
+}
+
+type TwemproxyOptions struct {
+	// Set logging level to N. (default: 5, min: 0, max: 11)
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	LogLevel *int32 `json:"logLevel,omitempty"`
+	// Set stats monitoring port to port.  (default: 9151)
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	MetricsPort *int32 `json:"metricsPort,omitempty"`
+	// Set stats aggregation interval in msec to interval.  (default: 30s)
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	StatsInterval *metav1.Duration `json:"statsInterval,omitempty"`
+}
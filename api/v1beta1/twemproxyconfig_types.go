@@ -0,0 +1,207 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TwemproxyConfigSpec defines the desired state of TwemproxyConfig
+type TwemproxyConfigSpec struct {
+	// SentinelURI is the redis URI of sentinel. If not set, the controller
+	// will try to autodiscover Sentinel within the namespace.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SentinelURIs []string `json:"sentinelURIs,omitempty"`
+	// ServerPools is the list of Twemproxy server pools
+	// WARNING: only 1 pool is supported at this time
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ServerPools []TwemproxyServerPool `json:"serverPools"`
+	// ReconcileServerPools is a flag that allows to deactivate
+	// the reconcile of the contents of the managed ConfigMap. This is
+	// useful in an emergency, to fix something manually. The re-sync
+	// logic will still work whenever the contents of the ConfigMap
+	// are changed, even if they are manually changed.
+	// This switch defaults to "true".
+	ReconcileServerPools *bool `json:"reconcileServerPools,omitempty"`
+	// Configures the Grafana Dashboard for the component
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	GrafanaDashboard *GrafanaDashboardSpec `json:"grafanaDashboard,omitempty"`
+	// SentinelEventsDebounce is the time the controller waits since the last
+	// relevant sentinel event (ie a '+switch-master') was received before
+	// triggering a reconcile. This avoids several consecutive reconciles when
+	// sentinel events are received in bursts. Defaults to 500ms.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	SentinelEventsDebounce *metav1.Duration `json:"sentinelEventsDebounce,omitempty"`
+	// ResyncPeriod is the interval at which a full resync of the configuration is
+	// performed. Changes in the topology are detected through sentinel events,
+	// so this is just a fallback in case some event is lost. Defaults to 5m.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	ResyncPeriod *metav1.Duration `json:"resyncPeriod,omitempty"`
+}
+
+type TwemproxyServerPool struct {
+	// The name of the server pool
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// The topology of the servers within the server pool. This
+	// field describes the association of logical shards to physical
+	// shards.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Topology []ShardedRedisTopology `json:"topology"`
+	// The address to bind to. Format is ip:port
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	BindAddress string `json:"bindAddress"`
+	// Timeout to stablish connection with the servers in the
+	// server pool
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Timeout int `json:"timeout"`
+	// Max number of pending connections in the queue
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	TCPBacklog int `json:"tcpBacklog"`
+	// Connect to all servers in the pool during startup
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	PreConnect bool `json:"preConnect"`
+	// Target defines which are the servers that will be configured
+	// as backend redis servers for the Twemproxy configuration. The
+	// default is to target masters, but read-write slaves can be configured
+	// through this option. If read-write slaves are configured but there are none
+	// available, the config will fall back to masters. The masters never fall back
+	// to slaves though and will just wait for sentinel triggered failovers to solve
+	// the unavailability.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=masters;slaves-rw
+	// +optional
+	Target *TargetRedisServers `json:"target,omitempty"`
+	// Additional nutcracker options for the server pool
+	TwemproxyServerPoolOptions `json:",inline"`
+}
+
+// TwemproxyServerPoolOptions are the nutcracker options of a server pool
+// that can be tuned by the user. See https://github.com/twitter/twemproxy#configuration
+// for a detailed description of each option.
+type TwemproxyServerPoolOptions struct {
+	// The name of the hash function. Defaults to "fnv1a_64".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=one_at_a_time;md5;crc16;crc32;crc32a;fnv1_64;fnv1a_64;fnv1_32;fnv1a_32;hsieh;murmur;jenkins
+	// +optional
+	Hash *string `json:"hash,omitempty"`
+	// A two character string that specifies the part of the key
+	// used for hashing. Defaults to "{}".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=2
+	// +optional
+	HashTag *string `json:"hashTag,omitempty"`
+	// The key distribution mode. Defaults to "ketama".
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Enum=ketama;modula;random
+	// +optional
+	Distribution *string `json:"distribution,omitempty"`
+	// Whether servers should be temporarily ejected when they fail
+	// consecutively ServerFailureLimit times. Defaults to false.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	AutoEjectHosts *bool `json:"autoEjectHosts,omitempty"`
+	// The timeout in milliseconds to wait for before retrying on a temporarily
+	// ejected server, when AutoEjectHosts is set to true. Nutcracker defaults
+	// to 30000 if unset.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ServerRetryTimeout *int32 `json:"serverRetryTimeout,omitempty"`
+	// The number of consecutive failures on a server that would lead to it being
+	// temporarily ejected when AutoEjectHosts is set to true. Nutcracker defaults
+	// to 2 if unset.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ServerFailureLimit *int32 `json:"serverFailureLimit,omitempty"`
+	// Reference to a Secret key holding the password used to authenticate to
+	// the redis servers. Note that the password is written in plain text to the
+	// generated twemproxy ConfigMap, as nutcracker requires it in its config file.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +optional
+	RedisAuth *corev1.SecretKeySelector `json:"redisAuth,omitempty"`
+	// The redis database number to use in the redis servers. Nutcracker
+	// defaults to 0 if unset.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RedisDB *int32 `json:"redisDB,omitempty"`
+}
+
+type TargetRedisServers string
+
+const (
+	Masters  TargetRedisServers = "masters"
+	SlavesRW TargetRedisServers = "slaves-rw"
+)
+
+type ShardedRedisTopology struct {
+	// The name of the locigal shard
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ShardName string `json:"shardName"`
+	// The physical shard where the logical one is stored.
+	// This name should match the shard names monitored by
+	// Sentinel.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	PhysicalShard string `json:"physicalShard"`
+}
+
+// TwemproxyConfigStatus defines the observed state of TwemproxyConfig
+type TwemproxyConfigStatus struct {
+	// The list of serves currently targeted by this TwemproxyConfig
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +optional
+	SelectedTargets map[string]TargetServer `json:"targets,omitempty"`
+}
+
+// Defines a server targeted by one of the TwemproxyConfig server pools
+type TargetServer struct {
+	ServerAlias   *string `json:"serverAlias,omitempty"`
+	ServerAddress string  `json:"serverAddress"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=`.status.targets`,name=Selected Targets,type=string
+// TwemproxyConfig is the Schema for the twemproxyconfigs API
+type TwemproxyConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TwemproxyConfigSpec   `json:"spec,omitempty"`
+	Status TwemproxyConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TwemproxyConfigList contains a list of TwemproxyConfig
+type TwemproxyConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TwemproxyConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TwemproxyConfig{}, &TwemproxyConfigList{})
+}
@@ -29,12 +29,18 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	"github.com/3scale-sre/basereconciler/reconciler"
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	controllers "github.com/3scale-sre/saas-operator/internal/controller"
+	kedav1alpha1 "github.com/3scale-sre/saas-operator/internal/pkg/keda/v1alpha1"
 	"github.com/3scale-sre/saas-operator/internal/pkg/reconcilers/threads"
 	redis "github.com/3scale-sre/saas-operator/internal/pkg/redis/server"
 	operatorscheme "github.com/3scale-sre/saas-operator/internal/pkg/scheme"
 	operatorutils "github.com/3scale-sre/saas-operator/internal/pkg/util"
 	"github.com/3scale-sre/saas-operator/internal/pkg/version"
+	deployment_workload "github.com/3scale-sre/saas-operator/internal/pkg/workloads/deployment"
+	webhookv1alpha1 "github.com/3scale-sre/saas-operator/internal/webhook/v1alpha1"
+	routev1 "github.com/openshift/api/route/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -112,6 +118,26 @@ func main() {
 		os.Exit(1)
 	}
 
+	workloadOptions := deployment_workload.Options{}
+
+	// Publish endpoints with OpenShift Routes instead of Ingresses when the API is available
+	if _, err := mgr.GetRESTMapper().RESTMapping(routev1.SchemeGroupVersion.WithKind("Route").GroupKind(), routev1.SchemeGroupVersion.Version); err == nil {
+		setupLog.Info("route.openshift.io API found, the Ingress publishing strategy will generate Routes by default")
+		workloadOptions.DefaultIngressKind = saasv1alpha1.IngressKindRoute
+	} else if !meta.IsNoMatchError(err) {
+		setupLog.Error(err, "unable to check if the route.openshift.io API is available")
+		os.Exit(1)
+	}
+
+	// Delete the ScaledObjects that are no longer used when the KEDA API is available
+	if _, err := mgr.GetRESTMapper().RESTMapping(kedav1alpha1.GroupVersion.WithKind("ScaledObject").GroupKind(), kedav1alpha1.GroupVersion.Version); err == nil {
+		setupLog.Info("keda.sh API found, unused ScaledObjects will be deleted")
+		workloadOptions.KEDAAvailable = true
+	} else if !meta.IsNoMatchError(err) {
+		setupLog.Error(err, "unable to check if the keda.sh API is available")
+		os.Exit(1)
+	}
+
 	redisPool := redis.NewServerPool()
 	if err = (&controllers.SentinelReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
@@ -156,6 +182,7 @@ func main() {
 	if err = (&controllers.ApicastReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("Apicast")),
+		WorkloadOptions: workloadOptions,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Apicast")
 		os.Exit(1)
@@ -164,6 +191,7 @@ func main() {
 	if err = (&controllers.ZyncReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("Zync")),
+		WorkloadOptions: workloadOptions,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Zync")
 		os.Exit(1)
@@ -172,6 +200,7 @@ func main() {
 	if err = (&controllers.MappingServiceReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("MappingService")),
+		WorkloadOptions: workloadOptions,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "MappingService")
 		os.Exit(1)
@@ -180,6 +209,7 @@ func main() {
 	if err = (&controllers.CORSProxyReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("CORSProxy")),
+		WorkloadOptions: workloadOptions,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "CORSProxy")
 		os.Exit(1)
@@ -188,6 +218,7 @@ func main() {
 	if err = (&controllers.AutoSSLReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("AutoSSL")),
+		WorkloadOptions: workloadOptions,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AutoSSL")
 		os.Exit(1)
@@ -196,6 +227,7 @@ func main() {
 	if err = (&controllers.EchoAPIReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("EchoAPI")),
+		WorkloadOptions: workloadOptions,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "EchoAPI")
 		os.Exit(1)
@@ -204,6 +236,7 @@ func main() {
 	if err = (&controllers.BackendReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("Backend")),
+		WorkloadOptions: workloadOptions,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Backend")
 		os.Exit(1)
@@ -212,11 +245,21 @@ func main() {
 	if err = (&controllers.SystemReconciler{
 		Reconciler: reconciler.NewFromManager(mgr).
 			WithLogger(ctrl.Log.WithName("controllers").WithName("System")),
+		WorkloadOptions: workloadOptions,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "System")
 		os.Exit(1)
 	}
 
+	// Webhooks can be disabled to run the operator outside
+	// of the cluster, where the webhook certificates are not available
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err = webhookv1alpha1.SetupWebhooksWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhooks")
+			os.Exit(1)
+		}
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("health", healthz.Ping); err != nil {
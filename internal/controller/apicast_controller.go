@@ -23,6 +23,7 @@ import (
 	"github.com/3scale-sre/basereconciler/util"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	"github.com/3scale-sre/saas-operator/internal/pkg/generators/apicast"
+	deployment_workload "github.com/3scale-sre/saas-operator/internal/pkg/workloads/deployment"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -30,6 +31,7 @@ import (
 // ApicastReconciler reconciles a Apicast object
 type ApicastReconciler struct {
 	*reconciler.Reconciler
+	WorkloadOptions deployment_workload.Options
 }
 
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=apicasts,verbs=get;list;watch;create;update;patch;delete
@@ -39,9 +41,13 @@ type ApicastReconciler struct {
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="grafana.integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="marin3r.3scale.net",namespace=placeholder,resources=envoyconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="gateway.networking.k8s.io",namespace=placeholder,resources=httproutes;grpcroutes;tlsroutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="networking.k8s.io",namespace=placeholder,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="route.openshift.io",namespace=placeholder,resources=routes;routes/custom-host,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -56,12 +62,40 @@ func (r *ApicastReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return result.Values()
 	}
 
-	gen, err := apicast.NewGenerator(instance.GetName(), instance.GetNamespace(), instance.Spec)
+	gen, err := apicast.NewGenerator(instance.GetName(), instance.GetNamespace(), instance.Spec, r.WorkloadOptions)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	// run the step schedules of the weighted canaries
+	ramps := newCanaryRamps(instance)
+	if gen.CanaryStaging != nil {
+		gen.CanaryStaging.Weight = ramps.Reconcile(ctx, gen.Staging.GetKey(), gen.CanaryStaging.GetKey(), instance.Spec.Staging.Canary, gen.CanaryStaging.Weight)
+	}
+	if gen.CanaryProduction != nil {
+		gen.CanaryProduction.Weight = ramps.Reconcile(ctx, gen.Production.GetKey(), gen.CanaryProduction.GetKey(), instance.Spec.Production.Canary, gen.CanaryProduction.Weight)
+	}
+
+	// run the automated analyses of the canaries
+	analyses := newCanaryAnalyses(instance)
+	if gen.CanaryStaging != nil {
+		gen.CanaryStaging.Abort = analyses.Reconcile(ctx, gen.Staging.GetKey(), gen.CanaryStaging.GetKey(),
+			promoteCanary(instance.Spec.Staging.Image, &instance.Spec.Staging.Canary))
+	}
+	if gen.CanaryProduction != nil {
+		gen.CanaryProduction.Abort = analyses.Reconcile(ctx, gen.Production.GetKey(), gen.CanaryProduction.GetKey(),
+			promoteCanary(instance.Spec.Production.Image, &instance.Spec.Production.Canary))
+	}
+
 	resources, err := gen.Resources()
+	if err != nil {
+		return ctrl.Result{}, envoyConfigValidationFailed(ctx, r.Client, instance, err)
+	}
+
+	// keep the LoadBalancer Services that are no longer generated until their deletion is allowed
+	guard := newDeletionGuard(instance)
+
+	resources, err = guard.Reconcile(ctx, r.Client, instance, resources)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -73,15 +107,25 @@ func (r *ApicastReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	}
 
 	// reconcile the status
+	published := newPublishedEndpoints(instance, r.WorkloadOptions)
 	result = r.ReconcileStatus(ctx, instance, []types.NamespacedName{
 		gen.Staging.GetKey(),
 		gen.Production.GetKey(),
-	}, nil)
+	}, nil, envoyConfigValid(instance), ramps.StatusMutator(), analyses.StatusMutator(),
+		published.StatusMutator(ctx, r.Client, &gen.Staging, &gen.Production), guard.StatusMutator(),
+		vpaRecommendations(ctx, r.Client, instance, &gen.Staging, &gen.Production))
 	if result.ShouldReturn() {
 		return result.Values()
 	}
 
-	return ctrl.Result{}, nil
+	// promote the canaries that passed the analysis
+	if err := analyses.Promote(ctx, r.Client, instance); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{
+		RequeueAfter: minRequeue(ramps.RequeueAfter(), analyses.RequeueAfter(), published.RequeueAfter()),
+	}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -14,6 +14,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -228,6 +229,14 @@ var _ = Describe("Apicast controller", func() {
 												RouteConfigName: "route",
 											},
 										},
+										"route": {
+											GeneratorVersion: ptr.To("v1"),
+											RouteConfiguration: &saasv1alpha1.RouteConfiguration{
+												VirtualHosts: []runtime.RawExtension{{
+													Raw: []byte(`{"name":"vhost","domains":["*"],"routes":[{"match":{"prefix":"/"},"direct_response":{"status":200}}]}`),
+												}},
+											},
+										},
 									},
 								},
 							}},
@@ -263,6 +272,14 @@ var _ = Describe("Apicast controller", func() {
 												RouteConfigName: "route",
 											},
 										},
+										"route": {
+											GeneratorVersion: ptr.To("v1"),
+											RouteConfiguration: &saasv1alpha1.RouteConfiguration{
+												VirtualHosts: []runtime.RawExtension{{
+													Raw: []byte(`{"name":"vhost","domains":["*"],"routes":[{"match":{"prefix":"/"},"direct_response":{"status":200}}]}`),
+												}},
+											},
+										},
 									},
 								},
 							}},
@@ -23,6 +23,7 @@ import (
 	"github.com/3scale-sre/basereconciler/util"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	"github.com/3scale-sre/saas-operator/internal/pkg/generators/backend"
+	deployment_workload "github.com/3scale-sre/saas-operator/internal/pkg/workloads/deployment"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -31,20 +32,27 @@ import (
 // BackendReconciler reconciles a Backend object
 type BackendReconciler struct {
 	*reconciler.Reconciler
+	WorkloadOptions deployment_workload.Options
 }
 
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=backends,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=backends/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=backends/finalizers,verbs=update
+// +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=twemproxyconfigs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="keda.sh",namespace=placeholder,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="grafana.integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="external-secrets.io",namespace=placeholder,resources=externalsecrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="marin3r.3scale.net",namespace=placeholder,resources=envoyconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="gateway.networking.k8s.io",namespace=placeholder,resources=httproutes;grpcroutes;tlsroutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="networking.k8s.io",namespace=placeholder,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="route.openshift.io",namespace=placeholder,resources=routes;routes/custom-host,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -59,12 +67,37 @@ func (r *BackendReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return result.Values()
 	}
 
-	gen, err := backend.NewGenerator(instance.GetName(), instance.GetNamespace(), instance.Spec)
+	gen, err := backend.NewGenerator(instance.GetName(), instance.GetNamespace(), instance.Spec, r.WorkloadOptions)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	// run the step schedules of the weighted canaries
+	ramps := newCanaryRamps(instance)
+	if gen.CanaryListener != nil {
+		gen.CanaryListener.Weight = ramps.Reconcile(ctx, gen.Listener.GetKey(), gen.CanaryListener.GetKey(), instance.Spec.Listener.Canary, gen.CanaryListener.Weight)
+	}
+
+	// run the automated analyses of the canaries
+	analyses := newCanaryAnalyses(instance)
+	if gen.CanaryListener != nil {
+		gen.CanaryListener.Abort = analyses.Reconcile(ctx, gen.Listener.GetKey(), gen.CanaryListener.GetKey(),
+			promoteCanary(instance.Spec.Image, &instance.Spec.Listener.Canary))
+	}
+	if gen.CanaryWorker != nil {
+		gen.CanaryWorker.Abort = analyses.Reconcile(ctx, gen.Worker.GetKey(), gen.CanaryWorker.GetKey(),
+			promoteCanary(instance.Spec.Image, &instance.Spec.Worker.Canary))
+	}
+
 	resources, err := gen.Resources()
+	if err != nil {
+		return ctrl.Result{}, envoyConfigValidationFailed(ctx, r.Client, instance, err)
+	}
+
+	// keep the LoadBalancer Services that are no longer generated until their deletion is allowed
+	guard := newDeletionGuard(instance)
+
+	resources, err = guard.Reconcile(ctx, r.Client, instance, resources)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -76,16 +109,26 @@ func (r *BackendReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	}
 
 	// reconcile the status
+	published := newPublishedEndpoints(instance, r.WorkloadOptions)
 	result = r.ReconcileStatus(ctx, instance, []types.NamespacedName{
 		gen.Listener.GetKey(),
 		gen.Worker.GetKey(),
 		gen.Cron.GetKey(),
-	}, nil)
+	}, nil, envoyConfigValid(instance), ramps.StatusMutator(), analyses.StatusMutator(),
+		published.StatusMutator(ctx, r.Client, &gen.Listener), guard.StatusMutator(),
+		vpaRecommendations(ctx, r.Client, instance, &gen.Listener, &gen.Worker, &gen.Cron))
 	if result.ShouldReturn() {
 		return result.Values()
 	}
 
-	return ctrl.Result{}, nil
+	// promote the canaries that passed the analysis
+	if err := analyses.Promote(ctx, r.Client, instance); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{
+		RequeueAfter: minRequeue(ramps.RequeueAfter(), analyses.RequeueAfter(), published.RequeueAfter()),
+	}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -17,6 +17,7 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -325,6 +326,14 @@ var _ = Describe("Backend controller", func() {
 											Port:            8080,
 											RouteConfigName: "route",
 										},
+									},
+									"route": {
+										GeneratorVersion: ptr.To("v1"),
+										RouteConfiguration: &saasv1alpha1.RouteConfiguration{
+											VirtualHosts: []runtime.RawExtension{{
+												Raw: []byte(`{"name":"vhost","domains":["*"],"routes":[{"match":{"prefix":"/"},"direct_response":{"status":200}}]}`),
+											}},
+										},
 									}}},
 						}},
 					}
@@ -487,6 +496,14 @@ var _ = Describe("Backend controller", func() {
 											Port:            8080,
 											RouteConfigName: "route",
 										},
+									},
+									"route": {
+										GeneratorVersion: ptr.To("v1"),
+										RouteConfiguration: &saasv1alpha1.RouteConfiguration{
+											VirtualHosts: []runtime.RawExtension{{
+												Raw: []byte(`{"name":"vhost","domains":["*"],"routes":[{"match":{"prefix":"/"},"direct_response":{"status":200}}]}`),
+											}},
+										},
 									}},
 							},
 						}},
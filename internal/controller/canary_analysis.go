@@ -0,0 +1,214 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/3scale-sre/basereconciler/reconciler"
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/3scale-sre/saas-operator/internal/pkg/canary"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type statusWithCanaryAnalyses interface {
+	GetCanaryAnalyses() map[string]*saasv1alpha1.CanaryAnalysisStatus
+	GetCanaryAnalysisStatus(string) *saasv1alpha1.CanaryAnalysisStatus
+	SetCanaryAnalysisStatus(string, *saasv1alpha1.CanaryAnalysisStatus)
+}
+
+// canaryAnalyses runs the automated analyses of the canaries of a custom resource
+// and keeps track of the status changes and of the canaries that must be promoted
+type canaryAnalyses struct {
+	status     statusWithCanaryAnalyses
+	seen       map[string]bool
+	changed    bool
+	requeue    time.Duration
+	promotions map[*saasv1alpha1.ImageSpec][]canaryPromotion
+	blocked    map[*saasv1alpha1.ImageSpec]bool
+}
+
+func newCanaryAnalyses(instance client.Object) *canaryAnalyses {
+	status, _ := instance.(reconciler.ObjectWithAppStatus).GetStatus().(statusWithCanaryAnalyses)
+
+	return &canaryAnalyses{
+		status:     status,
+		seen:       map[string]bool{},
+		promotions: map[*saasv1alpha1.ImageSpec][]canaryPromotion{},
+		blocked:    map[*saasv1alpha1.ImageSpec]bool{},
+	}
+}
+
+// Reconcile runs the analysis of the canary Deployment and returns true if the canary
+// has been aborted. The promotion is queued and later run by Promote if the analysis
+// decides that the canary must be promoted. All the canaries that share the image
+// must be reconciled, as the image is only promoted once all of them have passed
+// their analyses.
+func (ca *canaryAnalyses) Reconcile(ctx context.Context, main, key types.NamespacedName,
+	promotion canaryPromotion) bool {
+	c := *promotion.canary
+	if c == nil {
+		return false
+	}
+
+	if ca.status == nil || c.Analysis == nil {
+		// canaries without analysis must be promoted manually
+		ca.blocked[promotion.image] = true
+
+		return false
+	}
+
+	ca.seen[main.Name] = true
+	current := ca.status.GetCanaryAnalysisStatus(main.Name)
+
+	status, requeue, err := canary.Analyze(ctx, c.Analysis, c, key.Name, current, time.Now(),
+		canary.AnalysisMetrics(c.Analysis, c.TrafficWeight() != nil, key.Namespace, main.Name, key.Name))
+	if err != nil {
+		// the canary keeps being analysed until the metrics can be checked again
+		logr.FromContextOrDiscard(ctx).Error(err, "unable to check canary metrics", "canary", key.Name)
+	}
+
+	if !equality.Semantic.DeepEqual(current, status) {
+		ca.status.SetCanaryAnalysisStatus(main.Name, status)
+		ca.changed = true
+	}
+
+	ca.requeue = minRequeue(ca.requeue, requeue)
+
+	if status.Phase == saasv1alpha1.CanaryAnalysisPromoted {
+		promotion.main = main.Name
+		ca.promotions[promotion.image] = append(ca.promotions[promotion.image], promotion)
+	} else {
+		ca.blocked[promotion.image] = true
+	}
+
+	return status.Phase == saasv1alpha1.CanaryAnalysisAborted
+}
+
+// StatusMutator returns a status mutator that removes the status of the analyses
+// that were not reconciled and reports whether the status has changed. The status
+// of finished analyses is kept so the last decision can be inspected, but its
+// revision is cleared so the analysis starts again if the canary is added back.
+func (ca *canaryAnalyses) StatusMutator() func() (bool, error) {
+	return func() (bool, error) {
+		if ca.status == nil {
+			return false, nil
+		}
+
+		for name, status := range ca.status.GetCanaryAnalyses() {
+			switch {
+			case ca.seen[name]:
+				continue
+			case !status.IsFinished():
+				ca.status.SetCanaryAnalysisStatus(name, nil)
+				ca.changed = true
+			case status.Revision != "":
+				status.Revision = ""
+				ca.changed = true
+			}
+		}
+
+		for image, promotions := range ca.promotions {
+			message := canaryPromotedMessage
+			if !ca.canPromote(image) {
+				message = canaryPromotionBlockedMessage
+			}
+
+			for _, p := range promotions {
+				if status := ca.status.GetCanaryAnalysisStatus(p.main); status.Message != message {
+					status.Message = message
+					ca.changed = true
+				}
+			}
+		}
+
+		return ca.changed, nil
+	}
+}
+
+// RequeueAfter returns the time after which the next check of any of
+// the analyses is due, or zero if all of them are finished
+func (ca *canaryAnalyses) RequeueAfter() time.Duration {
+	return ca.requeue
+}
+
+// Promote updates the spec of the custom resource with the
+// promoted canaries. It must be called once the status has
+// been reconciled, as the instance is updated from the server.
+func (ca *canaryAnalyses) Promote(ctx context.Context, cl client.Client, instance client.Object) error {
+	patch := client.MergeFrom(instance.DeepCopyObject().(client.Object))
+	promoted := false
+
+	for image, promotions := range ca.promotions {
+		if !ca.canPromote(image) {
+			continue
+		}
+
+		for _, p := range promotions {
+			(*p.canary).Promote(image)
+			*p.canary = nil
+		}
+
+		promoted = true
+	}
+
+	if !promoted {
+		return nil
+	}
+
+	return cl.Patch(ctx, instance, patch)
+}
+
+// canPromote returns true if all the canaries that share the image have passed
+// their analyses and all of them promote the same image
+func (ca *canaryAnalyses) canPromote(image *saasv1alpha1.ImageSpec) bool {
+	if ca.blocked[image] {
+		return false
+	}
+
+	promotions := ca.promotions[image]
+	for _, p := range promotions[1:] {
+		if !ptr.Equal((*p.canary).ImageName, (*promotions[0].canary).ImageName) ||
+			!ptr.Equal((*p.canary).ImageTag, (*promotions[0].canary).ImageTag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+const (
+	canaryPromotedMessage         = "canary passed the analysis"
+	canaryPromotionBlockedMessage = "canary passed the analysis, waiting for the other canaries of the image to pass their analyses"
+)
+
+// canaryPromotion holds the canary spec of a Deployment, the
+// image spec the canary image is promoted to and the name of
+// the main Deployment, which indexes the status of the analysis
+type canaryPromotion struct {
+	main   string
+	image  *saasv1alpha1.ImageSpec
+	canary **saasv1alpha1.Canary
+}
+
+// promoteCanary returns the promotion that copies the canary image
+// to the main image spec and removes the canary from the spec
+func promoteCanary(image *saasv1alpha1.ImageSpec, c **saasv1alpha1.Canary) canaryPromotion {
+	return canaryPromotion{image: image, canary: c}
+}
+
+// minRequeue returns the lowest of the non zero durations
+func minRequeue(durations ...time.Duration) time.Duration {
+	var requeue time.Duration
+
+	for _, d := range durations {
+		if d > 0 && (requeue == 0 || d < requeue) {
+			requeue = d
+		}
+	}
+
+	return requeue
+}
@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/3scale-sre/basereconciler/reconciler"
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/3scale-sre/saas-operator/internal/pkg/canary"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type statusWithCanaries interface {
+	GetCanaries() map[string]*saasv1alpha1.CanaryStatus
+	GetCanaryStatus(string) *saasv1alpha1.CanaryStatus
+	SetCanaryStatus(string, *saasv1alpha1.CanaryStatus)
+}
+
+// canaryRamps runs the step schedules of the weighted canaries of a custom resource
+// and keeps track of the status changes and of when the next step is due
+type canaryRamps struct {
+	status  statusWithCanaries
+	seen    map[string]bool
+	changed bool
+	requeue time.Duration
+}
+
+func newCanaryRamps(instance client.Object) *canaryRamps {
+	status, _ := instance.(reconciler.ObjectWithAppStatus).GetStatus().(statusWithCanaries)
+
+	return &canaryRamps{status: status, seen: map[string]bool{}}
+}
+
+// Reconcile advances the step schedule of the canary Deployment and returns the weight
+// the canary must receive. The received weight is returned unchanged if the canary
+// does not have a step schedule.
+func (cr *canaryRamps) Reconcile(ctx context.Context, main, key types.NamespacedName,
+	c *saasv1alpha1.Canary, weight *int32) *int32 {
+	if cr.status == nil || c == nil || len(c.Steps) == 0 {
+		return weight
+	}
+
+	cr.seen[key.Name] = true
+	current := cr.status.GetCanaryStatus(key.Name)
+
+	var errorRate canary.ErrorRateFunc
+	if c.ErrorRateThreshold != nil {
+		errorRate = canary.ErrorRate(c.ErrorRateThreshold, main.Namespace, main.Name)
+	}
+
+	status, requeue, err := canary.Ramp(ctx, c, current, time.Now(), errorRate)
+	if err != nil {
+		// the schedule is kept in the current step until the error rate can be checked again
+		logr.FromContextOrDiscard(ctx).Error(err, "unable to advance canary step schedule", "canary", key.Name)
+	}
+
+	if !equality.Semantic.DeepEqual(current, status) {
+		cr.status.SetCanaryStatus(key.Name, status)
+		cr.changed = true
+	}
+
+	cr.requeue = minRequeue(cr.requeue, requeue)
+
+	return ptr.To(status.Weight)
+}
+
+// StatusMutator returns a status mutator that removes the status of the canaries
+// that were not reconciled and reports whether the status has changed
+func (cr *canaryRamps) StatusMutator() func() (bool, error) {
+	return func() (bool, error) {
+		if cr.status == nil {
+			return false, nil
+		}
+
+		for name := range cr.status.GetCanaries() {
+			if !cr.seen[name] {
+				cr.status.SetCanaryStatus(name, nil)
+				cr.changed = true
+			}
+		}
+
+		return cr.changed, nil
+	}
+}
+
+// RequeueAfter returns the time after which the next step of any of the
+// schedules is due, or zero if all of them are completed
+func (cr *canaryRamps) RequeueAfter() time.Duration {
+	return cr.requeue
+}
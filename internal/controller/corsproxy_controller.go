@@ -24,6 +24,7 @@ import (
 	"github.com/3scale-sre/basereconciler/util"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	"github.com/3scale-sre/saas-operator/internal/pkg/generators/corsproxy"
+	deployment_workload "github.com/3scale-sre/saas-operator/internal/pkg/workloads/deployment"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -32,6 +33,7 @@ import (
 // CORSProxyReconciler reconciles a CORSProxy object
 type CORSProxyReconciler struct {
 	*reconciler.Reconciler
+	WorkloadOptions deployment_workload.Options
 }
 
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=corsproxies,verbs=get;list;watch;create;update;patch;delete
@@ -42,9 +44,13 @@ type CORSProxyReconciler struct {
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="grafana.integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="external-secrets.io",namespace=placeholder,resources=externalsecrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="gateway.networking.k8s.io",namespace=placeholder,resources=httproutes;grpcroutes;tlsroutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="networking.k8s.io",namespace=placeholder,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="route.openshift.io",namespace=placeholder,resources=routes;routes/custom-host,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -59,9 +65,17 @@ func (r *CORSProxyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return result.Values()
 	}
 
-	gen := corsproxy.NewGenerator(instance.GetName(), instance.GetNamespace(), instance.Spec)
+	gen := corsproxy.NewGenerator(instance.GetName(), instance.GetNamespace(), instance.Spec, r.WorkloadOptions)
 
 	resources, err := gen.Resources()
+	if err != nil {
+		return ctrl.Result{}, envoyConfigValidationFailed(ctx, r.Client, instance, err)
+	}
+
+	// keep the LoadBalancer Services that are no longer generated until their deletion is allowed
+	guard := newDeletionGuard(instance)
+
+	resources, err = guard.Reconcile(ctx, r.Client, instance, resources)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -73,14 +87,17 @@ func (r *CORSProxyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	}
 
 	// reconcile the status
+	published := newPublishedEndpoints(instance, r.WorkloadOptions)
 	result = r.ReconcileStatus(ctx, instance, []types.NamespacedName{
 		gen.GetKey(),
-	}, nil)
+	}, nil, envoyConfigValid(instance),
+		published.StatusMutator(ctx, r.Client, &gen), guard.StatusMutator(),
+		vpaRecommendations(ctx, r.Client, instance, &gen))
 	if result.ShouldReturn() {
 		return result.Values()
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: published.RequeueAfter()}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -0,0 +1,101 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/3scale-sre/basereconciler/reconciler"
+	"github.com/3scale-sre/basereconciler/resource"
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/service"
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type statusWithPendingDeletions interface {
+	GetPendingDeletions() []saasv1alpha1.PendingDeletionStatus
+	SetPendingDeletions([]saasv1alpha1.PendingDeletionStatus)
+}
+
+// deletionGuard protects the Services of the custom resource that are no longer
+// generated from being deleted by the resource pruner, and keeps track of the
+// deletions that are blocked
+type deletionGuard struct {
+	status  statusWithPendingDeletions
+	pending []saasv1alpha1.PendingDeletionStatus
+}
+
+func newDeletionGuard(instance client.Object) *deletionGuard {
+	status, _ := instance.(reconciler.ObjectWithAppStatus).GetStatus().(statusWithPendingDeletions)
+
+	return &deletionGuard{status: status, pending: []saasv1alpha1.PendingDeletionStatus{}}
+}
+
+// Reconcile releases the owned Services with the Orphan deletion policy that are no longer
+// generated, and returns the resources with the templates that keep the blocked LoadBalancer
+// Services in place. Templates for the blocked Services use the live object, so they are
+// never modified.
+func (dg *deletionGuard) Reconcile(ctx context.Context, cl client.Client, instance client.Object,
+	resources []resource.TemplateInterface) ([]resource.TemplateInterface, error) {
+	generated := map[string]bool{}
+
+	for _, t := range resource.ExtractGVK[*corev1.Service](resources) {
+		svc, err := t.Build(ctx, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		generated[svc.GetName()] = true
+	}
+
+	list := &corev1.ServiceList{}
+	if err := cl.List(ctx, list, client.InNamespace(instance.GetNamespace())); err != nil {
+		return nil, err
+	}
+
+	owned := lo.Filter(list.Items, func(svc corev1.Service, _ int) bool { return metav1.IsControlledBy(&svc, instance) })
+	removals := service.ServiceRemovals(owned, generated)
+
+	for _, svc := range removals.Orphan {
+		patch := client.MergeFrom(svc.DeepCopy())
+		svc.SetOwnerReferences(lo.Reject(svc.GetOwnerReferences(), func(ref metav1.OwnerReference, _ int) bool {
+			return ref.UID == instance.GetUID()
+		}))
+
+		if err := cl.Patch(ctx, &svc, patch); err != nil {
+			return nil, fmt.Errorf("unable to orphan service %s: %w", svc.GetName(), err)
+		}
+
+		logr.FromContextOrDiscard(ctx).Info("service orphaned", "resource", svc.GetName())
+	}
+
+	for _, svc := range removals.Blocked {
+		resources = append(resources,
+			resource.NewTemplateFromObjectFunction(func() *corev1.Service { return svc.DeepCopy() }))
+		dg.pending = append(dg.pending, saasv1alpha1.PendingDeletionStatus{
+			Kind: "Service",
+			Name: svc.GetName(),
+			Message: fmt.Sprintf("LoadBalancer Service is no longer used, annotate it with '%s=true' to delete it",
+				saasv1alpha1.AllowDeletionAnnotation),
+		})
+	}
+
+	return resources, nil
+}
+
+// StatusMutator returns a status mutator that sets the blocked deletions in the status
+func (dg *deletionGuard) StatusMutator() func() (bool, error) {
+	return func() (bool, error) {
+		if dg.status == nil || equality.Semantic.DeepEqual(dg.status.GetPendingDeletions(), dg.pending) {
+			return false, nil
+		}
+
+		dg.status.SetPendingDeletions(dg.pending)
+
+		return true, nil
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/3scale-sre/basereconciler/util"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	"github.com/3scale-sre/saas-operator/internal/pkg/generators/echoapi"
+	deployment_workload "github.com/3scale-sre/saas-operator/internal/pkg/workloads/deployment"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -31,6 +32,7 @@ import (
 // EchoAPIReconciler reconciles a EchoAPI object
 type EchoAPIReconciler struct {
 	*reconciler.Reconciler
+	WorkloadOptions deployment_workload.Options
 }
 
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=echoapis,verbs=get;list;watch;create;update;patch;delete
@@ -40,8 +42,12 @@ type EchoAPIReconciler struct {
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="marin3r.3scale.net",namespace=placeholder,resources=envoyconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="gateway.networking.k8s.io",namespace=placeholder,resources=httproutes;grpcroutes;tlsroutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="networking.k8s.io",namespace=placeholder,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="route.openshift.io",namespace=placeholder,resources=routes;routes/custom-host,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -56,9 +62,17 @@ func (r *EchoAPIReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return result.Values()
 	}
 
-	gen := echoapi.NewGenerator(instance.GetName(), instance.GetNamespace(), instance.Spec)
+	gen := echoapi.NewGenerator(instance.GetName(), instance.GetNamespace(), instance.Spec, r.WorkloadOptions)
 
 	resources, err := gen.Resources()
+	if err != nil {
+		return ctrl.Result{}, envoyConfigValidationFailed(ctx, r.Client, instance, err)
+	}
+
+	// keep the LoadBalancer Services that are no longer generated until their deletion is allowed
+	guard := newDeletionGuard(instance)
+
+	resources, err = guard.Reconcile(ctx, r.Client, instance, resources)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -70,12 +84,15 @@ func (r *EchoAPIReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	}
 
 	// reconcile the status
-	result = r.ReconcileStatus(ctx, instance, []types.NamespacedName{gen.GetKey()}, nil)
+	published := newPublishedEndpoints(instance, r.WorkloadOptions)
+	result = r.ReconcileStatus(ctx, instance, []types.NamespacedName{gen.GetKey()}, nil, envoyConfigValid(instance),
+		published.StatusMutator(ctx, r.Client, &gen), guard.StatusMutator(),
+		vpaRecommendations(ctx, r.Client, instance, &gen))
 	if result.ShouldReturn() {
 		return result.Values()
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: published.RequeueAfter()}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -12,6 +12,7 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -143,6 +144,14 @@ var _ = Describe("EchoAPI controller", func() {
 											RouteConfigName: "route",
 										},
 									},
+									"route": {
+										GeneratorVersion: ptr.To("v1"),
+										RouteConfiguration: &saasv1alpha1.RouteConfiguration{
+											VirtualHosts: []runtime.RawExtension{{
+												Raw: []byte(`{"name":"vhost","domains":["*"],"routes":[{"match":{"prefix":"/"},"direct_response":{"status":200}}]}`),
+											}},
+										},
+									},
 								},
 							},
 						}},
@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/3scale-sre/basereconciler/reconciler"
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/3scale-sre/saas-operator/internal/pkg/endpoints"
+	deployment_workload "github.com/3scale-sre/saas-operator/internal/pkg/workloads/deployment"
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// endpointsResolutionCheckInterval is the time after which the resolution of the
+// external DNS hostnames is checked again while any of them does not resolve yet
+const endpointsResolutionCheckInterval = time.Minute
+
+// endpointsLookup resolves the external DNS hostnames of the endpoints. The results
+// are kept for half the check interval so the hostnames are resolved again when the
+// check is requeued, but not on every reconcile of the custom resources.
+var endpointsLookup = endpoints.CachedLookup(endpoints.DefaultLookup, endpointsResolutionCheckInterval/2)
+
+type statusWithEndpoints interface {
+	GetEndpoints() []saasv1alpha1.EndpointStatus
+	SetEndpoints([]saasv1alpha1.EndpointStatus)
+}
+
+// publishedEndpoints reports where the endpoints of the workloads of a custom resource
+// are published and keeps track of the hostnames that do not resolve to them yet
+type publishedEndpoints struct {
+	status  statusWithEndpoints
+	opts    deployment_workload.Options
+	requeue time.Duration
+}
+
+func newPublishedEndpoints(instance client.Object, opts deployment_workload.Options) *publishedEndpoints {
+	status, _ := instance.(reconciler.ObjectWithAppStatus).GetStatus().(statusWithEndpoints)
+
+	return &publishedEndpoints{status: status, opts: opts}
+}
+
+// StatusMutator returns a status mutator that sets the endpoints of the given workloads
+// in the status, reading the addresses from the live Services, Ingresses and Routes
+func (pe *publishedEndpoints) StatusMutator(ctx context.Context, cl client.Client,
+	workloads ...deployment_workload.DeploymentWorkload) func() (bool, error) {
+	return func() (bool, error) {
+		if pe.status == nil {
+			return false, nil
+		}
+
+		statuses := []saasv1alpha1.EndpointStatus{}
+
+		for _, w := range workloads {
+			eps, err := deployment_workload.Endpoints(w, pe.opts)
+			if err != nil {
+				return false, err
+			}
+
+			for _, ep := range eps {
+				if err := liveObjects(ctx, cl, &ep); err != nil {
+					// the endpoint is reported once its resources exist
+					logr.FromContextOrDiscard(ctx).V(1).Info("unable to get endpoint resources", "endpoint", ep.EndpointName, "error", err.Error())
+
+					continue
+				}
+
+				status := endpoints.Status(ctx, w.GetKey().Name, ep, endpointsLookup)
+				if lo.SomeBy(status.Hostnames, func(h saasv1alpha1.EndpointHostnameStatus) bool { return !h.Resolves }) {
+					pe.requeue = endpointsResolutionCheckInterval
+				}
+
+				statuses = append(statuses, status)
+			}
+		}
+
+		if equality.Semantic.DeepEqual(pe.status.GetEndpoints(), statuses) {
+			return false, nil
+		}
+
+		pe.status.SetEndpoints(statuses)
+
+		return true, nil
+	}
+}
+
+// RequeueAfter returns the time after which the resolution of the hostnames must
+// be checked again, or zero if all of them resolve to their endpoints
+func (pe *publishedEndpoints) RequeueAfter() time.Duration {
+	return pe.requeue
+}
+
+// liveObjects replaces the resources of the endpoint with the live ones
+func liveObjects(ctx context.Context, cl client.Client, ep *deployment_workload.Endpoint) error {
+	if err := cl.Get(ctx, client.ObjectKeyFromObject(ep.Service), ep.Service); err != nil {
+		return err
+	}
+
+	for _, route := range ep.Routes {
+		if err := cl.Get(ctx, client.ObjectKeyFromObject(route), route); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
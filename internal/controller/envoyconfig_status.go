@@ -0,0 +1,65 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/3scale-sre/basereconciler/reconciler"
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/envoyconfig"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type statusWithConditions interface {
+	GetCondition(string) *metav1.Condition
+	SetCondition(metav1.Condition) bool
+}
+
+// envoyConfigValidationFailed sets the EnvoyConfigValid condition to false if the
+// error returned by the generator is caused by an invalid envoy config. The received
+// error is always returned so the reconcile fails before anything is published.
+func envoyConfigValidationFailed(ctx context.Context, cl client.Client, instance client.Object, err error) error {
+	verr := &envoyconfig.ValidationError{}
+	if !errors.As(err, &verr) {
+		return err
+	}
+
+	status, ok := instance.(reconciler.ObjectWithAppStatus).GetStatus().(statusWithConditions)
+	if !ok {
+		return err
+	}
+
+	if status.SetCondition(metav1.Condition{
+		Type:               saasv1alpha1.EnvoyConfigValidCondition,
+		Status:             metav1.ConditionFalse,
+		Reason:             saasv1alpha1.EnvoyConfigValidationFailedReason,
+		Message:            err.Error(),
+		ObservedGeneration: instance.GetGeneration(),
+	}) {
+		if uerr := cl.Status().Update(ctx, instance); uerr != nil {
+			return errors.Join(err, uerr)
+		}
+	}
+
+	return err
+}
+
+// envoyConfigValid returns a status mutator that sets the EnvoyConfigValid condition
+// back to true once the envoy config validates again. The condition is only
+// added to the status after a validation failure.
+func envoyConfigValid(instance client.Object) func() (bool, error) {
+	return func() (bool, error) {
+		status, ok := instance.(reconciler.ObjectWithAppStatus).GetStatus().(statusWithConditions)
+		if !ok || status.GetCondition(saasv1alpha1.EnvoyConfigValidCondition) == nil {
+			return false, nil
+		}
+
+		return status.SetCondition(metav1.Condition{
+			Type:               saasv1alpha1.EnvoyConfigValidCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             saasv1alpha1.EnvoyConfigValidReason,
+			ObservedGeneration: instance.GetGeneration(),
+		}), nil
+	}
+}
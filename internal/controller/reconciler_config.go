@@ -54,7 +54,6 @@ func init() {
 				"spec.template.spec.dnsPolicy",
 				"spec.template.spec.schedulerName",
 				"spec.template.spec.restartPolicy",
-				"spec.template.spec.securityContext",
 				"spec.template.spec.containers[*].terminationMessagePath",
 				"spec.template.spec.containers[*].terminationMessagePolicy",
 				"spec.template.spec.initContainers[*].terminationMessagePath",
@@ -83,7 +82,6 @@ func init() {
 				"spec.template.spec.dnsPolicy",
 				"spec.template.spec.restartPolicy",
 				"spec.template.spec.schedulerName",
-				"spec.template.spec.securityContext",
 				"spec.template.spec.containers[*].terminationMessagePath",
 				"spec.template.spec.containers[*].terminationMessagePolicy",
 				"spec.template.spec.initContainers[*].terminationMessagePath",
@@ -100,6 +98,7 @@ func init() {
 				"spec.minReplicas",
 				"spec.maxReplicas",
 				"spec.metrics",
+				"spec.behavior",
 			},
 		})
 	config.SetDefaultReconcileConfigForGVK(
@@ -157,6 +156,68 @@ func init() {
 				"spec.finally",
 			},
 		})
+	// Only the fields of the routes that the operator sets are ensured, so the
+	// other fields of the Gateway API routes are not reverted
+	for _, gvk := range []schema.GroupVersionKind{
+		{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"},
+		{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "GRPCRoute"},
+		{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "TLSRoute"},
+	} {
+		config.SetDefaultReconcileConfigForGVK(gvk,
+			config.ReconcileConfigForGVK{
+				EnsureProperties: []string{
+					"metadata.annotations",
+					"metadata.labels",
+					"spec.parentRefs",
+					"spec.hostnames",
+					"spec.rules",
+				},
+			})
+	}
+	// The vendored OpenShift Route types lack the fields added by later OpenShift releases,
+	// and the KEDA and VerticalPodAutoscaler types only model a subset of their APIs (see
+	// their packages), so only the fields set by the operator are ensured
+	config.SetDefaultReconcileConfigForGVK(
+		schema.FromAPIVersionAndKind("route.openshift.io/v1", "Route"),
+		config.ReconcileConfigForGVK{
+			EnsureProperties: []string{
+				"metadata.annotations",
+				"metadata.labels",
+				"spec.host",
+				"spec.path",
+				"spec.to",
+				"spec.port",
+				"spec.tls",
+				"spec.wildcardPolicy",
+			},
+		})
+	config.SetDefaultReconcileConfigForGVK(
+		schema.FromAPIVersionAndKind("keda.sh/v1alpha1", "ScaledObject"),
+		config.ReconcileConfigForGVK{
+			EnsureProperties: []string{
+				"metadata.annotations",
+				"metadata.labels",
+				"spec.scaleTargetRef",
+				"spec.pollingInterval",
+				"spec.cooldownPeriod",
+				"spec.idleReplicaCount",
+				"spec.minReplicaCount",
+				"spec.maxReplicaCount",
+				"spec.advanced",
+				"spec.triggers",
+			},
+		})
+	config.SetDefaultReconcileConfigForGVK(
+		schema.FromAPIVersionAndKind("autoscaling.k8s.io/v1", "VerticalPodAutoscaler"),
+		config.ReconcileConfigForGVK{
+			EnsureProperties: []string{
+				"metadata.annotations",
+				"metadata.labels",
+				"spec.targetRef",
+				"spec.updatePolicy",
+				"spec.resourcePolicy",
+			},
+		})
 	// default config for any GVK not explicitly declared in the config
 	config.SetDefaultReconcileConfigForGVK(
 		schema.GroupVersionKind{},
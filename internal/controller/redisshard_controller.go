@@ -34,6 +34,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	redisShardReplicationCheckInterval = 30 * time.Second
 )
 
 // RedisShardReconciler reconciles a RedisShard object
@@ -45,9 +50,12 @@ type RedisShardReconciler struct {
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=redisshards,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=redisshards/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=redisshards/finalizers,verbs=update
+// +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=sentinels,verbs=get;list;watch
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=pods,verbs=get;list;watch
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -69,8 +77,20 @@ func (r *RedisShardReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return result.Values()
 	}
 
+	// when sentinel monitors the shard, it is in charge of
+	// failovers and of reconfiguring the replication links
+	monitored, err := r.isMonitoredBySentinel(ctx, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	shard, result := r.setRedisRoles(ctx, types.NamespacedName{Name: req.Name, Namespace: req.Namespace},
-		*instance.Spec.MasterIndex, *instance.Spec.SlaveCount+1, gen.ServiceName(), logger)
+		*instance.Spec.MasterIndex, *instance.Spec.SlaveCount+1, gen.ServiceName(), monitored, logger)
+	if result.ShouldReturn() {
+		return result.Values()
+	}
+
+	result = r.repairReplication(ctx, shard, monitored, logger)
 	if result.ShouldReturn() {
 		return result.Values()
 	}
@@ -84,7 +104,8 @@ func (r *RedisShardReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return result.Values()
 	}
 
-	return ctrl.Result{}, nil
+	// requeue periodically to keep verifying the replication links
+	return ctrl.Result{RequeueAfter: redisShardReplicationCheckInterval}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -95,8 +116,28 @@ func (r *RedisShardReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	)
 }
 
+// isMonitoredBySentinel returns true if any Sentinel resource monitors the RedisShard
+func (r *RedisShardReconciler) isMonitoredBySentinel(ctx context.Context, instance *saasv1alpha1.RedisShard) (bool, error) {
+	list := &saasv1alpha1.SentinelList{}
+	if err := r.Client.List(ctx, list, ctrlclient.InNamespace(instance.GetNamespace())); err != nil {
+		return false, err
+	}
+
+	for _, s := range list.Items {
+		if s.Spec.Config == nil {
+			continue
+		}
+
+		if _, ok := s.Spec.Config.MonitoredShards[instance.GetName()]; ok || s.Spec.Config.SelectsRedisShard(instance) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (r *RedisShardReconciler) setRedisRoles(ctx context.Context, key types.NamespacedName,
-	masterIndex, replicas int32, serviceName string, log logr.Logger) (*sharded.Shard, reconciler.Result) {
+	masterIndex, replicas int32, serviceName string, monitored bool, log logr.Logger) (*sharded.Shard, reconciler.Result) {
 	var masterHostPort string
 
 	redisURLs := make(map[string]string, replicas)
@@ -128,6 +169,32 @@ func (r *RedisShardReconciler) setRedisRoles(ctx context.Context, key types.Name
 		return shard, reconciler.Result{Error: err}
 	}
 
+	// A failover might have promoted a different server than the one in
+	// 'spec.masterIndex'. Use the current master, if any, so the slaves are
+	// initialized against it.
+	if err := shard.Discover(ctx, nil, sharded.ReplicationInfoDiscoveryOpt); err == nil {
+		if master, err := shard.GetMaster(); err == nil {
+			masterHostPort = master.ID()
+		} else if shard.IsInitialized() {
+			// The shard has lost its master. Initializing the server in 'spec.masterIndex'
+			// as master would wipe the data of the slaves if it has been restarted empty.
+			if monitored {
+				log.Info("waiting for sentinel to promote a new master")
+
+				return shard, reconciler.Result{Action: reconciler.ReturnAndRequeueAction, RequeueAfter: 10 * time.Second}
+			}
+
+			master, err := shard.PromoteSlave(ctx)
+			if err != nil {
+				log.Info("unable to promote a new master", "error", err.Error())
+
+				return shard, reconciler.Result{Action: reconciler.ReturnAndRequeueAction, RequeueAfter: 10 * time.Second}
+			}
+
+			masterHostPort = master.ID()
+		}
+	}
+
 	_, err = shard.Init(ctx, masterHostPort)
 	if err != nil {
 		log.Info("waiting for redis shard init")
@@ -138,19 +205,61 @@ func (r *RedisShardReconciler) setRedisRoles(ctx context.Context, key types.Name
 	return shard, reconciler.Result{}
 }
 
+// repairReplication verifies the replication links of the shard slaves and
+// points them to the current master if they replicate from a different address.
+// The links are only discovered, not repaired, when sentinel monitors the shard.
+func (r *RedisShardReconciler) repairReplication(ctx context.Context, shard *sharded.Shard, monitored bool, log logr.Logger) reconciler.Result {
+	if err := shard.Discover(ctx, nil, sharded.ReplicationInfoDiscoveryOpt); err != nil {
+		log.Info("waiting for redis shard discovery", "error", err.Error())
+
+		return reconciler.Result{Action: reconciler.ReturnAndRequeueAction, RequeueAfter: 10 * time.Second}
+	}
+
+	if monitored {
+		return reconciler.Result{}
+	}
+
+	changed, err := shard.RepairReplication(ctx)
+	if err != nil {
+		return reconciler.Result{Error: err}
+	}
+
+	if len(changed) > 0 {
+		log.Info("repaired redis shard replication", "servers", changed)
+
+		// refresh the replication info so the status reflects the new links
+		if err := shard.Discover(ctx, nil, sharded.ReplicationInfoDiscoveryOpt); err != nil {
+			return reconciler.Result{Error: err}
+		}
+	}
+
+	return reconciler.Result{}
+}
+
 func redisShardStatusReconciler(shard *sharded.Shard, instance *saasv1alpha1.RedisShard) (bool, error) {
 	shardNodes := &saasv1alpha1.RedisShardNodes{Master: map[string]string{}, Slaves: map[string]string{}}
+	replication := map[string]saasv1alpha1.RedisShardReplicationStatus{}
 
 	for _, server := range shard.Servers {
 		if server.Role == client.Master {
 			shardNodes.Master[server.GetAlias()] = server.ID()
 		} else if server.Role == client.Slave {
 			shardNodes.Slaves[server.GetAlias()] = server.ID()
+
+			if server.Replication != nil {
+				replication[server.GetAlias()] = saasv1alpha1.RedisShardReplicationStatus{
+					Master:         server.Replication.MasterHostPort(),
+					LinkStatus:     server.Replication.MasterLinkStatus,
+					SyncInProgress: server.Replication.SyncInProgress,
+				}
+			}
 		}
 	}
 
-	if !equality.Semantic.DeepEqual(instance.Status.ShardNodes, shardNodes) {
+	if !equality.Semantic.DeepEqual(instance.Status.ShardNodes, shardNodes) ||
+		!equality.Semantic.DeepEqual(instance.Status.Replication, replication) {
 		instance.Status.ShardNodes = shardNodes
+		instance.Status.Replication = replication
 
 		return true, nil
 	}
@@ -33,9 +33,11 @@ import (
 	"github.com/go-logr/logr"
 	"golang.org/x/time/rate"
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -53,6 +55,7 @@ type SentinelReconciler struct {
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=sentinels,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=sentinels/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=sentinels/finalizers,verbs=update
+// +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=redisshards,verbs=get;list;watch
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
@@ -82,6 +85,13 @@ func (r *SentinelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return result.Values()
 	}
 
+	redisShards, err := r.resolveRedisShards(ctx, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	gen.RedisShards = redisShards
+
 	clustermap, err := gen.ClusterTopology(ctx)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -216,11 +226,75 @@ func (r *SentinelReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return reconciler.SetupWithDynamicTypeWatches(r,
 		ctrl.NewControllerManagedBy(mgr).
 			For(&saasv1alpha1.Sentinel{}).
+			Watches(&saasv1alpha1.RedisShard{}, handler.EnqueueRequestsFromMapFunc(r.sentinelsForRedisShard)).
 			WatchesRawSource(source.Channel(r.SentinelEvents.GetChannel(), &handler.EnqueueRequestForObject{})).
 			WithOptions(controller.Options{RateLimiter: PermissiveRateLimiter()}),
 	)
 }
 
+// resolveRedisShards returns the RedisShard resources referenced
+// or selected by the Sentinel config
+func (r *SentinelReconciler) resolveRedisShards(ctx context.Context, instance *saasv1alpha1.Sentinel) ([]saasv1alpha1.RedisShard, error) {
+	shards := []saasv1alpha1.RedisShard{}
+	seen := map[string]bool{}
+
+	for _, name := range instance.Spec.Config.RedisShardRefs {
+		rs := &saasv1alpha1.RedisShard{}
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: instance.GetNamespace()}, rs); err != nil {
+			return nil, err
+		}
+
+		seen[rs.GetName()] = true
+		shards = append(shards, *rs)
+	}
+
+	if instance.Spec.Config.RedisShardSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(instance.Spec.Config.RedisShardSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		list := &saasv1alpha1.RedisShardList{}
+		if err := r.Client.List(ctx, list, client.InNamespace(instance.GetNamespace()),
+			client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, err
+		}
+
+		for _, rs := range list.Items {
+			if !seen[rs.GetName()] {
+				seen[rs.GetName()] = true
+				shards = append(shards, rs)
+			}
+		}
+	}
+
+	return shards, nil
+}
+
+// sentinelsForRedisShard maps a RedisShard to the Sentinel resources that
+// monitor it, so changes in the shard topology are propagated to sentinel
+func (r *SentinelReconciler) sentinelsForRedisShard(ctx context.Context, o client.Object) []reconcile.Request {
+	rs, ok := o.(*saasv1alpha1.RedisShard)
+	if !ok {
+		return nil
+	}
+
+	list := &saasv1alpha1.SentinelList{}
+	if err := r.Client.List(ctx, list, client.InNamespace(rs.GetNamespace())); err != nil {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for _, s := range list.Items {
+		if s.Spec.Config != nil && s.Spec.Config.SelectsRedisShard(rs) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&s)})
+		}
+	}
+
+	return requests
+}
+
 func PermissiveRateLimiter() workqueue.TypedRateLimiter[reconcile.Request] {
 	// return workqueue.DefaultControllerRateLimiter()
 	return workqueue.NewTypedMaxOfRateLimiter(
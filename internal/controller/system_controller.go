@@ -23,6 +23,7 @@ import (
 	"github.com/3scale-sre/basereconciler/util"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	"github.com/3scale-sre/saas-operator/internal/pkg/generators/system"
+	deployment_workload "github.com/3scale-sre/saas-operator/internal/pkg/workloads/deployment"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -31,21 +32,28 @@ import (
 // SystemReconciler reconciles a System object
 type SystemReconciler struct {
 	*reconciler.Reconciler
+	WorkloadOptions deployment_workload.Options
 }
 
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=systems,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=systems/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=systems/finalizers,verbs=update
+// +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=twemproxyconfigs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="apps",namespace=placeholder,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="monitoring.coreos.com",namespace=placeholder,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="autoscaling",namespace=placeholder,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="autoscaling.k8s.io",namespace=placeholder,resources=verticalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="keda.sh",namespace=placeholder,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="policy",namespace=placeholder,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="grafana.integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="external-secrets.io",namespace=placeholder,resources=externalsecrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="tekton.dev",namespace=placeholder,resources=tasks,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="tekton.dev",namespace=placeholder,resources=pipelines,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="gateway.networking.k8s.io",namespace=placeholder,resources=httproutes;grpcroutes;tlsroutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="networking.k8s.io",namespace=placeholder,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="route.openshift.io",namespace=placeholder,resources=routes;routes/custom-host,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -60,12 +68,45 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return result.Values()
 	}
 
-	gen, err := system.NewGenerator(instance.GetName(), instance.GetNamespace(), instance.Spec)
+	gen, err := system.NewGenerator(instance.GetName(), instance.GetNamespace(), instance.Spec, r.WorkloadOptions)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	// run the step schedules of the weighted canaries
+	ramps := newCanaryRamps(instance)
+	if gen.CanaryApp != nil {
+		gen.CanaryApp.Weight = ramps.Reconcile(ctx, gen.App.GetKey(), gen.CanaryApp.GetKey(), instance.Spec.App.Canary, gen.CanaryApp.Weight)
+	}
+
+	// run the automated analyses of the canaries
+	analyses := newCanaryAnalyses(instance)
+	if gen.CanaryApp != nil {
+		gen.CanaryApp.Abort = analyses.Reconcile(ctx, gen.App.GetKey(), gen.CanaryApp.GetKey(),
+			promoteCanary(instance.Spec.Image, &instance.Spec.App.Canary))
+	}
+	if gen.CanarySidekiqDefault != nil {
+		gen.CanarySidekiqDefault.Abort = analyses.Reconcile(ctx, gen.SidekiqDefault.GetKey(), gen.CanarySidekiqDefault.GetKey(),
+			promoteCanary(instance.Spec.Image, &instance.Spec.SidekiqDefault.Canary))
+	}
+	if gen.CanarySidekiqBilling != nil {
+		gen.CanarySidekiqBilling.Abort = analyses.Reconcile(ctx, gen.SidekiqBilling.GetKey(), gen.CanarySidekiqBilling.GetKey(),
+			promoteCanary(instance.Spec.Image, &instance.Spec.SidekiqBilling.Canary))
+	}
+	if gen.CanarySidekiqLow != nil {
+		gen.CanarySidekiqLow.Abort = analyses.Reconcile(ctx, gen.SidekiqLow.GetKey(), gen.CanarySidekiqLow.GetKey(),
+			promoteCanary(instance.Spec.Image, &instance.Spec.SidekiqLow.Canary))
+	}
+
 	resources, err := gen.Resources()
+	if err != nil {
+		return ctrl.Result{}, envoyConfigValidationFailed(ctx, r.Client, instance, err)
+	}
+
+	// keep the LoadBalancer Services that are no longer generated until their deletion is allowed
+	guard := newDeletionGuard(instance)
+
+	resources, err = guard.Reconcile(ctx, r.Client, instance, resources)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -77,6 +118,7 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	// reconcile the status
+	published := newPublishedEndpoints(instance, r.WorkloadOptions)
 	result = r.ReconcileStatus(ctx, instance,
 		[]types.NamespacedName{
 			gen.App.GetKey(),
@@ -91,12 +133,22 @@ func (r *SystemReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 
 			return []types.NamespacedName{gen.Searchd.GetKey()}
 		}(),
+		envoyConfigValid(instance), ramps.StatusMutator(), analyses.StatusMutator(),
+		published.StatusMutator(ctx, r.Client, &gen.App), guard.StatusMutator(),
+		vpaRecommendations(ctx, r.Client, instance, &gen.App, &gen.SidekiqDefault, &gen.SidekiqBilling, &gen.SidekiqLow),
 	)
 	if result.ShouldReturn() {
 		return result.Values()
 	}
 
-	return ctrl.Result{}, nil
+	// promote the canaries that passed the analysis
+	if err := analyses.Promote(ctx, r.Client, instance); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{
+		RequeueAfter: minRequeue(ramps.RequeueAfter(), analyses.RequeueAfter(), published.RequeueAfter()),
+	}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -43,6 +43,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
@@ -58,6 +59,7 @@ type TwemproxyConfigReconciler struct {
 // +kubebuilder:rbac:groups=saas.3scale.net,namespace=placeholder,resources=twemproxyconfigs/finalizers,verbs=update
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="core",namespace=placeholder,resources=pods,verbs=list;patch
+// +kubebuilder:rbac:groups="core",namespace=placeholder,resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups="grafana.integreatly.org",namespace=placeholder,resources=grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -105,13 +107,21 @@ func (r *TwemproxyConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	// Reconcile sentinel event watchers
 	eventWatchers := make([]threads.RunnableThread, 0, len(gen.Spec.SentinelURIs))
 
+	// Only failovers change the targeted masters, but read-write slaves
+	// might also be added or removed from the config when they go up or down
+	reconcileEvents := []string{"+switch-master"}
+	if instance.Spec.TargetsSlavesRW() {
+		reconcileEvents = append(reconcileEvents, "+sdown", "-sdown")
+	}
+
 	for _, uri := range gen.Spec.SentinelURIs {
 		watcher, err := events.NewSentinelEventWatcher(uri, instance, nil, false, r.Pool)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 
-		eventWatchers = append(eventWatchers, watcher)
+		eventWatchers = append(eventWatchers,
+			watcher.WithReconcileEvents(reconcileEvents...).WithDebounce(instance.Spec.SentinelEventsDebounce.Duration))
 	}
 
 	if err := r.SentinelEvents.ReconcileThreads(ctx, instance, eventWatchers, logger.WithName("event-watcher")); err != nil {
@@ -127,8 +137,9 @@ func (r *TwemproxyConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, err
 	}
 
-	// Reconcile periodically in case some event is lost ...
-	return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	// Topology changes are driven by sentinel events, but a full
+	// resync is periodically performed in case some event is lost ...
+	return ctrl.Result{RequeueAfter: instance.Spec.ResyncPeriod.Duration}, nil
 }
 
 func (r *TwemproxyConfigReconciler) reconcileConfigMap(ctx context.Context, owner client.Object,
@@ -279,6 +290,7 @@ func (r *TwemproxyConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&saasv1alpha1.TwemproxyConfig{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&grafanav1beta1.GrafanaDashboard{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.twemproxyConfigsForSecret)).
 		WatchesRawSource(source.Channel(r.SentinelEvents.GetChannel(), &handler.EnqueueRequestForObject{})).
 		WithOptions(controller.Options{
 			RateLimiter: PermissiveRateLimiter(),
@@ -287,3 +299,27 @@ func (r *TwemproxyConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		}).
 		Complete(r)
 }
+
+// twemproxyConfigsForSecret maps a Secret to the TwemproxyConfig resources that
+// read the redis password from it, so password rotations are propagated to the
+// generated twemproxy ConfigMaps
+func (r *TwemproxyConfigReconciler) twemproxyConfigsForSecret(ctx context.Context, o client.Object) []reconcile.Request {
+	list := &saasv1alpha1.TwemproxyConfigList{}
+	if err := r.Client.List(ctx, list, client.InNamespace(o.GetNamespace())); err != nil {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for _, tc := range list.Items {
+		for _, pool := range tc.Spec.ServerPools {
+			if pool.RedisAuth != nil && pool.RedisAuth.Name == o.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&tc)})
+
+				break
+			}
+		}
+	}
+
+	return requests
+}
@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/3scale-sre/basereconciler/reconciler"
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/vpa"
+	vpav1 "github.com/3scale-sre/saas-operator/internal/pkg/vpa/v1"
+	deployment_workload "github.com/3scale-sre/saas-operator/internal/pkg/workloads/deployment"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type statusWithResourceRecommendations interface {
+	GetResourceRecommendations(types.NamespacedName) []saasv1alpha1.ContainerResourceRecommendation
+	SetResourceRecommendations(types.NamespacedName, []saasv1alpha1.ContainerResourceRecommendation)
+}
+
+// vpaRecommendations returns a status mutator that copies the recommendations of the
+// VerticalPodAutoscalers of the given workloads to the status of each workload
+func vpaRecommendations(ctx context.Context, cl client.Client, instance client.Object,
+	workloads ...deployment_workload.DeploymentWorkload) func() (bool, error) {
+	return func() (bool, error) {
+		status, ok := instance.(reconciler.ObjectWithAppStatus).GetStatus().(statusWithResourceRecommendations)
+		if !ok {
+			return false, nil
+		}
+
+		changed := false
+
+		for _, w := range workloads {
+			var recommendations []saasv1alpha1.ContainerResourceRecommendation
+
+			if deployment_workload.VPASpec(w) != nil {
+				var err error
+				if recommendations, err = liveRecommendations(ctx, cl, w.GetKey()); err != nil {
+					// the recommendations are reported once the VerticalPodAutoscaler exists
+					logr.FromContextOrDiscard(ctx).V(1).Info("unable to get resource recommendations", "workload", w.GetKey().Name, "error", err.Error())
+
+					continue
+				}
+			}
+
+			if equality.Semantic.DeepEqual(status.GetResourceRecommendations(w.GetKey()), recommendations) {
+				continue
+			}
+
+			status.SetResourceRecommendations(w.GetKey(), recommendations)
+			changed = true
+		}
+
+		return changed, nil
+	}
+}
+
+// liveRecommendations reads the recommendations from the live VerticalPodAutoscaler
+// and the configured resource requests from the live Deployment
+func liveRecommendations(ctx context.Context, cl client.Client,
+	key types.NamespacedName) ([]saasv1alpha1.ContainerResourceRecommendation, error) {
+	v := &vpav1.VerticalPodAutoscaler{}
+	if err := cl.Get(ctx, key, v); err != nil {
+		return nil, err
+	}
+
+	dep := &appsv1.Deployment{}
+	if err := cl.Get(ctx, key, dep); err != nil {
+		return nil, err
+	}
+
+	return vpa.Recommendations(dep, v), nil
+}
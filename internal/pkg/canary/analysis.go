@@ -0,0 +1,202 @@
+package canary
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// analysisCheckInterval is the minimum time between two
+	// consecutive checks of the metrics of the canary
+	analysisCheckInterval = 30 * time.Second
+	// analysisWindow is the time window used to compute the
+	// rates in the analysis queries
+	analysisWindow = time.Minute
+	// defaultNoDataChecksLimit is the number of consecutive checks without
+	// metrics after which the canary is aborted
+	defaultNoDataChecksLimit int32 = 10
+	// canaryPodSelector matches the pods of the canary Deployment
+	canaryPodSelector = `namespace="$namespace",pod=~"$deployment-[a-z0-9]+-[a-z0-9]+",envoy_http_conn_manager_prefix!="admin"`
+	// canaryUpstreamSelector matches the canary upstream clusters
+	// in the envoy sidecars of the pods of the main Deployment
+	canaryUpstreamSelector = `namespace="$namespace",pod=~"$main-[a-z0-9]+-[a-z0-9]+",envoy_cluster_name=~".+_canary"`
+
+	defaultSuccessRateQuery = `100 * sum(rate(envoy_http_downstream_rq_xx{` + canaryPodSelector + `,envoy_response_code_class!="5"}[$interval]))` +
+		` / sum(rate(envoy_http_downstream_rq_total{` + canaryPodSelector + `}[$interval]))`
+	defaultLatencyQuery = `histogram_quantile(0.99, sum(rate(envoy_http_downstream_rq_time_bucket{` + canaryPodSelector + `}[$interval])) by (le))`
+
+	weightedSuccessRateQuery = `100 * sum(rate(envoy_cluster_upstream_rq_xx{` + canaryUpstreamSelector + `,envoy_response_code_class!="5"}[$interval]))` +
+		` / sum(rate(envoy_cluster_upstream_rq_total{` + canaryUpstreamSelector + `}[$interval]))`
+	weightedLatencyQuery = `histogram_quantile(0.99, sum(rate(envoy_cluster_upstream_rq_time_bucket{` + canaryUpstreamSelector + `}[$interval])) by (le))`
+)
+
+// Metrics holds the values of the canary metrics. A nil value means
+// that the metric is not checked or that there is no data for it.
+type Metrics struct {
+	// SuccessRate is the percentage of non 5xx responses
+	SuccessRate *float64
+	// Latency is the 99th percentile latency in milliseconds
+	Latency *float64
+}
+
+// MetricsFunc returns the current metrics of the canary
+type MetricsFunc func(context.Context) (Metrics, error)
+
+// AnalysisMetrics returns a MetricsFunc that queries Prometheus for the metrics that
+// the analysis has thresholds for. Weighted canaries receive their traffic from the
+// envoy sidecars of the main Deployment, so the default queries use the metrics of
+// the canary upstream clusters of the main pods for them.
+func AnalysisMetrics(a *saasv1alpha1.CanaryAnalysis, weighted bool, namespace, main, deployment string) MetricsFunc {
+	replacer := strings.NewReplacer("$namespace", namespace, "$deployment", deployment, "$main", main,
+		"$interval", promDuration(analysisWindow))
+
+	successRateQuery, latencyQuery := defaultSuccessRateQuery, defaultLatencyQuery
+	if weighted {
+		successRateQuery, latencyQuery = weightedSuccessRateQuery, weightedLatencyQuery
+	}
+
+	return func(ctx context.Context) (Metrics, error) {
+		m := Metrics{}
+
+		if a.MinSuccessRatePercent != nil {
+			v, ok, err := QueryScalar(ctx, a.PrometheusURL, replacer.Replace(ptr.Deref(a.SuccessRateQuery, successRateQuery)))
+			if err != nil {
+				return m, fmt.Errorf("unable to get canary success rate: %w", err)
+			}
+
+			if ok {
+				m.SuccessRate = &v
+			}
+		}
+
+		if a.MaxLatencyMilliseconds != nil {
+			v, ok, err := QueryScalar(ctx, a.PrometheusURL, replacer.Replace(ptr.Deref(a.LatencyQuery, latencyQuery)))
+			if err != nil {
+				return m, fmt.Errorf("unable to get canary latency: %w", err)
+			}
+
+			if ok {
+				m.Latency = &v
+			}
+		}
+
+		return m, nil
+	}
+}
+
+// Analyze checks the metrics of the canary against the thresholds of the analysis.
+// It returns the new status of the analysis and the time after which Analyze should
+// be called again, which is zero once a decision has been made. The canary is
+// aborted as soon as the number of failed checks, or of consecutive checks without
+// metrics, reaches its limit, and it is promoted if the interval ends without
+// reaching them. Canaries that patch the spec are never promoted, as only the
+// image can be promoted automatically.
+func Analyze(ctx context.Context, a *saasv1alpha1.CanaryAnalysis, c *saasv1alpha1.Canary, canaryName string,
+	status *saasv1alpha1.CanaryAnalysisStatus, now time.Time, metrics MetricsFunc) (*saasv1alpha1.CanaryAnalysisStatus, time.Duration, error) {
+	revision := AnalysisRevision(c)
+	if status == nil || status.Revision != revision || status.Canary != canaryName {
+		status = &saasv1alpha1.CanaryAnalysisStatus{
+			Canary:    canaryName,
+			Revision:  revision,
+			Phase:     saasv1alpha1.CanaryAnalysisProgressing,
+			StartTime: metav1.NewTime(now),
+		}
+	} else {
+		status = status.DeepCopy()
+	}
+
+	if status.IsFinished() {
+		return status, 0, nil
+	}
+
+	if status.LastCheckTime != nil {
+		if elapsed := now.Sub(status.LastCheckTime.Time); elapsed < analysisCheckInterval {
+			return status, analysisCheckInterval - elapsed, nil
+		}
+	}
+
+	m, err := metrics(ctx)
+	if err != nil {
+		return status, analysisCheckInterval, err
+	}
+
+	status.LastCheckTime = ptr.To(metav1.NewTime(now))
+
+	if m.SuccessRate == nil && m.Latency == nil {
+		status.NoDataChecks++
+		status.Message = "no metrics available for the canary"
+
+		if limit := ptr.Deref(a.NoDataChecksLimit, defaultNoDataChecksLimit); status.NoDataChecks >= limit {
+			status.Phase = saasv1alpha1.CanaryAnalysisAborted
+			status.Message = fmt.Sprintf("no metrics available for the canary after %d checks", limit)
+			status.DecisionTime = ptr.To(metav1.NewTime(now))
+
+			return status, 0, nil
+		}
+	} else {
+		status.Checks++
+		status.NoDataChecks = 0
+		status.Message = ""
+
+		failures := []string{}
+
+		if m.SuccessRate != nil {
+			status.SuccessRate = fmt.Sprintf("%.2f%%", *m.SuccessRate)
+			if *m.SuccessRate < float64(*a.MinSuccessRatePercent) {
+				failures = append(failures, fmt.Sprintf("success rate %.2f%% is below the %d%% threshold", *m.SuccessRate, *a.MinSuccessRatePercent))
+			}
+		}
+
+		if m.Latency != nil {
+			status.Latency = fmt.Sprintf("%.0fms", *m.Latency)
+			if *m.Latency > float64(*a.MaxLatencyMilliseconds) {
+				failures = append(failures, fmt.Sprintf("latency %.0fms is above the %dms threshold", *m.Latency, *a.MaxLatencyMilliseconds))
+			}
+		}
+
+		if len(failures) > 0 {
+			status.FailedChecks++
+			status.Message = strings.Join(failures, ", ")
+		}
+
+		if status.FailedChecks >= ptr.Deref(a.FailedChecksLimit, 1) {
+			status.Phase = saasv1alpha1.CanaryAnalysisAborted
+			status.DecisionTime = ptr.To(metav1.NewTime(now))
+
+			return status, 0, nil
+		}
+	}
+
+	// the analysis is extended until there is at least one check with metrics
+	if now.Sub(status.StartTime.Time) < a.Interval.Duration || status.Checks == 0 {
+		return status, analysisCheckInterval, nil
+	}
+
+	if len(c.Patches) > 0 {
+		status.Phase = saasv1alpha1.CanaryAnalysisSucceeded
+		status.Message = "canary passed the analysis, patches must be promoted manually"
+	} else {
+		status.Phase = saasv1alpha1.CanaryAnalysisPromoted
+		status.Message = "canary passed the analysis"
+	}
+
+	status.DecisionTime = ptr.To(metav1.NewTime(now))
+
+	return status, 0, nil
+}
+
+// AnalysisRevision returns a string that identifies the spec of the canary,
+// so the analysis is restarted when any field of the canary changes
+func AnalysisRevision(c *saasv1alpha1.Canary) string {
+	b, _ := json.Marshal(c)
+
+	return fmt.Sprintf("%x", sha256.Sum256(b))[:10]
+}
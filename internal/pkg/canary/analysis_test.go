@@ -0,0 +1,283 @@
+package canary
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/go-test/deep"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestAnalyze(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	analysis := &saasv1alpha1.CanaryAnalysis{
+		PrometheusURL:          "http://prometheus:9090",
+		Interval:               metav1.Duration{Duration: 10 * time.Minute},
+		MinSuccessRatePercent:  ptr.To[int32](99),
+		MaxLatencyMilliseconds: ptr.To[int32](500),
+	}
+	canary := &saasv1alpha1.Canary{ImageTag: ptr.To("v2"), Analysis: analysis}
+	revision := AnalysisRevision(canary)
+	withPatches := &saasv1alpha1.Canary{ImageTag: ptr.To("v2"), Analysis: analysis, Patches: []string{`[]`}}
+	withLimit := &saasv1alpha1.Canary{ImageTag: ptr.To("v2"), Analysis: &saasv1alpha1.CanaryAnalysis{
+		PrometheusURL:          "http://prometheus:9090",
+		Interval:               metav1.Duration{Duration: 10 * time.Minute},
+		MinSuccessRatePercent:  ptr.To[int32](99),
+		MaxLatencyMilliseconds: ptr.To[int32](500),
+		FailedChecksLimit:      ptr.To[int32](2),
+	}}
+	progressing := func(start, lastCheck time.Duration, checks, failed int32) *saasv1alpha1.CanaryAnalysisStatus {
+		return &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: revision, Phase: saasv1alpha1.CanaryAnalysisProgressing,
+			StartTime: metav1.NewTime(now.Add(-start)), LastCheckTime: ptr.To(metav1.NewTime(now.Add(-lastCheck))),
+			Checks: checks, FailedChecks: failed}
+	}
+	withNoData := func(s *saasv1alpha1.CanaryAnalysisStatus, noData int32) *saasv1alpha1.CanaryAnalysisStatus {
+		s.NoDataChecks = noData
+
+		return s
+	}
+	metrics := func(successRate, latency *float64, err error) MetricsFunc {
+		return func(context.Context) (Metrics, error) {
+			return Metrics{SuccessRate: successRate, Latency: latency}, err
+		}
+	}
+
+	type args struct {
+		c       *saasv1alpha1.Canary
+		status  *saasv1alpha1.CanaryAnalysisStatus
+		metrics MetricsFunc
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		want        *saasv1alpha1.CanaryAnalysisStatus
+		wantRequeue time.Duration
+		wantErr     bool
+	}{
+		{
+			name: "Starts the analysis",
+			args: args{
+				c:       canary,
+				metrics: metrics(ptr.To(99.9), ptr.To(120.0), nil),
+			},
+			want: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: revision, Phase: saasv1alpha1.CanaryAnalysisProgressing,
+				StartTime: metav1.NewTime(now), LastCheckTime: ptr.To(metav1.NewTime(now)), Checks: 1,
+				SuccessRate: "99.90%", Latency: "120ms"},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "Does not check the metrics before the check interval",
+			args: args{
+				c:       canary,
+				status:  progressing(time.Minute, 10*time.Second, 1, 0),
+				metrics: metrics(nil, nil, errors.New("should not be called")),
+			},
+			want:        progressing(time.Minute, 10*time.Second, 1, 0),
+			wantRequeue: 20 * time.Second,
+		},
+		{
+			name: "Aborts when the success rate is below the threshold",
+			args: args{
+				c:       canary,
+				status:  progressing(time.Minute, time.Minute, 1, 0),
+				metrics: metrics(ptr.To(90.0), ptr.To(120.0), nil),
+			},
+			want: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: revision, Phase: saasv1alpha1.CanaryAnalysisAborted,
+				StartTime: metav1.NewTime(now.Add(-time.Minute)), DecisionTime: ptr.To(metav1.NewTime(now)),
+				LastCheckTime: ptr.To(metav1.NewTime(now)), Checks: 2, FailedChecks: 1,
+				SuccessRate: "90.00%", Latency: "120ms", Message: "success rate 90.00% is below the 99% threshold"},
+			wantRequeue: 0,
+		},
+		{
+			name: "Keeps analysing until the failed checks limit is reached",
+			args: args{
+				c: withLimit,
+				status: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: AnalysisRevision(withLimit), Phase: saasv1alpha1.CanaryAnalysisProgressing,
+					StartTime: metav1.NewTime(now.Add(-time.Minute)), LastCheckTime: ptr.To(metav1.NewTime(now.Add(-time.Minute))), Checks: 1},
+				metrics: metrics(ptr.To(99.5), ptr.To(800.0), nil),
+			},
+			want: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: AnalysisRevision(withLimit), Phase: saasv1alpha1.CanaryAnalysisProgressing,
+				StartTime: metav1.NewTime(now.Add(-time.Minute)), LastCheckTime: ptr.To(metav1.NewTime(now)), Checks: 2, FailedChecks: 1,
+				SuccessRate: "99.50%", Latency: "800ms", Message: "latency 800ms is above the 500ms threshold"},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "Promotes the canary at the end of the interval",
+			args: args{
+				c:       canary,
+				status:  progressing(10*time.Minute, time.Minute, 19, 0),
+				metrics: metrics(ptr.To(100.0), ptr.To(100.0), nil),
+			},
+			want: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: revision, Phase: saasv1alpha1.CanaryAnalysisPromoted,
+				StartTime: metav1.NewTime(now.Add(-10 * time.Minute)), DecisionTime: ptr.To(metav1.NewTime(now)),
+				LastCheckTime: ptr.To(metav1.NewTime(now)), Checks: 20,
+				SuccessRate: "100.00%", Latency: "100ms", Message: "canary passed the analysis"},
+			wantRequeue: 0,
+		},
+		{
+			name: "Does not promote canaries with patches",
+			args: args{
+				c: withPatches,
+				status: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: AnalysisRevision(withPatches), Phase: saasv1alpha1.CanaryAnalysisProgressing,
+					StartTime: metav1.NewTime(now.Add(-10 * time.Minute)), Checks: 19},
+				metrics: metrics(ptr.To(100.0), nil, nil),
+			},
+			want: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: AnalysisRevision(withPatches), Phase: saasv1alpha1.CanaryAnalysisSucceeded,
+				StartTime: metav1.NewTime(now.Add(-10 * time.Minute)), DecisionTime: ptr.To(metav1.NewTime(now)),
+				LastCheckTime: ptr.To(metav1.NewTime(now)), Checks: 20,
+				SuccessRate: "100.00%", Message: "canary passed the analysis, patches must be promoted manually"},
+			wantRequeue: 0,
+		},
+		{
+			name: "Extends the analysis if there are no metrics",
+			args: args{
+				c:       canary,
+				status:  progressing(10*time.Minute, time.Minute, 0, 0),
+				metrics: metrics(nil, nil, nil),
+			},
+			want: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: revision, Phase: saasv1alpha1.CanaryAnalysisProgressing,
+				StartTime: metav1.NewTime(now.Add(-10 * time.Minute)), LastCheckTime: ptr.To(metav1.NewTime(now)),
+				NoDataChecks: 1, Message: "no metrics available for the canary"},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "Aborts when there are no metrics for too many checks",
+			args: args{
+				c:       canary,
+				status:  withNoData(progressing(5*time.Minute, time.Minute, 0, 0), 9),
+				metrics: metrics(nil, nil, nil),
+			},
+			want: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: revision, Phase: saasv1alpha1.CanaryAnalysisAborted,
+				StartTime: metav1.NewTime(now.Add(-5 * time.Minute)), DecisionTime: ptr.To(metav1.NewTime(now)),
+				LastCheckTime: ptr.To(metav1.NewTime(now)), NoDataChecks: 10,
+				Message: "no metrics available for the canary after 10 checks"},
+			wantRequeue: 0,
+		},
+		{
+			name: "Resets the checks without metrics when there are metrics",
+			args: args{
+				c:       canary,
+				status:  withNoData(progressing(time.Minute, time.Minute, 1, 0), 9),
+				metrics: metrics(ptr.To(100.0), ptr.To(100.0), nil),
+			},
+			want: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: revision, Phase: saasv1alpha1.CanaryAnalysisProgressing,
+				StartTime: metav1.NewTime(now.Add(-time.Minute)), LastCheckTime: ptr.To(metav1.NewTime(now)), Checks: 2,
+				SuccessRate: "100.00%", Latency: "100ms"},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "Keeps the decision",
+			args: args{
+				c: canary,
+				status: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: revision, Phase: saasv1alpha1.CanaryAnalysisAborted,
+					StartTime: metav1.NewTime(now.Add(-time.Hour))},
+				metrics: metrics(nil, nil, errors.New("should not be called")),
+			},
+			want: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: revision, Phase: saasv1alpha1.CanaryAnalysisAborted,
+				StartTime: metav1.NewTime(now.Add(-time.Hour))},
+			wantRequeue: 0,
+		},
+		{
+			name: "Restarts the analysis when the canary changes",
+			args: args{
+				c: canary,
+				status: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: "other", Phase: saasv1alpha1.CanaryAnalysisAborted,
+					StartTime: metav1.NewTime(now.Add(-time.Hour))},
+				metrics: metrics(nil, nil, nil),
+			},
+			want: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: revision, Phase: saasv1alpha1.CanaryAnalysisProgressing,
+				StartTime: metav1.NewTime(now), LastCheckTime: ptr.To(metav1.NewTime(now)), NoDataChecks: 1,
+				Message: "no metrics available for the canary"},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "Restarts the analysis when the canary is added back",
+			args: args{
+				c: canary,
+				status: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Phase: saasv1alpha1.CanaryAnalysisAborted,
+					StartTime: metav1.NewTime(now.Add(-time.Hour))},
+				metrics: metrics(ptr.To(100.0), ptr.To(100.0), nil),
+			},
+			want: &saasv1alpha1.CanaryAnalysisStatus{Canary: "canary", Revision: revision, Phase: saasv1alpha1.CanaryAnalysisProgressing,
+				StartTime: metav1.NewTime(now), LastCheckTime: ptr.To(metav1.NewTime(now)), Checks: 1,
+				SuccessRate: "100.00%", Latency: "100ms"},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "Returns an error if the metrics cannot be checked",
+			args: args{
+				c:       canary,
+				status:  progressing(time.Minute, time.Minute, 1, 0),
+				metrics: metrics(nil, nil, errors.New("connection refused")),
+			},
+			want:        progressing(time.Minute, time.Minute, 1, 0),
+			wantRequeue: 30 * time.Second,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, requeue, err := Analyze(context.TODO(), tt.args.c.Analysis, tt.args.c, "canary", tt.args.status, now, tt.args.metrics)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Analyze() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if diff := deep.Equal(got, tt.want); len(diff) > 0 {
+				t.Errorf("Analyze() got diff %v", diff)
+			}
+
+			if requeue != tt.wantRequeue {
+				t.Errorf("Analyze() requeue = %v, want %v", requeue, tt.wantRequeue)
+			}
+		})
+	}
+}
+
+func TestAnalysisMetrics(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("query") {
+		case `success{namespace="ns",deployment="canary",main="main"}[60s]`:
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"99.5"]}]}}`))
+		case `histogram_quantile(0.99, sum(rate(envoy_cluster_upstream_rq_time_bucket{namespace="ns",pod=~"main-[a-z0-9]+-[a-z0-9]+",envoy_cluster_name=~".+_canary"}[60s])) by (le))`:
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"120"]}]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[]}}`))
+		}
+	}))
+	defer srv.Close()
+
+	got, err := AnalysisMetrics(&saasv1alpha1.CanaryAnalysis{
+		PrometheusURL:          srv.URL,
+		MinSuccessRatePercent:  ptr.To[int32](99),
+		MaxLatencyMilliseconds: ptr.To[int32](500),
+		SuccessRateQuery:       ptr.To(`success{namespace="$namespace",deployment="$deployment",main="$main"}[$interval]`),
+	}, false, "ns", "main", "canary")(context.TODO())
+	if err != nil {
+		t.Fatalf("AnalysisMetrics() error = %v", err)
+	}
+
+	if diff := deep.Equal(got, Metrics{SuccessRate: ptr.To(99.5)}); len(diff) > 0 {
+		t.Errorf("AnalysisMetrics() got diff %v", diff)
+	}
+
+	got, err = AnalysisMetrics(&saasv1alpha1.CanaryAnalysis{
+		PrometheusURL:          srv.URL,
+		MaxLatencyMilliseconds: ptr.To[int32](500),
+	}, true, "ns", "main", "canary")(context.TODO())
+	if err != nil {
+		t.Fatalf("AnalysisMetrics() error = %v", err)
+	}
+
+	if diff := deep.Equal(got, Metrics{Latency: ptr.To(120.0)}); len(diff) > 0 {
+		t.Errorf("AnalysisMetrics() got diff %v", diff)
+	}
+}
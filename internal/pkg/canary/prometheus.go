@@ -0,0 +1,79 @@
+package canary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// queryTimeout is the maximum time to wait for Prometheus to answer a query
+const queryTimeout = 10 * time.Second
+
+type queryResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Data      struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Value [2]any `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// QueryScalar runs an instant query against the Prometheus server at the given
+// address and returns the value of the first sample of the resulting vector. The
+// second value returned is false if the query returns no samples.
+func QueryScalar(ctx context.Context, address, query string) (float64, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	u, err := url.JoinPath(address, "/api/v1/query")
+	if err != nil {
+		return 0, false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u+"?"+url.Values{"query": {query}}.Encode(), nil)
+	if err != nil {
+		return 0, false, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, false, err
+	}
+	defer resp.Body.Close()
+
+	qr := &queryResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(qr); err != nil {
+		return 0, false, fmt.Errorf("unable to decode prometheus response (HTTP %d): %w", resp.StatusCode, err)
+	}
+
+	if qr.Status != "success" {
+		return 0, false, fmt.Errorf("prometheus query failed: %s: %s", qr.ErrorType, qr.Error)
+	}
+
+	if qr.Data.ResultType != "vector" {
+		return 0, false, fmt.Errorf("unexpected prometheus result type '%s'", qr.Data.ResultType)
+	}
+
+	if len(qr.Data.Result) == 0 {
+		return 0, false, nil
+	}
+
+	s, ok := qr.Data.Result[0].Value[1].(string)
+	if !ok {
+		return 0, false, fmt.Errorf("unexpected prometheus sample value '%v'", qr.Data.Result[0].Value[1])
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return v, true, nil
+}
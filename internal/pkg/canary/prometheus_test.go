@@ -0,0 +1,64 @@
+package canary
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestQueryScalar(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     float64
+		wantOk   bool
+		wantErr  bool
+	}{
+		{
+			name:     "Returns the value of the first sample",
+			response: `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000.123,"2.5"]}]}}`,
+			want:     2.5,
+			wantOk:   true,
+		},
+		{
+			name:     "Returns not ok if there are no samples",
+			response: `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+			wantOk:   false,
+		},
+		{
+			name:     "Returns an error if the query fails",
+			response: `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+			wantErr:  true,
+		},
+		{
+			name:     "Returns an error if the result is not a vector",
+			response: `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/query" || r.URL.Query().Get("query") != "up" {
+					w.WriteHeader(http.StatusNotFound)
+
+					return
+				}
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer srv.Close()
+
+			got, ok, err := QueryScalar(context.TODO(), srv.URL, "up")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("QueryScalar() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("QueryScalar() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
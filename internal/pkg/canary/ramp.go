@@ -0,0 +1,133 @@
+package canary
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// defaultErrorRateWindow is the time window used to compute the
+	// error rate when the threshold does not specify one
+	defaultErrorRateWindow = time.Minute
+	// errorRateCheckInterval is the maximum time between two
+	// consecutive checks of the error rate of the canary
+	errorRateCheckInterval = 30 * time.Second
+)
+
+// ErrorRateFunc returns the percentage of requests sent to the canary that failed.
+// The second value returned is false if there is no traffic to compute it from.
+type ErrorRateFunc func(context.Context) (float64, bool, error)
+
+// ErrorRate returns an ErrorRateFunc that computes the percentage of 5xx responses
+// from the canary as seen by the envoy sidecars of the main Deployment
+func ErrorRate(threshold *saasv1alpha1.CanaryErrorRateThreshold, namespace, deployment string) ErrorRateFunc {
+	window := defaultErrorRateWindow
+	if threshold.Window != nil {
+		window = threshold.Window.Duration
+	}
+
+	selector := fmt.Sprintf(`namespace="%s",pod=~"%s-[a-z0-9]+-[a-z0-9]+",envoy_cluster_name=~".+_canary"`, namespace, deployment)
+	query := fmt.Sprintf(`100 * sum(rate(envoy_cluster_upstream_rq_xx{%s,envoy_response_code_class="5"}[%s])) / sum(rate(envoy_cluster_upstream_rq_total{%s}[%s]))`,
+		selector, promDuration(window), selector, promDuration(window))
+
+	return func(ctx context.Context) (float64, bool, error) {
+		return QueryScalar(ctx, threshold.PrometheusURL, query)
+	}
+}
+
+// Revision returns a string that identifies the version of the canary, so
+// the step schedule can be restarted when a new version is deployed
+func Revision(c *saasv1alpha1.Canary) string {
+	b, _ := json.Marshal(struct {
+		ImageName *string  `json:"imageName,omitempty"`
+		ImageTag  *string  `json:"imageTag,omitempty"`
+		Patches   []string `json:"patches,omitempty"`
+	}{c.ImageName, c.ImageTag, c.Patches})
+
+	return fmt.Sprintf("%x", sha256.Sum256(b))[:10]
+}
+
+// Ramp advances the step schedule of the canary. It returns the new status of
+// the schedule and the time after which Ramp should be called again, which
+// is zero once the last step has been reached. A nil status is returned if
+// the canary has no step schedule.
+func Ramp(ctx context.Context, c *saasv1alpha1.Canary, status *saasv1alpha1.CanaryStatus, now time.Time,
+	errorRate ErrorRateFunc) (*saasv1alpha1.CanaryStatus, time.Duration, error) {
+	if c == nil || len(c.Steps) == 0 {
+		return nil, 0, nil
+	}
+
+	revision := Revision(c)
+	if status == nil || status.Revision != revision || int(status.Step) >= len(c.Steps) {
+		status = &saasv1alpha1.CanaryStatus{
+			Revision:      revision,
+			Step:          0,
+			Weight:        c.Steps[0].Weight,
+			StepStartTime: metav1.NewTime(now),
+		}
+	} else {
+		status = status.DeepCopy()
+	}
+
+	last := int(status.Step) == len(c.Steps)-1
+	if last {
+		status.Paused = false
+		status.Message = ""
+
+		return status, 0, nil
+	}
+
+	if c.ErrorRateThreshold != nil && errorRate != nil {
+		rate, ok, err := errorRate(ctx)
+		if err != nil {
+			return status, errorRateCheckInterval, fmt.Errorf("unable to get canary error rate: %w", err)
+		}
+
+		if ok && rate > float64(c.ErrorRateThreshold.MaxErrorRatePercent) {
+			// the current step restarts once the error rate goes back
+			// below the threshold
+			status.Paused = true
+			status.Message = fmt.Sprintf("error rate %.2f%% is above the %d%% threshold",
+				rate, c.ErrorRateThreshold.MaxErrorRatePercent)
+			status.StepStartTime = metav1.NewTime(now)
+
+			return status, errorRateCheckInterval, nil
+		}
+
+		status.Paused = false
+		status.Message = ""
+	}
+
+	step := c.Steps[status.Step]
+	if elapsed := now.Sub(status.StepStartTime.Time); elapsed < step.Duration.Duration {
+		return status, requeueAfter(c, step.Duration.Duration-elapsed), nil
+	}
+
+	status.Step++
+	status.Weight = c.Steps[status.Step].Weight
+	status.StepStartTime = metav1.NewTime(now)
+
+	if int(status.Step) == len(c.Steps)-1 {
+		return status, 0, nil
+	}
+
+	return status, requeueAfter(c, c.Steps[status.Step].Duration.Duration), nil
+}
+
+func requeueAfter(c *saasv1alpha1.Canary, d time.Duration) time.Duration {
+	if c.ErrorRateThreshold != nil {
+		return min(d, errorRateCheckInterval)
+	}
+
+	return d
+}
+
+func promDuration(d time.Duration) string {
+	return fmt.Sprintf("%ds", int64(d.Seconds()))
+}
@@ -0,0 +1,157 @@
+package canary
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/go-test/deep"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestRamp(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	steps := []saasv1alpha1.CanaryStep{
+		{Weight: 10, Duration: metav1.Duration{Duration: 5 * time.Minute}},
+		{Weight: 50, Duration: metav1.Duration{Duration: 10 * time.Minute}},
+		{Weight: 100, Duration: metav1.Duration{Duration: time.Minute}},
+	}
+	canary := &saasv1alpha1.Canary{ImageTag: ptr.To("v2"), Steps: steps}
+	revision := Revision(canary)
+	withThreshold := &saasv1alpha1.Canary{ImageTag: ptr.To("v2"), Steps: steps,
+		ErrorRateThreshold: &saasv1alpha1.CanaryErrorRateThreshold{PrometheusURL: "http://prometheus:9090", MaxErrorRatePercent: 5}}
+	errorRate := func(rate float64, ok bool, err error) ErrorRateFunc {
+		return func(context.Context) (float64, bool, error) { return rate, ok, err }
+	}
+
+	type args struct {
+		c         *saasv1alpha1.Canary
+		status    *saasv1alpha1.CanaryStatus
+		errorRate ErrorRateFunc
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		want        *saasv1alpha1.CanaryStatus
+		wantRequeue time.Duration
+		wantErr     bool
+	}{
+		{
+			name: "No step schedule",
+			args: args{
+				c: &saasv1alpha1.Canary{Weight: ptr.To[int32](20)},
+			},
+			want:        nil,
+			wantRequeue: 0,
+		},
+		{
+			name: "Starts the schedule",
+			args: args{
+				c: canary,
+			},
+			want:        &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 10, Step: 0, StepStartTime: metav1.NewTime(now)},
+			wantRequeue: 5 * time.Minute,
+		},
+		{
+			name: "Keeps the step until its duration has elapsed",
+			args: args{
+				c:      canary,
+				status: &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 10, Step: 0, StepStartTime: metav1.NewTime(now.Add(-2 * time.Minute))},
+			},
+			want:        &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 10, Step: 0, StepStartTime: metav1.NewTime(now.Add(-2 * time.Minute))},
+			wantRequeue: 3 * time.Minute,
+		},
+		{
+			name: "Moves to the next step",
+			args: args{
+				c:      canary,
+				status: &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 10, Step: 0, StepStartTime: metav1.NewTime(now.Add(-6 * time.Minute))},
+			},
+			want:        &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 50, Step: 1, StepStartTime: metav1.NewTime(now)},
+			wantRequeue: 10 * time.Minute,
+		},
+		{
+			name: "Completes the schedule",
+			args: args{
+				c:      canary,
+				status: &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 50, Step: 1, StepStartTime: metav1.NewTime(now.Add(-10 * time.Minute))},
+			},
+			want:        &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 100, Step: 2, StepStartTime: metav1.NewTime(now)},
+			wantRequeue: 0,
+		},
+		{
+			name: "Restarts the schedule when the canary changes",
+			args: args{
+				c:      canary,
+				status: &saasv1alpha1.CanaryStatus{Revision: "other", Weight: 100, Step: 2, StepStartTime: metav1.NewTime(now.Add(-time.Hour))},
+			},
+			want:        &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 10, Step: 0, StepStartTime: metav1.NewTime(now)},
+			wantRequeue: 5 * time.Minute,
+		},
+		{
+			name: "Pauses when the error rate is above the threshold",
+			args: args{
+				c:         withThreshold,
+				status:    &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 10, Step: 0, StepStartTime: metav1.NewTime(now.Add(-6 * time.Minute))},
+				errorRate: errorRate(12.5, true, nil),
+			},
+			want: &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 10, Step: 0, StepStartTime: metav1.NewTime(now),
+				Paused: true, Message: "error rate 12.50% is above the 5% threshold"},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "Resumes when the error rate is below the threshold",
+			args: args{
+				c: withThreshold,
+				status: &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 10, Step: 0, StepStartTime: metav1.NewTime(now.Add(-6 * time.Minute)),
+					Paused: true, Message: "error rate 12.50% is above the 5% threshold"},
+				errorRate: errorRate(1, true, nil),
+			},
+			want:        &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 50, Step: 1, StepStartTime: metav1.NewTime(now)},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "Advances if there is no traffic to compute the error rate",
+			args: args{
+				c:         withThreshold,
+				status:    &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 10, Step: 0, StepStartTime: metav1.NewTime(now.Add(-6 * time.Minute))},
+				errorRate: errorRate(0, false, nil),
+			},
+			want:        &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 50, Step: 1, StepStartTime: metav1.NewTime(now)},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "Keeps the step if the error rate cannot be checked",
+			args: args{
+				c:         withThreshold,
+				status:    &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 10, Step: 0, StepStartTime: metav1.NewTime(now.Add(-6 * time.Minute))},
+				errorRate: errorRate(0, false, errors.New("connection refused")),
+			},
+			want:        &saasv1alpha1.CanaryStatus{Revision: revision, Weight: 10, Step: 0, StepStartTime: metav1.NewTime(now.Add(-6 * time.Minute))},
+			wantRequeue: 30 * time.Second,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, requeue, err := Ramp(context.TODO(), tt.args.c, tt.args.status, now, tt.args.errorRate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Ramp() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if diff := deep.Equal(got, tt.want); len(diff) > 0 {
+				t.Errorf("Ramp() got diff %v", diff)
+			}
+
+			if requeue != tt.wantRequeue {
+				t.Errorf("Ramp() requeue = %v, want %v", requeue, tt.wantRequeue)
+			}
+		})
+	}
+}
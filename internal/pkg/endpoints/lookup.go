@@ -0,0 +1,67 @@
+package endpoints
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CachedLookup returns a LookupFunc that keeps the results of the given lookup, failures
+// included, for the duration of the ttl, so the hostnames of the endpoints are not
+// resolved again on every reconcile. It is safe for concurrent use.
+func CachedLookup(lookup LookupFunc, ttl time.Duration) LookupFunc {
+	cache := &lookupCache{
+		lookup:  lookup,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]lookupResult{},
+	}
+
+	return cache.Lookup
+}
+
+type lookupResult struct {
+	addresses []string
+	err       error
+	expires   time.Time
+}
+
+type lookupCache struct {
+	lookup  LookupFunc
+	ttl     time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+	entries map[string]lookupResult
+}
+
+// Lookup returns the cached result for the host if it has not expired yet,
+// and resolves the host otherwise
+func (c *lookupCache) Lookup(ctx context.Context, host string) ([]string, error) {
+	c.mu.Lock()
+	result, ok := c.entries[host]
+	c.mu.Unlock()
+
+	if ok && c.now().Before(result.expires) {
+		return result.addresses, result.err
+	}
+
+	addresses, err := c.lookup(ctx, host)
+	// a cancelled context says nothing about the host, so the result is not kept
+	if ctx.Err() != nil {
+		return addresses, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for h, r := range c.entries {
+		if !now.Before(r.expires) {
+			delete(c.entries, h)
+		}
+	}
+
+	c.entries[host] = lookupResult{addresses: addresses, err: err, expires: now.Add(c.ttl)}
+
+	return addresses, err
+}
@@ -0,0 +1,65 @@
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCachedLookup(t *testing.T) {
+	calls := map[string]int{}
+	lookup := func(_ context.Context, host string) ([]string, error) {
+		calls[host]++
+		if host == "missing.example.com" {
+			return nil, errors.New("no such host")
+		}
+
+		return []string{"10.0.0.1"}, nil
+	}
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := &lookupCache{lookup: lookup, ttl: time.Minute, now: func() time.Time { return now }, entries: map[string]lookupResult{}}
+
+	for range 3 {
+		if got, err := cache.Lookup(context.TODO(), "api.example.com"); err != nil || !cmp.Equal(got, []string{"10.0.0.1"}) {
+			t.Errorf("lookupCache.Lookup() = %v, %v", got, err)
+		}
+
+		if _, err := cache.Lookup(context.TODO(), "missing.example.com"); err == nil {
+			t.Errorf("lookupCache.Lookup() expected the cached error")
+		}
+	}
+
+	if diff := cmp.Diff(calls, map[string]int{"api.example.com": 1, "missing.example.com": 1}); len(diff) > 0 {
+		t.Errorf("lookupCache.Lookup() calls diff %v", diff)
+	}
+
+	// the results are resolved again once expired, and the expired entries are dropped
+	now = now.Add(time.Minute)
+	if _, err := cache.Lookup(context.TODO(), "api.example.com"); err != nil {
+		t.Errorf("lookupCache.Lookup() error = %v", err)
+	}
+
+	if diff := cmp.Diff(calls, map[string]int{"api.example.com": 2, "missing.example.com": 1}); len(diff) > 0 {
+		t.Errorf("lookupCache.Lookup() calls diff %v", diff)
+	}
+
+	if _, ok := cache.entries["missing.example.com"]; ok {
+		t.Errorf("lookupCache.Lookup() expected the expired entries to be dropped")
+	}
+
+	// results of cancelled lookups are not cached
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	if _, err := cache.Lookup(ctx, "other.example.com"); err != nil {
+		t.Errorf("lookupCache.Lookup() error = %v", err)
+	}
+
+	if _, ok := cache.entries["other.example.com"]; ok {
+		t.Errorf("lookupCache.Lookup() expected the result of a cancelled lookup not to be cached")
+	}
+}
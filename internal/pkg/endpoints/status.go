@@ -0,0 +1,129 @@
+package endpoints
+
+import (
+	"context"
+	"net"
+	"time"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/3scale-sre/saas-operator/internal/pkg/workloads/deployment"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// lookupTimeout is the maximum time to wait for the resolution of a hostname
+const lookupTimeout = 2 * time.Second
+
+// LookupFunc resolves a hostname to its addresses
+type LookupFunc func(ctx context.Context, host string) ([]string, error)
+
+// DefaultLookup resolves hostnames with the default resolver
+func DefaultLookup(ctx context.Context, host string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, lookupTimeout)
+	defer cancel()
+
+	return net.DefaultResolver.LookupHost(ctx, host)
+}
+
+// Status returns the status of an endpoint of the given workload. The Service and the
+// routes of the endpoint are expected to be the live objects, so their status holds
+// the addresses the endpoint is published at. Hostnames that cannot be resolved are
+// reported as not resolving, instead of returning an error. The hostnames are not
+// reported while the endpoint has no addresses, as there is nothing to compare with.
+func Status(ctx context.Context, workload string, ep deployment.Endpoint, lookup LookupFunc) saasv1alpha1.EndpointStatus {
+	status := saasv1alpha1.EndpointStatus{
+		Workload: workload,
+		Name:     ep.EndpointName,
+		Strategy: ep.Strategy,
+		Service:  ep.Service.GetName(),
+		Ports: lo.Map(ep.Service.Spec.Ports, func(p corev1.ServicePort, _ int) saasv1alpha1.EndpointPortStatus {
+			return saasv1alpha1.EndpointPortStatus{Name: p.Name, Port: p.Port, Protocol: p.Protocol}
+		}),
+		Addresses: addresses(ep),
+	}
+
+	hostnames := Hostnames(ep.PublishingStrategy)
+	if len(hostnames) == 0 || len(status.Addresses) == 0 {
+		return status
+	}
+
+	// the addresses of the endpoint can be both hostnames and IPs
+	resolved := []string{}
+	for _, address := range status.Addresses {
+		if net.ParseIP(address) != nil {
+			resolved = append(resolved, address)
+		} else if ips, err := lookup(ctx, address); err == nil {
+			resolved = append(resolved, ips...)
+		}
+	}
+
+	status.Hostnames = lo.Map(hostnames, func(hostname string, _ int) saasv1alpha1.EndpointHostnameStatus {
+		ips, err := lookup(ctx, hostname)
+
+		return saasv1alpha1.EndpointHostnameStatus{
+			Hostname: hostname,
+			Resolves: err == nil && lo.Some(resolved, ips),
+		}
+	})
+
+	return status
+}
+
+// Hostnames returns the external DNS hostnames of the given publishing strategy
+func Hostnames(ps saasv1alpha1.PublishingStrategy) []string {
+	switch ps.Strategy {
+	case saasv1alpha1.SimpleStrategy:
+		if ps.Simple != nil {
+			return ps.Simple.ExternalDnsHostnames
+		}
+
+	case saasv1alpha1.Marin3rSidecarStrategy:
+		if ps.Marin3rSidecar != nil && ps.Marin3rSidecar.Simple != nil {
+			return ps.Marin3rSidecar.ExternalDnsHostnames
+		}
+
+	case saasv1alpha1.GatewayAPIStrategy:
+		if ps.GatewayAPI != nil {
+			return ps.GatewayAPI.Hostnames
+		}
+
+	case saasv1alpha1.IngressStrategy:
+		if ps.Ingress != nil {
+			return ps.Ingress.ExternalDnsHostnames
+		}
+	}
+
+	return nil
+}
+
+// addresses returns the addresses the endpoint is published at. Endpoints published
+// through Ingresses or Routes are reached through the ingress controller instead of the
+// Service. The addresses of Gateway API routes belong to the Gateway, so none is returned.
+func addresses(ep deployment.Endpoint) []string {
+	addresses := []string{}
+
+	if len(ep.Routes) == 0 {
+		for _, ingress := range ep.Service.Status.LoadBalancer.Ingress {
+			addresses = append(addresses, lo.Ternary(ingress.Hostname != "", ingress.Hostname, ingress.IP))
+		}
+	}
+
+	for _, route := range ep.Routes {
+		switch o := route.(type) {
+		case *networkingv1.Ingress:
+			for _, ingress := range o.Status.LoadBalancer.Ingress {
+				addresses = append(addresses, lo.Ternary(ingress.Hostname != "", ingress.Hostname, ingress.IP))
+			}
+		case *routev1.Route:
+			for _, ingress := range o.Status.Ingress {
+				if ingress.RouterCanonicalHostname != "" {
+					addresses = append(addresses, ingress.RouterCanonicalHostname)
+				}
+			}
+		}
+	}
+
+	return lo.Uniq(lo.Compact(addresses))
+}
@@ -0,0 +1,195 @@
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/service"
+	"github.com/3scale-sre/saas-operator/internal/pkg/workloads/deployment"
+	"github.com/google/go-cmp/cmp"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestStatus(t *testing.T) {
+	lookup := func(_ context.Context, host string) ([]string, error) {
+		records := map[string][]string{
+			"lb.elb.amazonaws.com":    {"10.0.0.1", "10.0.0.2"},
+			"api.example.com":         {"10.0.0.2"},
+			"old.example.com":         {"10.0.0.9"},
+			"router.apps.example.com": {"10.1.0.1"},
+			"mapping.example.com":     {"10.1.0.1"},
+		}
+		if ips, ok := records[host]; ok {
+			return ips, nil
+		}
+
+		return nil, errors.New("no such host")
+	}
+	svc := func(ingress ...corev1.LoadBalancerIngress) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "apicast-production-gateway-nlb"},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Name: "gateway-http", Port: 80, Protocol: corev1.ProtocolTCP}},
+			},
+			Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: ingress}},
+		}
+	}
+	ports := []saasv1alpha1.EndpointPortStatus{{Name: "gateway-http", Port: 80, Protocol: corev1.ProtocolTCP}}
+
+	tests := []struct {
+		name string
+		ep   deployment.Endpoint
+		want saasv1alpha1.EndpointStatus
+	}{
+		{
+			name: "Reports the load balancer of the Service and the resolution of the hostnames",
+			ep: deployment.Endpoint{
+				ServiceDescriptor: service.ServiceDescriptor{PublishingStrategy: saasv1alpha1.PublishingStrategy{
+					Strategy:     saasv1alpha1.SimpleStrategy,
+					EndpointName: "Gateway",
+					Simple: &saasv1alpha1.Simple{
+						ServiceType:          ptr.To(saasv1alpha1.ServiceTypeNLB),
+						ExternalDnsHostnames: []string{"api.example.com", "old.example.com", "missing.example.com"},
+					},
+				}},
+				Service: svc(corev1.LoadBalancerIngress{Hostname: "lb.elb.amazonaws.com"}),
+			},
+			want: saasv1alpha1.EndpointStatus{
+				Workload:  "apicast-production",
+				Name:      "Gateway",
+				Strategy:  saasv1alpha1.SimpleStrategy,
+				Service:   "apicast-production-gateway-nlb",
+				Ports:     ports,
+				Addresses: []string{"lb.elb.amazonaws.com"},
+				Hostnames: []saasv1alpha1.EndpointHostnameStatus{
+					{Hostname: "api.example.com", Resolves: true},
+					{Hostname: "old.example.com", Resolves: false},
+					{Hostname: "missing.example.com", Resolves: false},
+				},
+			},
+		},
+		{
+			name: "Does not report the hostnames until the load balancer is provisioned",
+			ep: deployment.Endpoint{
+				ServiceDescriptor: service.ServiceDescriptor{PublishingStrategy: saasv1alpha1.PublishingStrategy{
+					Strategy:     saasv1alpha1.Marin3rSidecarStrategy,
+					EndpointName: "Gateway",
+					Marin3rSidecar: &saasv1alpha1.Marin3rSidecarSpec{Simple: &saasv1alpha1.Simple{
+						ExternalDnsHostnames: []string{"api.example.com"},
+					}},
+				}},
+				Service: svc(),
+			},
+			want: saasv1alpha1.EndpointStatus{
+				Workload:  "apicast-production",
+				Name:      "Gateway",
+				Strategy:  saasv1alpha1.Marin3rSidecarStrategy,
+				Service:   "apicast-production-gateway-nlb",
+				Ports:     ports,
+				Addresses: []string{},
+			},
+		},
+		{
+			name: "Does not report the hostnames of Gateway API routes",
+			ep: deployment.Endpoint{
+				ServiceDescriptor: service.ServiceDescriptor{PublishingStrategy: saasv1alpha1.PublishingStrategy{
+					Strategy:     saasv1alpha1.GatewayAPIStrategy,
+					EndpointName: "Gateway",
+					GatewayAPI:   &saasv1alpha1.GatewayAPISpec{Hostnames: []string{"api.example.com"}},
+				}},
+				Service: svc(corev1.LoadBalancerIngress{Hostname: "lb.elb.amazonaws.com"}),
+				Routes:  []client.Object{&gatewayv1.HTTPRoute{}},
+			},
+			want: saasv1alpha1.EndpointStatus{
+				Workload:  "apicast-production",
+				Name:      "Gateway",
+				Strategy:  saasv1alpha1.GatewayAPIStrategy,
+				Service:   "apicast-production-gateway-nlb",
+				Ports:     ports,
+				Addresses: []string{},
+			},
+		},
+		{
+			name: "Reports the load balancer IPs",
+			ep: deployment.Endpoint{
+				ServiceDescriptor: service.ServiceDescriptor{PublishingStrategy: saasv1alpha1.PublishingStrategy{
+					Strategy:     saasv1alpha1.SimpleStrategy,
+					EndpointName: "Gateway",
+					Simple:       &saasv1alpha1.Simple{ExternalDnsHostnames: []string{"api.example.com"}},
+				}},
+				Service: svc(corev1.LoadBalancerIngress{IP: "10.0.0.2"}),
+			},
+			want: saasv1alpha1.EndpointStatus{
+				Workload:  "apicast-production",
+				Name:      "Gateway",
+				Strategy:  saasv1alpha1.SimpleStrategy,
+				Service:   "apicast-production-gateway-nlb",
+				Ports:     ports,
+				Addresses: []string{"10.0.0.2"},
+				Hostnames: []saasv1alpha1.EndpointHostnameStatus{{Hostname: "api.example.com", Resolves: true}},
+			},
+		},
+		{
+			name: "Reports the addresses of the Ingress instead of the Service",
+			ep: deployment.Endpoint{
+				ServiceDescriptor: service.ServiceDescriptor{PublishingStrategy: saasv1alpha1.PublishingStrategy{
+					Strategy:     saasv1alpha1.IngressStrategy,
+					EndpointName: "Gateway",
+					Ingress:      &saasv1alpha1.IngressSpec{ExternalDnsHostnames: []string{"api.example.com"}},
+				}},
+				Service: svc(corev1.LoadBalancerIngress{IP: "10.0.0.9"}),
+				Routes: []client.Object{&networkingv1.Ingress{Status: networkingv1.IngressStatus{
+					LoadBalancer: networkingv1.IngressLoadBalancerStatus{Ingress: []networkingv1.IngressLoadBalancerIngress{{Hostname: "lb.elb.amazonaws.com"}}},
+				}}},
+			},
+			want: saasv1alpha1.EndpointStatus{
+				Workload:  "apicast-production",
+				Name:      "Gateway",
+				Strategy:  saasv1alpha1.IngressStrategy,
+				Service:   "apicast-production-gateway-nlb",
+				Ports:     ports,
+				Addresses: []string{"lb.elb.amazonaws.com"},
+				Hostnames: []saasv1alpha1.EndpointHostnameStatus{{Hostname: "api.example.com", Resolves: true}},
+			},
+		},
+		{
+			name: "Reports the router of the OpenShift Routes",
+			ep: deployment.Endpoint{
+				ServiceDescriptor: service.ServiceDescriptor{PublishingStrategy: saasv1alpha1.PublishingStrategy{
+					Strategy:     saasv1alpha1.IngressStrategy,
+					EndpointName: "Gateway",
+					Ingress:      &saasv1alpha1.IngressSpec{ExternalDnsHostnames: []string{"mapping.example.com"}},
+				}},
+				Service: svc(),
+				Routes: []client.Object{&routev1.Route{Status: routev1.RouteStatus{
+					Ingress: []routev1.RouteIngress{{Host: "mapping.example.com", RouterCanonicalHostname: "router.apps.example.com"}},
+				}}},
+			},
+			want: saasv1alpha1.EndpointStatus{
+				Workload:  "apicast-production",
+				Name:      "Gateway",
+				Strategy:  saasv1alpha1.IngressStrategy,
+				Service:   "apicast-production-gateway-nlb",
+				Ports:     ports,
+				Addresses: []string{"router.apps.example.com"},
+				Hostnames: []saasv1alpha1.EndpointHostnameStatus{{Hostname: "mapping.example.com", Resolves: true}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Status(context.TODO(), "apicast-production", tt.ep, lookup)
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("Status() got diff %v", diff)
+			}
+		})
+	}
+}
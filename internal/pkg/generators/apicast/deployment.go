@@ -46,7 +46,12 @@ func (gen *EnvGenerator) deployment() *appsv1.Deployment {
 							ImagePullPolicy: *gen.Spec.Image.PullPolicy,
 						},
 					},
-					Affinity:                      pod.Affinity(gen.GetSelector(), gen.Spec.NodeAffinity),
+					Affinity:                      pod.Affinity(gen.GetSelector(), gen.Spec.NodeAffinity, *gen.Spec.Scheduling.PodAntiAffinity),
+					TopologySpreadConstraints:     pod.TopologySpreadConstraints(gen.GetSelector(), gen.Spec.Scheduling.TopologySpreadConstraints),
+					PriorityClassName:             ptr.Deref(gen.Spec.Scheduling.PriorityClassName, ""),
+					RuntimeClassName:              gen.Spec.Scheduling.RuntimeClassName,
+					SecurityContext:               pod.SecurityContext(gen.Spec.Scheduling.SecurityContext),
+					ServiceAccountName:            ptr.Deref(gen.Spec.Scheduling.ServiceAccountName, ""),
 					Tolerations:                   gen.Spec.Tolerations,
 					TerminationGracePeriodSeconds: ptr.To[int64](30),
 				},
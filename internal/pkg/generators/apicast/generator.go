@@ -28,6 +28,7 @@ const (
 // Generator configures the generators for Apicast
 type Generator struct {
 	generators.BaseOptionsV2
+	WorkloadOptions      deployment_workload.Options
 	Staging              EnvGenerator
 	CanaryStaging        *EnvGenerator
 	Production           EnvGenerator
@@ -37,7 +38,7 @@ type Generator struct {
 }
 
 // NewGenerator returns a new Options struct
-func NewGenerator(instance, namespace string, spec saasv1alpha1.ApicastSpec) (Generator, error) {
+func NewGenerator(instance, namespace string, spec saasv1alpha1.ApicastSpec, opts deployment_workload.Options) (Generator, error) {
 	generator := Generator{
 		BaseOptionsV2: generators.BaseOptionsV2{
 			Component:    apicast,
@@ -48,6 +49,7 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.ApicastSpec) (Ge
 				"threescale_component": apicast,
 			},
 		},
+		WorkloadOptions: opts,
 		Staging: EnvGenerator{
 			BaseOptionsV2: generators.BaseOptionsV2{
 				Component:    apicastStaging,
@@ -101,8 +103,10 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.ApicastSpec) (Ge
 			Spec:    canarySpec.Staging,
 			Options: config.NewEnvOptions(canarySpec.Staging, "staging"),
 			Traffic: canarySpec.Staging.Canary.SendTraffic,
+			Weight:  canarySpec.Staging.Canary.TrafficWeight(),
 		}
-		// Disable PDB and HPA for the canary Deployment
+		// Disable PDB and autoscaling for the canary Deployment
+		generator.CanaryStaging.Spec.VPA = nil
 		generator.CanaryStaging.Spec.HPA = &saasv1alpha1.HorizontalPodAutoscalerSpec{}
 		generator.CanaryStaging.Spec.PDB = &saasv1alpha1.PodDisruptionBudgetSpec{}
 	}
@@ -127,8 +131,10 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.ApicastSpec) (Ge
 			Spec:    canarySpec.Production,
 			Options: config.NewEnvOptions(canarySpec.Production, "production"),
 			Traffic: canarySpec.Production.Canary.SendTraffic,
+			Weight:  canarySpec.Production.Canary.TrafficWeight(),
 		}
-		// Disable PDB and HPA for the canary Deployment
+		// Disable PDB and autoscaling for the canary Deployment
+		generator.CanaryProduction.Spec.VPA = nil
 		generator.CanaryProduction.Spec.HPA = &saasv1alpha1.HorizontalPodAutoscalerSpec{}
 		generator.CanaryProduction.Spec.PDB = &saasv1alpha1.PodDisruptionBudgetSpec{}
 	}
@@ -138,12 +144,12 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.ApicastSpec) (Ge
 
 // Resources returns the list of resource templates
 func (gen *Generator) Resources() ([]resource.TemplateInterface, error) {
-	staging, err := deployment_workload.New(&gen.Staging, gen.CanaryStaging)
+	staging, err := deployment_workload.New(&gen.Staging, gen.CanaryStaging, gen.WorkloadOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	production, err := deployment_workload.New(&gen.Production, gen.CanaryProduction)
+	production, err := deployment_workload.New(&gen.Production, gen.CanaryProduction, gen.WorkloadOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -171,11 +177,16 @@ type EnvGenerator struct {
 	Spec    saasv1alpha1.ApicastEnvironmentSpec
 	Options pod.Options
 	Traffic bool
+	Weight  *int32
+	Abort   bool
 }
 
 // Validate that EnvGenerator implements deployment_workload.DeploymentWorkload interface
 var _ deployment_workload.DeploymentWorkload = &EnvGenerator{}
 
+// Validate that EnvGenerator implements deployment_workload.WithVerticalPodAutoscaler interface
+var _ deployment_workload.WithVerticalPodAutoscaler = &EnvGenerator{}
+
 // Validate that EnvGenerator implements deployment_workload.WithPublishingStrategies interface
 var _ deployment_workload.WithPublishingStrategies = &EnvGenerator{}
 
@@ -190,6 +201,9 @@ func (gen *EnvGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 func (gen *EnvGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.Spec.HPA
 }
+func (gen *EnvGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.Spec.VPA
+}
 func (gen *EnvGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.Spec.PDB
 }
@@ -200,7 +214,9 @@ func (gen *EnvGenerator) MonitoredEndpoints() []monitoringv1.PodMetricsEndpoint
 	}
 }
 
-func (gen *EnvGenerator) SendTraffic() bool { return gen.Traffic }
+func (gen *EnvGenerator) SendTraffic() bool     { return gen.Traffic }
+func (gen *EnvGenerator) TrafficWeight() *int32 { return gen.Weight }
+func (gen *EnvGenerator) Aborted() bool         { return gen.Abort }
 func (gen *EnvGenerator) TrafficSelector() map[string]string {
 	return map[string]string{
 		// This is purposely hardcoded as the TrafficSelector needs to be the same for all workloads produced
@@ -25,20 +25,26 @@ const (
 // Generator configures the generators for AutoSSL
 type Generator struct {
 	generators.BaseOptionsV2
-	Spec    saasv1alpha1.AutoSSLSpec
-	Options pod.Options
-	Canary  *Generator
-	Traffic bool
+	WorkloadOptions deployment_workload.Options
+	Spec            saasv1alpha1.AutoSSLSpec
+	Options         pod.Options
+	Canary          *Generator
+	Traffic         bool
+	Weight          *int32
+	Abort           bool
 }
 
 // Validate that Generator implements deployment_workload.DeploymentWorkload interface
 var _ deployment_workload.DeploymentWorkload = &Generator{}
 
+// Validate that Generator implements deployment_workload.WithVerticalPodAutoscaler interface
+var _ deployment_workload.WithVerticalPodAutoscaler = &Generator{}
+
 // Validate that Generator implements deployment_workload.WithTraffic interface
 var _ deployment_workload.WithPublishingStrategies = &Generator{}
 
 // NewGenerator returns a new Options struct
-func NewGenerator(instance, namespace string, spec saasv1alpha1.AutoSSLSpec) (Generator, error) {
+func NewGenerator(instance, namespace string, spec saasv1alpha1.AutoSSLSpec, opts deployment_workload.Options) (Generator, error) {
 	generator := Generator{
 		BaseOptionsV2: generators.BaseOptionsV2{
 			Component:    component,
@@ -49,9 +55,10 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.AutoSSLSpec) (Ge
 				"part-of": "3scale-saas",
 			},
 		},
-		Spec:    spec,
-		Options: config.NewOptions(spec),
-		Traffic: true,
+		WorkloadOptions: opts,
+		Spec:            spec,
+		Options:         config.NewOptions(spec),
+		Traffic:         true,
 	}
 
 	if spec.Canary != nil {
@@ -74,8 +81,10 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.AutoSSLSpec) (Ge
 			Spec:    *canarySpec,
 			Options: config.NewOptions(*canarySpec),
 			Traffic: spec.Canary.SendTraffic,
+			Weight:  spec.Canary.TrafficWeight(),
 		}
-		// Disable PDB and HPA for the canary Deployment
+		// Disable PDB and autoscaling for the canary Deployment
+		generator.Canary.Spec.VPA = nil
 		generator.Canary.Spec.HPA = &saasv1alpha1.HorizontalPodAutoscalerSpec{}
 		generator.Canary.Spec.PDB = &saasv1alpha1.PodDisruptionBudgetSpec{}
 	}
@@ -85,7 +94,7 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.AutoSSLSpec) (Ge
 
 // Resources returns the list of resource templates
 func (gen *Generator) Resources() ([]resource.TemplateInterface, error) {
-	workload, err := deployment_workload.New(gen, gen.Canary)
+	workload, err := deployment_workload.New(gen, gen.Canary, gen.WorkloadOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +108,9 @@ func (gen *Generator) Resources() ([]resource.TemplateInterface, error) {
 	return operatorutil.ConcatSlices(workload, misc), nil
 }
 
-func (gen *Generator) SendTraffic() bool { return gen.Traffic }
+func (gen *Generator) SendTraffic() bool     { return gen.Traffic }
+func (gen *Generator) TrafficWeight() *int32 { return gen.Weight }
+func (gen *Generator) Aborted() bool         { return gen.Abort }
 func (gen *Generator) TrafficSelector() map[string]string {
 	return map[string]string{
 		saasv1alpha1.GroupVersion.Group + "/traffic": component,
@@ -109,6 +120,9 @@ func (gen *Generator) TrafficSelector() map[string]string {
 // Validate that Generator implements deployment_workload.DeploymentWorkload interface
 var _ deployment_workload.DeploymentWorkload = &Generator{}
 
+// Validate that Generator implements deployment_workload.WithVerticalPodAutoscaler interface
+var _ deployment_workload.WithVerticalPodAutoscaler = &Generator{}
+
 func (gen *Generator) Deployment() *resource.Template[*appsv1.Deployment] {
 	return resource.NewTemplateFromObjectFunction(gen.deployment).
 		WithMutation(mutators.SetDeploymentReplicas(gen.Spec.HPA.IsDeactivated()))
@@ -117,6 +131,9 @@ func (gen *Generator) Deployment() *resource.Template[*appsv1.Deployment] {
 func (gen *Generator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.Spec.HPA
 }
+func (gen *Generator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.Spec.VPA
+}
 
 func (gen *Generator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.Spec.PDB
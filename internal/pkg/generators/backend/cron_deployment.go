@@ -34,7 +34,12 @@ func (gen *CronGenerator) deployment() *appsv1.Deployment {
 							ImagePullPolicy: *gen.Image.PullPolicy,
 						},
 					},
-					Affinity:                      pod.Affinity(gen.GetSelector(), gen.CronSpec.NodeAffinity),
+					Affinity:                      pod.Affinity(gen.GetSelector(), gen.CronSpec.NodeAffinity, *gen.CronSpec.Scheduling.PodAntiAffinity),
+					TopologySpreadConstraints:     pod.TopologySpreadConstraints(gen.GetSelector(), gen.CronSpec.Scheduling.TopologySpreadConstraints),
+					PriorityClassName:             ptr.Deref(gen.CronSpec.Scheduling.PriorityClassName, ""),
+					RuntimeClassName:              gen.CronSpec.Scheduling.RuntimeClassName,
+					SecurityContext:               pod.SecurityContext(gen.CronSpec.Scheduling.SecurityContext),
+					ServiceAccountName:            ptr.Deref(gen.CronSpec.Scheduling.ServiceAccountName, ""),
 					Tolerations:                   gen.CronSpec.Tolerations,
 					TerminationGracePeriodSeconds: ptr.To[int64](30),
 				},
@@ -13,6 +13,7 @@ import (
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/pod"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/podmonitor"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/service"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/twemproxy"
 	operatorutil "github.com/3scale-sre/saas-operator/internal/pkg/util"
 	deployment_workload "github.com/3scale-sre/saas-operator/internal/pkg/workloads/deployment"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -29,6 +30,7 @@ const (
 // Generator configures the generators for Backend
 type Generator struct {
 	generators.BaseOptionsV2
+	WorkloadOptions      deployment_workload.Options
 	Listener             ListenerGenerator
 	CanaryListener       *ListenerGenerator
 	Worker               WorkerGenerator
@@ -39,7 +41,7 @@ type Generator struct {
 }
 
 // NewGenerator returns a new Options struct
-func NewGenerator(instance, namespace string, spec saasv1alpha1.BackendSpec) (Generator, error) {
+func NewGenerator(instance, namespace string, spec saasv1alpha1.BackendSpec, opts deployment_workload.Options) (Generator, error) {
 	generator := Generator{
 		BaseOptionsV2: generators.BaseOptionsV2{
 			Component:    component,
@@ -50,6 +52,7 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.BackendSpec) (Ge
 				"threescale_component": component,
 			},
 		},
+		WorkloadOptions: opts,
 		Listener: ListenerGenerator{
 			BaseOptionsV2: generators.BaseOptionsV2{
 				Component:    strings.Join([]string{component, listener}, "-"),
@@ -123,9 +126,11 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.BackendSpec) (Ge
 			Image:         *canarySpec.Image,
 			Options:       config.NewListenerOptions(*canarySpec),
 			Traffic:       spec.Listener.Canary.SendTraffic,
+			Weight:        spec.Listener.Canary.TrafficWeight(),
 			TwemproxySpec: canarySpec.Twemproxy,
 		}
-		// Disable PDB and HPA for the canary Deployment
+		// Disable PDB and autoscaling for the canary Deployment
+		generator.CanaryListener.ListenerSpec.VPA = nil
 		generator.CanaryListener.ListenerSpec.HPA = &saasv1alpha1.HorizontalPodAutoscalerSpec{}
 		generator.CanaryListener.ListenerSpec.PDB = &saasv1alpha1.PodDisruptionBudgetSpec{}
 	}
@@ -152,7 +157,9 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.BackendSpec) (Ge
 			Options:       config.NewWorkerOptions(*canarySpec),
 			TwemproxySpec: canarySpec.Twemproxy,
 		}
-		// Disable PDB and HPA for the canary Deployment
+		// Disable PDB and autoscaling for the canary Deployment
+		generator.CanaryWorker.WorkerSpec.KEDA = nil
+		generator.CanaryWorker.WorkerSpec.VPA = nil
 		generator.CanaryWorker.WorkerSpec.HPA = &saasv1alpha1.HorizontalPodAutoscalerSpec{}
 		generator.CanaryWorker.WorkerSpec.PDB = &saasv1alpha1.PodDisruptionBudgetSpec{}
 	}
@@ -162,17 +169,17 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.BackendSpec) (Ge
 
 // Resources returns the list of resource templates
 func (gen *Generator) Resources() ([]resource.TemplateInterface, error) {
-	listener_resources, err := deployment_workload.New(&gen.Listener, gen.CanaryListener)
+	listener_resources, err := deployment_workload.New(&gen.Listener, gen.CanaryListener, gen.WorkloadOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	worker_resources, err := deployment_workload.New(&gen.Worker, gen.CanaryWorker)
+	worker_resources, err := deployment_workload.New(&gen.Worker, gen.CanaryWorker, gen.WorkloadOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	cron_resources, err := deployment_workload.New(&gen.Cron, nil)
+	cron_resources, err := deployment_workload.New(&gen.Cron, nil, gen.WorkloadOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +195,18 @@ func (gen *Generator) Resources() ([]resource.TemplateInterface, error) {
 			WithEnabled(!gen.grafanaDashboardSpec.IsDeactivated()),
 	}
 
+	// The listener and the worker share the same TwemproxySpec
+	twemproxySpecs := []*saasv1alpha1.TwemproxySpec{gen.Listener.TwemproxySpec}
+	if gen.CanaryListener != nil {
+		twemproxySpecs = append(twemproxySpecs, gen.CanaryListener.TwemproxySpec)
+	}
+
+	if gen.CanaryWorker != nil {
+		twemproxySpecs = append(twemproxySpecs, gen.CanaryWorker.TwemproxySpec)
+	}
+
+	misc = append(misc, twemproxy.DerivedTwemproxyConfigs(gen.GetNamespace(), gen.GetLabels(), twemproxySpecs...)...)
+
 	return operatorutil.ConcatSlices(listener_resources, worker_resources, cron_resources, externalsecrets, misc), nil
 }
 
@@ -199,12 +218,17 @@ type ListenerGenerator struct {
 	ListenerSpec  saasv1alpha1.ListenerSpec
 	Options       pod.Options
 	Traffic       bool
+	Weight        *int32
+	Abort         bool
 	TwemproxySpec *saasv1alpha1.TwemproxySpec
 }
 
 // Validate that ListenerGenerator implements deployment_workload.DeploymentWorkloadWithTraffic interface
 var _ deployment_workload.DeploymentWorkload = &ListenerGenerator{}
 
+// Validate that ListenerGenerator implements deployment_workload.WithVerticalPodAutoscaler interface
+var _ deployment_workload.WithVerticalPodAutoscaler = &ListenerGenerator{}
+
 // Validate that ListenerGenerator implements deployment_workload.WithPublishingStrategies interface
 var _ deployment_workload.WithPublishingStrategies = &ListenerGenerator{}
 
@@ -220,6 +244,9 @@ func (gen *ListenerGenerator) Deployment() *resource.Template[*appsv1.Deployment
 func (gen *ListenerGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.ListenerSpec.HPA
 }
+func (gen *ListenerGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.ListenerSpec.VPA
+}
 func (gen *ListenerGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.ListenerSpec.PDB
 }
@@ -234,7 +261,9 @@ func (gen *ListenerGenerator) MonitoredEndpoints() []monitoringv1.PodMetricsEndp
 
 	return pmes
 }
-func (gen *ListenerGenerator) SendTraffic() bool { return gen.Traffic }
+func (gen *ListenerGenerator) SendTraffic() bool     { return gen.Traffic }
+func (gen *ListenerGenerator) TrafficWeight() *int32 { return gen.Weight }
+func (gen *ListenerGenerator) Aborted() bool         { return gen.Abort }
 func (gen *ListenerGenerator) TrafficSelector() map[string]string {
 	return map[string]string{
 		// This is purposely hardcoded as the TrafficSelector needs to be the same for all workloads produced
@@ -258,19 +287,37 @@ type WorkerGenerator struct {
 	WorkerSpec    saasv1alpha1.WorkerSpec
 	Options       pod.Options
 	TwemproxySpec *saasv1alpha1.TwemproxySpec
+	Abort         bool
 }
 
 // Validate that WorkerGenerator implements deployment_workload.DeploymentWorkload interface
 var _ deployment_workload.DeploymentWorkload = &WorkerGenerator{}
 
+// Validate that WorkerGenerator implements deployment_workload.WithVerticalPodAutoscaler interface
+var _ deployment_workload.WithVerticalPodAutoscaler = &WorkerGenerator{}
+
+// Validate that WorkerGenerator implements deployment_workload.WithCanaryAnalysis interface
+var _ deployment_workload.WithCanaryAnalysis = &WorkerGenerator{}
+
+// Validate that WorkerGenerator implements deployment_workload.WithKEDAScaledObject interface
+var _ deployment_workload.WithKEDAScaledObject = &WorkerGenerator{}
+
+func (gen *WorkerGenerator) Aborted() bool { return gen.Abort }
+
 func (gen *WorkerGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 	return resource.NewTemplateFromObjectFunction(gen.deployment).
-		WithMutation(mutators.SetDeploymentReplicas(gen.WorkerSpec.HPA.IsDeactivated())).
+		WithMutation(mutators.SetDeploymentReplicas(gen.WorkerSpec.HPA.IsDeactivated() && gen.WorkerSpec.KEDA == nil)).
 		WithMutations(gen.Options.GenerateRolloutTriggers())
 }
 func (gen *WorkerGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.WorkerSpec.HPA
 }
+func (gen *WorkerGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.WorkerSpec.VPA
+}
+func (gen *WorkerGenerator) KEDASpec() *saasv1alpha1.KEDAScaledObjectSpec {
+	return gen.WorkerSpec.KEDA
+}
 func (gen *WorkerGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.WorkerSpec.PDB
 }
@@ -297,6 +344,9 @@ type CronGenerator struct {
 // Validate that CronGenerator implements deployment_workload.DeploymentWorkload interface
 var _ deployment_workload.DeploymentWorkload = &CronGenerator{}
 
+// Validate that CronGenerator implements deployment_workload.WithVerticalPodAutoscaler interface
+var _ deployment_workload.WithVerticalPodAutoscaler = &CronGenerator{}
+
 func (gen *CronGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 	return resource.NewTemplateFromObjectFunction(gen.deployment).
 		WithMutations(gen.Options.GenerateRolloutTriggers())
@@ -304,6 +354,9 @@ func (gen *CronGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 func (gen *CronGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return &saasv1alpha1.HorizontalPodAutoscalerSpec{}
 }
+func (gen *CronGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.CronSpec.VPA
+}
 func (gen *CronGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return &saasv1alpha1.PodDisruptionBudgetSpec{}
 }
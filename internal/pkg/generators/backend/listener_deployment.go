@@ -51,7 +51,12 @@ func (gen *ListenerGenerator) deployment() *appsv1.Deployment {
 						},
 					},
 					RestartPolicy:                 corev1.RestartPolicyAlways,
-					Affinity:                      pod.Affinity(gen.GetSelector(), gen.ListenerSpec.NodeAffinity),
+					Affinity:                      pod.Affinity(gen.GetSelector(), gen.ListenerSpec.NodeAffinity, *gen.ListenerSpec.Scheduling.PodAntiAffinity),
+					TopologySpreadConstraints:     pod.TopologySpreadConstraints(gen.GetSelector(), gen.ListenerSpec.Scheduling.TopologySpreadConstraints),
+					PriorityClassName:             ptr.Deref(gen.ListenerSpec.Scheduling.PriorityClassName, ""),
+					RuntimeClassName:              gen.ListenerSpec.Scheduling.RuntimeClassName,
+					SecurityContext:               pod.SecurityContext(gen.ListenerSpec.Scheduling.SecurityContext),
+					ServiceAccountName:            ptr.Deref(gen.ListenerSpec.Scheduling.ServiceAccountName, ""),
 					Tolerations:                   gen.ListenerSpec.Tolerations,
 					TerminationGracePeriodSeconds: ptr.To[int64](30),
 				},
@@ -40,7 +40,12 @@ func (gen *WorkerGenerator) deployment() *appsv1.Deployment {
 							ReadinessProbe:  pod.HTTPProbe("/metrics", intstr.FromString("metrics"), corev1.URISchemeHTTP, *gen.WorkerSpec.ReadinessProbe),
 						},
 					},
-					Affinity:                      pod.Affinity(gen.GetSelector(), gen.WorkerSpec.NodeAffinity),
+					Affinity:                      pod.Affinity(gen.GetSelector(), gen.WorkerSpec.NodeAffinity, *gen.WorkerSpec.Scheduling.PodAntiAffinity),
+					TopologySpreadConstraints:     pod.TopologySpreadConstraints(gen.GetSelector(), gen.WorkerSpec.Scheduling.TopologySpreadConstraints),
+					PriorityClassName:             ptr.Deref(gen.WorkerSpec.Scheduling.PriorityClassName, ""),
+					RuntimeClassName:              gen.WorkerSpec.Scheduling.RuntimeClassName,
+					SecurityContext:               pod.SecurityContext(gen.WorkerSpec.Scheduling.SecurityContext),
+					ServiceAccountName:            ptr.Deref(gen.WorkerSpec.Scheduling.ServiceAccountName, ""),
 					Tolerations:                   gen.WorkerSpec.Tolerations,
 					TerminationGracePeriodSeconds: ptr.To[int64](30),
 				},
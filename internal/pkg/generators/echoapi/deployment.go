@@ -43,7 +43,12 @@ func (gen *Generator) deployment() *appsv1.Deployment {
 							ReadinessProbe:  pod.HTTPProbe("/", intstr.FromInt(9292), corev1.URISchemeHTTP, *gen.Spec.ReadinessProbe),
 						},
 					},
-					Affinity:                      pod.Affinity(gen.GetSelector(), gen.Spec.NodeAffinity),
+					Affinity:                      pod.Affinity(gen.GetSelector(), gen.Spec.NodeAffinity, *gen.Spec.Scheduling.PodAntiAffinity),
+					TopologySpreadConstraints:     pod.TopologySpreadConstraints(gen.GetSelector(), gen.Spec.Scheduling.TopologySpreadConstraints),
+					PriorityClassName:             ptr.Deref(gen.Spec.Scheduling.PriorityClassName, ""),
+					RuntimeClassName:              gen.Spec.Scheduling.RuntimeClassName,
+					SecurityContext:               pod.SecurityContext(gen.Spec.Scheduling.SecurityContext),
+					ServiceAccountName:            ptr.Deref(gen.Spec.Scheduling.ServiceAccountName, ""),
 					Tolerations:                   gen.Spec.Tolerations,
 					TerminationGracePeriodSeconds: ptr.To[int64](30),
 				},
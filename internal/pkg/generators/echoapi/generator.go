@@ -20,18 +20,22 @@ const (
 // Generator configures the generators for EchoAPI
 type Generator struct {
 	generators.BaseOptionsV2
-	Spec    saasv1alpha1.EchoAPISpec
-	Traffic bool
+	WorkloadOptions deployment_workload.Options
+	Spec            saasv1alpha1.EchoAPISpec
+	Traffic         bool
 }
 
 // Validate that Generator implements deployment_workload.DeploymentWorkload interface
 var _ deployment_workload.DeploymentWorkload = &Generator{}
 
+// Validate that Generator implements deployment_workload.WithVerticalPodAutoscaler interface
+var _ deployment_workload.WithVerticalPodAutoscaler = &Generator{}
+
 // Validate that Generator implements deployment_workload.WithTWithPublishingStrategiesraffic interface
 var _ deployment_workload.WithPublishingStrategies = &Generator{}
 
 // NewGenerator returns a new Options struct
-func NewGenerator(instance, namespace string, spec saasv1alpha1.EchoAPISpec) Generator {
+func NewGenerator(instance, namespace string, spec saasv1alpha1.EchoAPISpec, opts deployment_workload.Options) Generator {
 	return Generator{
 		BaseOptionsV2: generators.BaseOptionsV2{
 			Component:    component,
@@ -42,14 +46,15 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.EchoAPISpec) Gen
 				"part-of": "3scale-saas",
 			},
 		},
-		Spec:    spec,
-		Traffic: true,
+		WorkloadOptions: opts,
+		Spec:            spec,
+		Traffic:         true,
 	}
 }
 
 // Resources returns the list of resource templates
 func (gen *Generator) Resources() ([]resource.TemplateInterface, error) {
-	workload, err := deployment_workload.New(gen, nil)
+	workload, err := deployment_workload.New(gen, nil, gen.WorkloadOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +70,9 @@ func (gen *Generator) Deployment() *resource.Template[*appsv1.Deployment] {
 func (gen *Generator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.Spec.HPA
 }
+func (gen *Generator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.Spec.VPA
+}
 
 func (gen *Generator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.Spec.PDB
@@ -1,11 +1,21 @@
 package redisshard
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/3scale-sre/basereconciler/util"
 	"github.com/MakeNowJust/heredoc"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+var (
+	redisDefaultConfig      = map[string]string{"tcp-keepalive": "60"}
+	managedConfigDirectives = map[string]bool{"slaveof": true, "replicaof": true}
+)
+
 func (gen *Generator) redisConfigConfigMap() *corev1.ConfigMap {
 	return &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -14,14 +24,38 @@ func (gen *Generator) redisConfigConfigMap() *corev1.ConfigMap {
 			Labels:    gen.GetLabels(),
 		},
 		Data: map[string]string{
-			"redis.conf": heredoc.Doc(`
-					slaveof 127.0.0.1 6379
-					tcp-keepalive 60
-				`),
+			"redis.conf": gen.redisConfig(),
 		},
 	}
 }
 
+// redisConfig renders the redis.conf file, merging the user provided
+// directives with the defaults. Directives are sorted to get a stable output.
+func (gen *Generator) redisConfig() string {
+	directives := util.MergeMaps(map[string]string{}, redisDefaultConfig, gen.Config)
+
+	keys := make([]string, 0, len(directives))
+	for key := range directives {
+		// replication is managed by the operator
+		if managedConfigDirectives[strings.ToLower(key)] {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	// all servers start as slaves of themselves until the operator
+	// configures the replication
+	config := "slaveof 127.0.0.1 6379\n"
+	for _, key := range keys {
+		config += fmt.Sprintf("%s %s\n", key, directives[key])
+	}
+
+	return config
+}
+
 func (gen *Generator) redisReadinessScriptConfigMap() *corev1.ConfigMap {
 	return &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
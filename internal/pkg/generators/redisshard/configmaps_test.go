@@ -0,0 +1,65 @@
+package redisshard
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/MakeNowJust/heredoc"
+)
+
+func TestGenerator_redisConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		spec saasv1alpha1.RedisShardSpec
+		want string
+	}{
+		{
+			name: "Renders the default config",
+			spec: saasv1alpha1.RedisShardSpec{},
+			want: heredoc.Doc(`
+				slaveof 127.0.0.1 6379
+				tcp-keepalive 60
+			`),
+		},
+		{
+			name: "Renders custom directives sorted and overrides defaults",
+			spec: saasv1alpha1.RedisShardSpec{
+				Config: map[string]string{
+					"tcp-keepalive": "30",
+					"appendonly":    "yes",
+					"save":          `""`,
+					"maxmemory":     "1gb",
+				},
+			},
+			want: heredoc.Doc(`
+				slaveof 127.0.0.1 6379
+				appendonly yes
+				maxmemory 1gb
+				save ""
+				tcp-keepalive 30
+			`),
+		},
+		{
+			name: "Ignores replication directives",
+			spec: saasv1alpha1.RedisShardSpec{
+				Config: map[string]string{
+					"slaveof":   "10.0.0.1 6379",
+					"REPLICAOF": "10.0.0.1 6379",
+				},
+			},
+			want: heredoc.Doc(`
+				slaveof 127.0.0.1 6379
+				tcp-keepalive 60
+			`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.Default()
+			gen := NewGenerator("test", "test", tt.spec)
+			if got := gen.redisConfig(); got != tt.want {
+				t.Errorf("Generator.redisConfig() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
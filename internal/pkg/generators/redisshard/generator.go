@@ -6,6 +6,9 @@ import (
 	"github.com/3scale-sre/basereconciler/resource"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	"github.com/3scale-sre/saas-operator/internal/pkg/generators"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/pdb"
+	corev1 "k8s.io/api/core/v1"
+	res "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -16,10 +19,18 @@ const (
 // Generator configures the generators for RedisShard
 type Generator struct {
 	generators.BaseOptionsV2
-	Image       saasv1alpha1.ImageSpec
-	MasterIndex int32
-	Replicas    int32
-	Command     string
+	Image                saasv1alpha1.ImageSpec
+	MasterIndex          int32
+	Replicas             int32
+	Command              string
+	StorageClass         *string
+	StorageSize          *res.Quantity
+	Config               map[string]string
+	PDB                  saasv1alpha1.PodDisruptionBudgetSpec
+	ResourceRequirements saasv1alpha1.ResourceRequirementsSpec
+	NodeAffinity         *corev1.NodeAffinity
+	Tolerations          []corev1.Toleration
+	Scheduling           saasv1alpha1.SchedulingSpec
 }
 
 // GetKey returns a types.NamespacedName for the RedisShard StatefulSet
@@ -49,10 +60,18 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.RedisShardSpec)
 				"part-of": "3scale-saas-testing",
 			},
 		},
-		Image:       *spec.Image,
-		MasterIndex: *spec.MasterIndex,
-		Replicas:    *spec.SlaveCount + 1,
-		Command:     *spec.Command,
+		Image:                *spec.Image,
+		MasterIndex:          *spec.MasterIndex,
+		Replicas:             *spec.SlaveCount + 1,
+		Command:              *spec.Command,
+		StorageClass:         spec.StorageClass,
+		StorageSize:          spec.StorageSize,
+		Config:               spec.Config,
+		PDB:                  *spec.PDB,
+		ResourceRequirements: *spec.Resources,
+		NodeAffinity:         spec.NodeAffinity,
+		Tolerations:          spec.Tolerations,
+		Scheduling:           *spec.Scheduling,
 	}
 }
 
@@ -63,6 +82,8 @@ func (gen *Generator) Resources() []resource.TemplateInterface {
 		resource.NewTemplateFromObjectFunction(gen.service),
 		resource.NewTemplateFromObjectFunction(gen.redisConfigConfigMap),
 		resource.NewTemplateFromObjectFunction(gen.redisReadinessScriptConfigMap),
+		resource.NewTemplate(pdb.New(gen.GetKey(), gen.GetLabels(), gen.GetSelector(), gen.PDB)).
+			WithEnabled(!gen.PDB.IsDeactivated()),
 	}
 }
 
@@ -13,7 +13,7 @@ import (
 )
 
 func (gen *Generator) statefulSet() *appsv1.StatefulSet {
-	return &appsv1.StatefulSet{
+	sts := &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      gen.GetKey().Name,
 			Namespace: gen.GetKey().Namespace,
@@ -30,6 +30,12 @@ func (gen *Generator) statefulSet() *appsv1.StatefulSet {
 					Labels: util.MergeMaps(gen.GetLabels(), gen.GetSelector()),
 				},
 				Spec: corev1.PodSpec{
+					Affinity:                  pod.Affinity(gen.GetSelector(), gen.NodeAffinity, *gen.Scheduling.PodAntiAffinity),
+					TopologySpreadConstraints: pod.TopologySpreadConstraints(gen.GetSelector(), gen.Scheduling.TopologySpreadConstraints),
+					PriorityClassName:         ptr.Deref(gen.Scheduling.PriorityClassName, ""),
+					RuntimeClassName:          gen.Scheduling.RuntimeClassName,
+					SecurityContext:           pod.SecurityContext(gen.Scheduling.SecurityContext),
+					ServiceAccountName:        ptr.Deref(gen.Scheduling.ServiceAccountName, ""),
 					ImagePullSecrets: func() []corev1.LocalObjectReference {
 						if gen.Image.PullSecretName != nil {
 							return []corev1.LocalObjectReference{{Name: *gen.Image.PullSecretName}}
@@ -57,6 +63,7 @@ func (gen *Generator) statefulSet() *appsv1.StatefulSet {
 								TimeoutSeconds:      5,
 							},
 							ImagePullPolicy: *gen.Image.PullPolicy,
+							Resources:       corev1.ResourceRequirements(gen.ResourceRequirements),
 							VolumeMounts: []corev1.VolumeMount{
 								{Name: "redis-config", MountPath: "/redis"},
 								{Name: "redis-readiness-script", MountPath: "/redis-readiness"},
@@ -64,6 +71,7 @@ func (gen *Generator) statefulSet() *appsv1.StatefulSet {
 							},
 						},
 					},
+					Tolerations:                   gen.Tolerations,
 					TerminationGracePeriodSeconds: ptr.To[int64](0),
 					Volumes: []corev1.Volume{
 						{
@@ -80,11 +88,6 @@ func (gen *Generator) statefulSet() *appsv1.StatefulSet {
 									DefaultMode:          ptr.To[int32](484),
 									LocalObjectReference: corev1.LocalObjectReference{Name: "redis-readiness-script-" + gen.GetInstanceName()}},
 							}},
-						{
-							Name: "redis-data",
-							VolumeSource: corev1.VolumeSource{
-								EmptyDir: &corev1.EmptyDirVolumeSource{},
-							}},
 					},
 				},
 			},
@@ -96,4 +99,30 @@ func (gen *Generator) statefulSet() *appsv1.StatefulSet {
 			},
 		},
 	}
+
+	if gen.StorageSize != nil {
+		sts.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "redis-data",
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources:        corev1.VolumeResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceStorage: *gen.StorageSize}},
+				StorageClassName: gen.StorageClass,
+				VolumeMode:       (*corev1.PersistentVolumeMode)(ptr.To(string(corev1.PersistentVolumeFilesystem))),
+				DataSource:       &corev1.TypedLocalObjectReference{},
+			},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Phase: corev1.ClaimPending,
+			},
+		}}
+	} else {
+		sts.Spec.Template.Spec.Volumes = append(sts.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: "redis-data",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			}})
+	}
+
+	return sts
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/3scale-sre/basereconciler/mutators"
 	"github.com/3scale-sre/basereconciler/resource"
+	"github.com/3scale-sre/basereconciler/util"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	"github.com/3scale-sre/saas-operator/internal/pkg/generators"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/grafanadashboard"
@@ -27,6 +28,9 @@ type Generator struct {
 	generators.BaseOptionsV2
 	Spec    saasv1alpha1.SentinelSpec
 	Options pod.Options
+	// RedisShards are the RedisShard resources referenced or
+	// selected by the Sentinel config, resolved by the controller
+	RedisShards []saasv1alpha1.RedisShard
 }
 
 // NewGenerator returns a new Options struct
@@ -133,8 +137,27 @@ func (gen *Generator) ClusterTopology(ctx context.Context) (map[string]map[strin
 
 			clustermap[shard] = shardmap
 		}
-	} else {
-		return nil, errors.New("either 'spec.config.clusterTopology' or 'spec.cluster.MonitoredShards' must be set")
+	}
+
+	// add the shards from the RedisShard resources
+	for _, rs := range gen.RedisShards {
+		if rs.Status.ShardNodes == nil {
+			continue
+		}
+
+		shardmap := map[string]string{}
+		for alias, hostport := range util.MergeMaps(map[string]string{}, rs.Status.ShardNodes.Master, rs.Status.ShardNodes.Slaves) {
+			shardmap[alias] = "redis://" + hostport
+		}
+
+		if len(shardmap) > 0 {
+			clustermap[rs.GetName()] = shardmap
+		}
+	}
+
+	if len(clustermap) == 0 {
+		return nil, errors.New("either 'spec.config.clusterTopology', 'spec.config.monitoredShards' or " +
+			"RedisShard resources with populated status, through 'spec.config.redisShardRefs' or 'spec.config.redisShardSelector', must be set")
 	}
 
 	clustermap["sentinel"] = make(map[string]string, int(*gen.Spec.Replicas))
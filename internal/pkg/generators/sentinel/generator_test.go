@@ -6,6 +6,7 @@ import (
 
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	"github.com/go-test/deep"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 )
@@ -16,12 +17,13 @@ func TestGenerator_ClusterTopology(t *testing.T) {
 	}
 
 	tests := []struct {
-		name    string
-		key     types.NamespacedName
-		spec    saasv1alpha1.SentinelSpec
-		args    args
-		want    map[string]map[string]string
-		wantErr bool
+		name        string
+		key         types.NamespacedName
+		spec        saasv1alpha1.SentinelSpec
+		redisShards []saasv1alpha1.RedisShard
+		args        args
+		want        map[string]map[string]string
+		wantErr     bool
 	}{
 		{
 			name: "Generates a correct cluster topology from 'spec.config.monitoredShards'",
@@ -103,10 +105,66 @@ func TestGenerator_ClusterTopology(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Adds the shards from the referenced RedisShard resources",
+			key:  types.NamespacedName{Name: "test", Namespace: "test"},
+			spec: saasv1alpha1.SentinelSpec{
+				Replicas: ptr.To(int32(1)),
+				Config: &saasv1alpha1.SentinelConfig{
+					RedisShardRefs: []string{"rs0", "rs1"},
+				},
+			},
+			redisShards: []saasv1alpha1.RedisShard{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "rs0", Namespace: "test"},
+					Status: saasv1alpha1.RedisShardStatus{
+						ShardNodes: &saasv1alpha1.RedisShardNodes{
+							Master: map[string]string{"redis-shard-rs0-0": "10.0.0.1:6379"},
+							Slaves: map[string]string{
+								"redis-shard-rs0-1": "10.0.0.2:6379",
+								"redis-shard-rs0-2": "10.0.0.3:6379",
+							},
+						},
+					},
+				},
+				{
+					// status not yet populated
+					ObjectMeta: metav1.ObjectMeta{Name: "rs1", Namespace: "test"},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			want: map[string]map[string]string{
+				"rs0": {
+					"redis-shard-rs0-0": "redis://10.0.0.1:6379",
+					"redis-shard-rs0-1": "redis://10.0.0.2:6379",
+					"redis-shard-rs0-2": "redis://10.0.0.3:6379",
+				},
+				"sentinel": {
+					"redis-sentinel-0": "redis://redis-sentinel-0.test.svc.cluster.local:26379",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Returns error if no shards are configured",
+			key:  types.NamespacedName{Name: "test", Namespace: "test"},
+			spec: saasv1alpha1.SentinelSpec{
+				Replicas: ptr.To(int32(1)),
+				Config:   &saasv1alpha1.SentinelConfig{},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			gen := NewGenerator("test", "test", tt.spec)
+			gen.RedisShards = tt.redisShards
 
 			got, err := gen.ClusterTopology(tt.args.ctx)
 			if (err != nil) != tt.wantErr {
@@ -34,7 +34,12 @@ func (gen *Generator) statefulSet() *appsv1.StatefulSet {
 					Labels: util.MergeMaps(gen.GetLabels(), gen.GetSelector()),
 				},
 				Spec: corev1.PodSpec{
-					Affinity:                     pod.Affinity(gen.GetSelector(), gen.Spec.NodeAffinity),
+					Affinity:                     pod.Affinity(gen.GetSelector(), gen.Spec.NodeAffinity, *gen.Spec.Scheduling.PodAntiAffinity),
+					TopologySpreadConstraints:    pod.TopologySpreadConstraints(gen.GetSelector(), gen.Spec.Scheduling.TopologySpreadConstraints),
+					PriorityClassName:            ptr.Deref(gen.Spec.Scheduling.PriorityClassName, ""),
+					RuntimeClassName:             gen.Spec.Scheduling.RuntimeClassName,
+					SecurityContext:              pod.SecurityContext(gen.Spec.Scheduling.SecurityContext),
+					ServiceAccountName:           ptr.Deref(gen.Spec.Scheduling.ServiceAccountName, ""),
 					AutomountServiceAccountToken: ptr.To(false),
 					DNSPolicy:                    corev1.DNSClusterFirst,
 					ImagePullSecrets: func() []corev1.LocalObjectReference {
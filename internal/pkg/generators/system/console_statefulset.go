@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/3scale-sre/basereconciler/util"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/pod"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/twemproxy"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -52,6 +53,12 @@ func (gen *ConsoleGenerator) statefulset() *appsv1.StatefulSet {
 							ImagePullPolicy: *gen.Image.PullPolicy,
 						},
 					},
+					Affinity:                      pod.Affinity(gen.GetSelector(), gen.Spec.NodeAffinity, *gen.Spec.Scheduling.PodAntiAffinity),
+					TopologySpreadConstraints:     pod.TopologySpreadConstraints(gen.GetSelector(), gen.Spec.Scheduling.TopologySpreadConstraints),
+					PriorityClassName:             ptr.Deref(gen.Spec.Scheduling.PriorityClassName, ""),
+					RuntimeClassName:              gen.Spec.Scheduling.RuntimeClassName,
+					SecurityContext:               pod.SecurityContext(gen.Spec.Scheduling.SecurityContext),
+					ServiceAccountName:            ptr.Deref(gen.Spec.Scheduling.ServiceAccountName, ""),
 					Tolerations:                   gen.Spec.Tolerations,
 					TerminationGracePeriodSeconds: ptr.To[int64](30),
 				},
@@ -13,6 +13,7 @@ import (
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/pod"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/podmonitor"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/service"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/twemproxy"
 	operatorutil "github.com/3scale-sre/saas-operator/internal/pkg/util"
 	deployment_workload "github.com/3scale-sre/saas-operator/internal/pkg/workloads/deployment"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -35,6 +36,7 @@ const (
 // Generator configures the generators for System
 type Generator struct {
 	generators.BaseOptionsV2
+	WorkloadOptions      deployment_workload.Options
 	App                  AppGenerator
 	CanaryApp            *AppGenerator
 	SidekiqDefault       SidekiqGenerator
@@ -53,7 +55,7 @@ type Generator struct {
 }
 
 // NewGenerator returns a new Options struct
-func NewGenerator(instance, namespace string, spec saasv1alpha1.SystemSpec) (Generator, error) {
+func NewGenerator(instance, namespace string, spec saasv1alpha1.SystemSpec, opts deployment_workload.Options) (Generator, error) {
 	generator := Generator{
 		BaseOptionsV2: generators.BaseOptionsV2{
 			Component:    component,
@@ -64,6 +66,7 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.SystemSpec) (Gen
 				"threescale_component": component,
 			},
 		},
+		WorkloadOptions: opts,
 		App: AppGenerator{
 			BaseOptionsV2: generators.BaseOptionsV2{
 				Component:    strings.Join([]string{component, app}, "-"),
@@ -198,9 +201,11 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.SystemSpec) (Gen
 			Options:           config.NewOptions(*canarySpec),
 			ConfigFilesSecret: *canarySpec.Config.ConfigFilesSecret,
 			Traffic:           spec.App.Canary.SendTraffic,
+			Weight:            spec.App.Canary.TrafficWeight(),
 			TwemproxySpec:     canarySpec.Twemproxy,
 		}
-		// Disable PDB and HPA for the canary Deployment
+		// Disable PDB and autoscaling for the canary Deployment
+		generator.CanaryApp.Spec.VPA = nil
 		generator.CanaryApp.Spec.HPA = &saasv1alpha1.HorizontalPodAutoscalerSpec{}
 		generator.CanaryApp.Spec.PDB = &saasv1alpha1.PodDisruptionBudgetSpec{}
 	}
@@ -228,7 +233,9 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.SystemSpec) (Gen
 			ConfigFilesSecret: *canarySpec.Config.ConfigFilesSecret,
 			TwemproxySpec:     canarySpec.Twemproxy,
 		}
-		// Disable PDB and HPA for the canary Deployment
+		// Disable PDB and autoscaling for the canary Deployment
+		generator.CanarySidekiqDefault.Spec.KEDA = nil
+		generator.CanarySidekiqDefault.Spec.VPA = nil
 		generator.CanarySidekiqDefault.Spec.HPA = &saasv1alpha1.HorizontalPodAutoscalerSpec{}
 		generator.CanarySidekiqDefault.Spec.PDB = &saasv1alpha1.PodDisruptionBudgetSpec{}
 	}
@@ -256,7 +263,9 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.SystemSpec) (Gen
 			ConfigFilesSecret: *canarySpec.Config.ConfigFilesSecret,
 			TwemproxySpec:     canarySpec.Twemproxy,
 		}
-		// Disable PDB and HPA for the canary Deployment
+		// Disable PDB and autoscaling for the canary Deployment
+		generator.CanarySidekiqLow.Spec.KEDA = nil
+		generator.CanarySidekiqLow.Spec.VPA = nil
 		generator.CanarySidekiqLow.Spec.HPA = &saasv1alpha1.HorizontalPodAutoscalerSpec{}
 		generator.CanarySidekiqLow.Spec.PDB = &saasv1alpha1.PodDisruptionBudgetSpec{}
 	}
@@ -284,7 +293,9 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.SystemSpec) (Gen
 			ConfigFilesSecret: *canarySpec.Config.ConfigFilesSecret,
 			TwemproxySpec:     canarySpec.Twemproxy,
 		}
-		// Disable PDB and HPA for the canary Deployment
+		// Disable PDB and autoscaling for the canary Deployment
+		generator.CanarySidekiqBilling.Spec.KEDA = nil
+		generator.CanarySidekiqBilling.Spec.VPA = nil
 		generator.CanarySidekiqBilling.Spec.HPA = &saasv1alpha1.HorizontalPodAutoscalerSpec{}
 		generator.CanarySidekiqBilling.Spec.PDB = &saasv1alpha1.PodDisruptionBudgetSpec{}
 	}
@@ -316,22 +327,22 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.SystemSpec) (Gen
 
 // Resources returns the list of resource templates
 func (gen *Generator) Resources() ([]resource.TemplateInterface, error) {
-	app_resources, err := deployment_workload.New(&gen.App, gen.CanaryApp)
+	app_resources, err := deployment_workload.New(&gen.App, gen.CanaryApp, gen.WorkloadOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	sidekiq_default_resources, err := deployment_workload.New(&gen.SidekiqDefault, gen.CanarySidekiqDefault)
+	sidekiq_default_resources, err := deployment_workload.New(&gen.SidekiqDefault, gen.CanarySidekiqDefault, gen.WorkloadOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	sidekiq_billing_resources, err := deployment_workload.New(&gen.SidekiqBilling, gen.CanarySidekiqBilling)
+	sidekiq_billing_resources, err := deployment_workload.New(&gen.SidekiqBilling, gen.CanarySidekiqBilling, gen.WorkloadOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	sidekiq_low_resources, err := deployment_workload.New(&gen.SidekiqLow, gen.CanarySidekiqLow)
+	sidekiq_low_resources, err := deployment_workload.New(&gen.SidekiqLow, gen.CanarySidekiqLow, gen.WorkloadOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -356,6 +367,20 @@ func (gen *Generator) Resources() ([]resource.TemplateInterface, error) {
 		)
 	}
 
+	// All the non canary workloads share the same TwemproxySpec
+	twemproxySpecs := []*saasv1alpha1.TwemproxySpec{gen.App.TwemproxySpec}
+	if gen.CanaryApp != nil {
+		twemproxySpecs = append(twemproxySpecs, gen.CanaryApp.TwemproxySpec)
+	}
+
+	for _, canary := range []*SidekiqGenerator{gen.CanarySidekiqDefault, gen.CanarySidekiqBilling, gen.CanarySidekiqLow} {
+		if canary != nil {
+			twemproxySpecs = append(twemproxySpecs, canary.TwemproxySpec)
+		}
+	}
+
+	misc = append(misc, twemproxy.DerivedTwemproxyConfigs(gen.GetNamespace(), gen.GetLabels(), twemproxySpecs...)...)
+
 	return operatorutil.ConcatSlices(
 			app_resources,
 			sidekiq_default_resources,
@@ -377,12 +402,17 @@ type AppGenerator struct {
 	Image             saasv1alpha1.ImageSpec
 	ConfigFilesSecret string
 	Traffic           bool
+	Weight            *int32
+	Abort             bool
 	TwemproxySpec     *saasv1alpha1.TwemproxySpec
 }
 
 // Validate that AppGenerator implements deployment_workload.DeploymentWorkload interface
 var _ deployment_workload.DeploymentWorkload = &AppGenerator{}
 
+// Validate that AppGenerator implements deployment_workload.WithVerticalPodAutoscaler interface
+var _ deployment_workload.WithVerticalPodAutoscaler = &AppGenerator{}
+
 // Validate that AppGenerator implements deployment_workload.WithPublishingStrategies interface
 var _ deployment_workload.WithPublishingStrategies = &AppGenerator{}
 
@@ -395,6 +425,9 @@ func (gen *AppGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 func (gen *AppGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.Spec.HPA
 }
+func (gen *AppGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.Spec.VPA
+}
 
 func (gen *AppGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.Spec.PDB
@@ -411,7 +444,9 @@ func (gen *AppGenerator) MonitoredEndpoints() []monitoringv1.PodMetricsEndpoint
 	return pmes
 }
 
-func (gen *AppGenerator) SendTraffic() bool { return gen.Traffic }
+func (gen *AppGenerator) SendTraffic() bool     { return gen.Traffic }
+func (gen *AppGenerator) TrafficWeight() *int32 { return gen.Weight }
+func (gen *AppGenerator) Aborted() bool         { return gen.Abort }
 func (gen *AppGenerator) TrafficSelector() map[string]string {
 	return map[string]string{
 		saasv1alpha1.GroupVersion.Group + "/traffic": fmt.Sprintf("%s-%s", component, app),
@@ -428,6 +463,15 @@ func (gen *AppGenerator) PublishingStrategies() ([]service.ServiceDescriptor, er
 // Validate that SidekiqGenerator implements deployment_workload.DeploymentWorkloadWithTraffic interface
 var _ deployment_workload.DeploymentWorkload = &SidekiqGenerator{}
 
+// Validate that SidekiqGenerator implements deployment_workload.WithVerticalPodAutoscaler interface
+var _ deployment_workload.WithVerticalPodAutoscaler = &SidekiqGenerator{}
+
+// Validate that SidekiqGenerator implements deployment_workload.WithCanaryAnalysis interface
+var _ deployment_workload.WithCanaryAnalysis = &SidekiqGenerator{}
+
+// Validate that SidekiqGenerator implements deployment_workload.WithKEDAScaledObject interface
+var _ deployment_workload.WithKEDAScaledObject = &SidekiqGenerator{}
+
 // SidekiqGenerator has methods to generate resources for system-sidekiq
 type SidekiqGenerator struct {
 	generators.BaseOptionsV2
@@ -436,17 +480,26 @@ type SidekiqGenerator struct {
 	Image             saasv1alpha1.ImageSpec
 	ConfigFilesSecret string
 	TwemproxySpec     *saasv1alpha1.TwemproxySpec
+	Abort             bool
 }
 
+func (gen *SidekiqGenerator) Aborted() bool { return gen.Abort }
+
 func (gen *SidekiqGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 	return resource.NewTemplateFromObjectFunction(gen.deployment).
 		WithMutations(gen.Options.GenerateRolloutTriggers(gen.ConfigFilesSecret)).
-		WithMutation(mutators.SetDeploymentReplicas(gen.Spec.HPA.IsDeactivated()))
+		WithMutation(mutators.SetDeploymentReplicas(gen.Spec.HPA.IsDeactivated() && gen.Spec.KEDA == nil))
 }
 
 func (gen *SidekiqGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.Spec.HPA
 }
+func (gen *SidekiqGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.Spec.VPA
+}
+func (gen *SidekiqGenerator) KEDASpec() *saasv1alpha1.KEDAScaledObjectSpec {
+	return gen.Spec.KEDA
+}
 
 func (gen *SidekiqGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.Spec.PDB
@@ -21,9 +21,9 @@ func (gen *Generator) configMap(toYAML bool) *corev1.ConfigMap {
 
 	for _, pool := range gen.Spec.ServerPools {
 		if *pool.Target == saasv1alpha1.Masters {
-			config[pool.Name] = twemproxy.GenerateServerPool(pool, gen.masterTargets)
+			config[pool.Name] = twemproxy.GenerateServerPool(pool, gen.masterTargets, gen.redisAuth[pool.Name])
 		} else {
-			config[pool.Name] = twemproxy.GenerateServerPool(pool, gen.slaverwTargets)
+			config[pool.Name] = twemproxy.GenerateServerPool(pool, gen.slaverwTargets, gen.redisAuth[pool.Name])
 		}
 	}
 
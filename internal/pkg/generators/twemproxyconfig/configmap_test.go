@@ -9,6 +9,7 @@ import (
 	"github.com/go-test/deep"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestGenerator_configMap(t *testing.T) {
@@ -17,6 +18,7 @@ func TestGenerator_configMap(t *testing.T) {
 		Spec           saasv1alpha1.TwemproxyConfigSpec
 		masterTargets  map[string]twemproxy.Server
 		slaverwTargets map[string]twemproxy.Server
+		redisAuth      map[string]string
 	}
 
 	type args struct {
@@ -145,14 +147,73 @@ func TestGenerator_configMap(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Generates the Twemproxy ConfigMap with custom server pool options",
+			fields: fields{
+				BaseOptionsV2: generators.BaseOptionsV2{
+					Component:    "twemproxy",
+					InstanceName: "test",
+					Namespace:    "ns",
+					Labels:       map[string]string{},
+				},
+				Spec: saasv1alpha1.TwemproxyConfigSpec{
+					SentinelURIs: []string{"sentinel.example.com"},
+					ServerPools: []saasv1alpha1.TwemproxyServerPool{
+						{
+							Name:   "pool1",
+							Target: ptr.To(saasv1alpha1.Masters),
+							Topology: []saasv1alpha1.ShardedRedisTopology{
+								{ShardName: "lshard01", PhysicalShard: "pshard01"},
+								{ShardName: "lshard02", PhysicalShard: "pshard02"},
+							},
+							BindAddress: "localhost:2000",
+							Timeout:     1000,
+							TCPBacklog:  500,
+							PreConnect:  false,
+							TwemproxyServerPoolOptions: saasv1alpha1.TwemproxyServerPoolOptions{
+								Hash:               ptr.To("murmur"),
+								Distribution:       ptr.To("random"),
+								AutoEjectHosts:     ptr.To(true),
+								ServerRetryTimeout: ptr.To[int32](5000),
+								ServerFailureLimit: ptr.To[int32](3),
+								RedisAuth: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}, Key: "password",
+								},
+								RedisDB: ptr.To[int32](2),
+							},
+						},
+					},
+				},
+				masterTargets: map[string]twemproxy.Server{
+					"pshard01": {Address: "127.0.0.1:6379", Priority: 1, Name: "pshard01"},
+					"pshard02": {Address: "127.0.0.2:6379", Priority: 1, Name: "pshard02"},
+				},
+				slaverwTargets: map[string]twemproxy.Server{},
+				redisAuth:      map[string]string{"pool1": "pass"},
+			},
+			args: args{},
+			want: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "ns",
+					Labels:    map[string]string{},
+				},
+				Data: map[string]string{
+					"nutcracker.yml": `{"health":{"listen":"127.0.0.1:22333","preconnect":false,"redis":true,"auto_eject_hosts":false,"servers":["127.0.0.1:6379:1 dummy"]},"pool1":{"listen":"localhost:2000","hash":"murmur","hash_tag":"{}","distribution":"random","timeout":1000,"backlog":500,"preconnect":false,"redis":true,"redis_auth":"pass","redis_db":2,"auto_eject_hosts":true,"server_retry_timeout":5000,"server_failure_limit":3,"servers":["127.0.0.1:6379:1 lshard01","127.0.0.2:6379:1 lshard02"]}}`,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			tt.fields.Spec.Default()
+
 			gen := &Generator{
 				BaseOptionsV2:  tt.fields.BaseOptionsV2,
 				Spec:           tt.fields.Spec,
 				masterTargets:  tt.fields.masterTargets,
 				slaverwTargets: tt.fields.slaverwTargets,
+				redisAuth:      tt.fields.redisAuth,
 			}
 
 			got := gen.configMap(tt.args.toYAML)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/3scale-sre/basereconciler/resource"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
@@ -25,6 +26,11 @@ import (
 
 const (
 	component string = "twemproxy"
+	// discoveryCacheTTL is the max age of a cached sentinel discovery for it
+	// to be reused. Cached discoveries are invalidated by the sentinel event
+	// watchers whenever the topology changes, so this only bounds the staleness
+	// of changes that are not notified through sentinel events.
+	discoveryCacheTTL time.Duration = 10 * time.Second
 )
 
 var (
@@ -49,6 +55,7 @@ type Generator struct {
 	Spec           saasv1alpha1.TwemproxyConfigSpec
 	masterTargets  map[string]twemproxy.Server
 	slaverwTargets map[string]twemproxy.Server
+	redisAuth      map[string]string
 }
 
 // NewGenerator returns a new Options struct
@@ -68,6 +75,12 @@ func NewGenerator(ctx context.Context, instance *saasv1alpha1.TwemproxyConfig, c
 	}
 
 	var err error
+
+	gen.redisAuth, err = resolveRedisAuth(ctx, cl, instance.GetNamespace(), gen.Spec.ServerPools)
+	if err != nil {
+		return Generator{}, err
+	}
+
 	if gen.Spec.SentinelURIs == nil {
 		gen.Spec.SentinelURIs, err = discoverSentinels(ctx, cl, instance.GetNamespace())
 		if err != nil {
@@ -94,18 +107,10 @@ func NewGenerator(ctx context.Context, instance *saasv1alpha1.TwemproxyConfig, c
 	}
 
 	// Check if there are pools in the config that require slave discovery
-	discoverSlavesRW := false
-
-	for _, pool := range gen.Spec.ServerPools {
-		if *pool.Target == saasv1alpha1.SlavesRW {
-			discoverSlavesRW = true
-		}
-	}
-
-	switch discoverSlavesRW {
+	switch gen.Spec.TargetsSlavesRW() {
 	case false:
 		// any error discovering masters should return
-		if merr := shardedCluster.SentinelDiscover(ctx, sharded.OnlyMasterDiscoveryOpt); merr != nil {
+		if merr := shardedCluster.CachedSentinelDiscover(ctx, discoveryCacheTTL, sharded.OnlyMasterDiscoveryOpt); merr != nil {
 			return Generator{}, merr
 		}
 
@@ -115,7 +120,7 @@ func NewGenerator(ctx context.Context, instance *saasv1alpha1.TwemproxyConfig, c
 		}
 
 	case true:
-		merr := shardedCluster.SentinelDiscover(ctx, sharded.SlaveReadOnlyDiscoveryOpt)
+		merr := shardedCluster.CachedSentinelDiscover(ctx, discoveryCacheTTL, sharded.SlaveReadOnlyDiscoveryOpt)
 		if merr != nil {
 			log.Error(merr, "DiscoveryError")
 			// Only sentinel/master discovery errors should return.
@@ -174,6 +179,37 @@ func discoverSentinels(ctx context.Context, cl client.Client, namespace string)
 	return uris, nil
 }
 
+// resolveRedisAuth returns the redis passwords of the server pools
+// that require authentication, indexed by server pool name
+func resolveRedisAuth(ctx context.Context, cl client.Client, namespace string,
+	pools []saasv1alpha1.TwemproxyServerPool) (map[string]string, error) {
+	var auth map[string]string
+
+	for _, pool := range pools {
+		if pool.RedisAuth == nil {
+			continue
+		}
+
+		if auth == nil {
+			auth = map[string]string{}
+		}
+
+		secret := &corev1.Secret{}
+		if err := cl.Get(ctx, types.NamespacedName{Name: pool.RedisAuth.Name, Namespace: namespace}, secret); err != nil {
+			return nil, err
+		}
+
+		value, ok := secret.Data[pool.RedisAuth.Key]
+		if !ok {
+			return nil, fmt.Errorf("key '%s' not found in Secret '%s'", pool.RedisAuth.Key, pool.RedisAuth.Name)
+		}
+
+		auth[pool.Name] = string(value)
+	}
+
+	return auth, nil
+}
+
 func (gen *Generator) getMonitoredMasters(
 	cluster *sharded.Cluster) (map[string]twemproxy.Server, error) {
 	m := make(map[string]twemproxy.Server, len(cluster.Shards))
@@ -66,7 +66,12 @@ func (gen *APIGenerator) deployment() *appsv1.Deployment {
 							ReadinessProbe:  pod.HTTPProbe("/status/ready", intstr.FromString("http"), corev1.URISchemeHTTP, *gen.APISpec.ReadinessProbe),
 						},
 					},
-					Affinity:                      pod.Affinity(gen.GetSelector(), gen.APISpec.NodeAffinity),
+					Affinity:                      pod.Affinity(gen.GetSelector(), gen.APISpec.NodeAffinity, *gen.APISpec.Scheduling.PodAntiAffinity),
+					TopologySpreadConstraints:     pod.TopologySpreadConstraints(gen.GetSelector(), gen.APISpec.Scheduling.TopologySpreadConstraints),
+					PriorityClassName:             ptr.Deref(gen.APISpec.Scheduling.PriorityClassName, ""),
+					RuntimeClassName:              gen.APISpec.Scheduling.RuntimeClassName,
+					SecurityContext:               pod.SecurityContext(gen.APISpec.Scheduling.SecurityContext),
+					ServiceAccountName:            ptr.Deref(gen.APISpec.Scheduling.ServiceAccountName, ""),
 					Tolerations:                   gen.APISpec.Tolerations,
 					TerminationGracePeriodSeconds: ptr.To[int64](30),
 				},
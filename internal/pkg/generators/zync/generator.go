@@ -29,6 +29,7 @@ const (
 // Generator configures the generators for Zync
 type Generator struct {
 	generators.BaseOptionsV2
+	WorkloadOptions      deployment_workload.Options
 	API                  APIGenerator
 	Que                  QueGenerator
 	Console              ConsoleGenerator
@@ -37,7 +38,7 @@ type Generator struct {
 }
 
 // NewGenerator returns a new Options struct
-func NewGenerator(instance, namespace string, spec saasv1alpha1.ZyncSpec) Generator {
+func NewGenerator(instance, namespace string, spec saasv1alpha1.ZyncSpec, opts deployment_workload.Options) Generator {
 	return Generator{
 		BaseOptionsV2: generators.BaseOptionsV2{
 			Component:    component,
@@ -48,6 +49,7 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.ZyncSpec) Genera
 				"threescale_component": component,
 			},
 		},
+		WorkloadOptions: opts,
 		API: APIGenerator{
 			BaseOptionsV2: generators.BaseOptionsV2{
 				Component:    api,
@@ -101,12 +103,12 @@ func NewGenerator(instance, namespace string, spec saasv1alpha1.ZyncSpec) Genera
 
 // Resources returns the list of templates
 func (gen *Generator) Resources() ([]resource.TemplateInterface, error) {
-	app_resources, err := deployment_workload.New(&gen.API, nil)
+	app_resources, err := deployment_workload.New(&gen.API, nil, gen.WorkloadOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	que_resources, err := deployment_workload.New(&gen.Que, nil)
+	que_resources, err := deployment_workload.New(&gen.Que, nil, gen.WorkloadOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -144,6 +146,9 @@ type APIGenerator struct {
 // Validate that APIGenerator implements deployment_workload.DeploymentWorkload interface
 var _ deployment_workload.DeploymentWorkload = &APIGenerator{}
 
+// Validate that APIGenerator implements deployment_workload.WithVerticalPodAutoscaler interface
+var _ deployment_workload.WithVerticalPodAutoscaler = &APIGenerator{}
+
 // Validate that APIGenerator implements deployment_workload.WithPublishingStrategies interface
 var _ deployment_workload.WithPublishingStrategies = &APIGenerator{}
 
@@ -159,6 +164,9 @@ func (gen *APIGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 func (gen *APIGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.APISpec.HPA
 }
+func (gen *APIGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.APISpec.VPA
+}
 func (gen *APIGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.APISpec.PDB
 }
@@ -195,14 +203,26 @@ type QueGenerator struct {
 // Validate that QueGenerator implements deployment_workload.DeploymentWorkload interface
 var _ deployment_workload.DeploymentWorkload = &QueGenerator{}
 
+// Validate that QueGenerator implements deployment_workload.WithVerticalPodAutoscaler interface
+var _ deployment_workload.WithVerticalPodAutoscaler = &QueGenerator{}
+
+// Validate that QueGenerator implements deployment_workload.WithKEDAScaledObject interface
+var _ deployment_workload.WithKEDAScaledObject = &QueGenerator{}
+
 func (gen *QueGenerator) Deployment() *resource.Template[*appsv1.Deployment] {
 	return resource.NewTemplateFromObjectFunction(gen.deployment).
-		WithMutation(mutators.SetDeploymentReplicas(gen.QueSpec.HPA.IsDeactivated())).
+		WithMutation(mutators.SetDeploymentReplicas(gen.QueSpec.HPA.IsDeactivated() && gen.QueSpec.KEDA == nil)).
 		WithMutations(gen.Options.GenerateRolloutTriggers())
 }
 func (gen *QueGenerator) HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec {
 	return gen.QueSpec.HPA
 }
+func (gen *QueGenerator) VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec {
+	return gen.QueSpec.VPA
+}
+func (gen *QueGenerator) KEDASpec() *saasv1alpha1.KEDAScaledObjectSpec {
+	return gen.QueSpec.KEDA
+}
 func (gen *QueGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpec {
 	return gen.QueSpec.PDB
 }
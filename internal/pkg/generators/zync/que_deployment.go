@@ -70,7 +70,12 @@ func (gen *QueGenerator) deployment() *appsv1.Deployment {
 							ReadinessProbe:  pod.HTTPProbe("/metrics", intstr.FromString("metrics"), corev1.URISchemeHTTP, *gen.QueSpec.ReadinessProbe),
 						},
 					},
-					Affinity:                      pod.Affinity(gen.GetSelector(), gen.QueSpec.NodeAffinity),
+					Affinity:                      pod.Affinity(gen.GetSelector(), gen.QueSpec.NodeAffinity, *gen.QueSpec.Scheduling.PodAntiAffinity),
+					TopologySpreadConstraints:     pod.TopologySpreadConstraints(gen.GetSelector(), gen.QueSpec.Scheduling.TopologySpreadConstraints),
+					PriorityClassName:             ptr.Deref(gen.QueSpec.Scheduling.PriorityClassName, ""),
+					RuntimeClassName:              gen.QueSpec.Scheduling.RuntimeClassName,
+					SecurityContext:               pod.SecurityContext(gen.QueSpec.Scheduling.SecurityContext),
+					ServiceAccountName:            ptr.Deref(gen.QueSpec.Scheduling.ServiceAccountName, ""),
 					Tolerations:                   gen.QueSpec.Tolerations,
 					TerminationGracePeriodSeconds: ptr.To[int64](30),
 				},
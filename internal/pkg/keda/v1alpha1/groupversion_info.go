@@ -0,0 +1,32 @@
+// Package v1alpha1 contains the subset of the KEDA keda.sh/v1alpha1 types that
+// the operator generates. Only the fields used by the operator are defined, which
+// avoids depending on the whole KEDA module:
+//
+//   - ScaledObject: spec.scaleTargetRef, spec.pollingInterval, spec.cooldownPeriod,
+//     spec.idleReplicaCount, spec.minReplicaCount, spec.maxReplicaCount,
+//     spec.advanced.horizontalPodAutoscalerConfig.behavior, spec.triggers and
+//     the originalReplicaCount and conditions of the status
+//
+// Any other field is dropped when an object is read into these types, so the objects
+// must never be written with a full update, which would remove the fields set by
+// others. The operator reconciles them with merge patches, which only contain the
+// modelled fields that have changed.
+// +kubebuilder:object:generate=true
+// +kubebuilder:skip
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects
+	GroupVersion = schema.GroupVersion{Group: "keda.sh", Version: "v1alpha1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
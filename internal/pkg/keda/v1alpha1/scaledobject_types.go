@@ -0,0 +1,80 @@
+package v1alpha1
+
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScaleTarget holds the reference to the scale target Object
+type ScaleTarget struct {
+	Name       string `json:"name"`
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+}
+
+// HorizontalPodAutoscalerConfig specifies horizontal scale config
+type HorizontalPodAutoscalerConfig struct {
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// AdvancedConfig specifies advance scaling options
+type AdvancedConfig struct {
+	HorizontalPodAutoscalerConfig *HorizontalPodAutoscalerConfig `json:"horizontalPodAutoscalerConfig,omitempty"`
+}
+
+// AuthenticationRef points to the TriggerAuthentication or ClusterTriggerAuthentication
+// object that is used to authenticate the scaler with the environment
+type AuthenticationRef struct {
+	Name string `json:"name"`
+	Kind string `json:"kind,omitempty"`
+}
+
+// ScaleTriggers reference the scaler that will be used
+type ScaleTriggers struct {
+	Type              string             `json:"type"`
+	Name              string             `json:"name,omitempty"`
+	Metadata          map[string]string  `json:"metadata"`
+	AuthenticationRef *AuthenticationRef `json:"authenticationRef,omitempty"`
+}
+
+// ScaledObjectSpec is the spec for a ScaledObject resource
+type ScaledObjectSpec struct {
+	ScaleTargetRef   *ScaleTarget    `json:"scaleTargetRef"`
+	PollingInterval  *int32          `json:"pollingInterval,omitempty"`
+	CooldownPeriod   *int32          `json:"cooldownPeriod,omitempty"`
+	IdleReplicaCount *int32          `json:"idleReplicaCount,omitempty"`
+	MinReplicaCount  *int32          `json:"minReplicaCount,omitempty"`
+	MaxReplicaCount  *int32          `json:"maxReplicaCount,omitempty"`
+	Advanced         *AdvancedConfig `json:"advanced,omitempty"`
+	Triggers         []ScaleTriggers `json:"triggers"`
+}
+
+// ScaledObjectStatus is the status for a ScaledObject resource
+type ScaledObjectStatus struct {
+	OriginalReplicaCount *int32             `json:"originalReplicaCount,omitempty"`
+	Conditions           []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScaledObject is a specification for a ScaledObject resource
+type ScaledObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScaledObjectSpec   `json:"spec"`
+	Status ScaledObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScaledObjectList is a list of ScaledObject resources
+type ScaledObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScaledObject `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScaledObject{}, &ScaledObjectList{})
+}
@@ -20,6 +20,14 @@ type RunnableThread interface {
 	CanBeDeleted() bool
 }
 
+// ConfigurableThread is a RunnableThread whose configuration can change
+// while it is running. The Manager restarts the thread whenever the hash
+// of its configuration changes, as it is only read when the thread starts.
+type ConfigurableThread interface {
+	RunnableThread
+	ConfigHash() string
+}
+
 // Manager is a struct that holds configuration to
 // manage concurrent RunnableThreads
 type Manager struct {
@@ -41,9 +49,16 @@ func (mgr *Manager) runThread(ctx context.Context, key string, thread RunnableTh
 	thread.SetChannel(mgr.channel)
 
 	t, ok := mgr.threads[key]
-	// do nothing if present and already started
 	if ok && t.IsStarted() {
-		return nil
+		// do nothing if present, already started and
+		// the configuration has not changed
+		if !configChanged(t, thread) {
+			return nil
+		}
+
+		mgr.stopThread(key)
+
+		ok = false
 	}
 
 	mgr.mu.Lock()
@@ -60,16 +75,33 @@ func (mgr *Manager) runThread(ctx context.Context, key string, thread RunnableTh
 	return nil
 }
 
+// configChanged returns whether the configuration of the desired
+// thread differs from the configuration of the running one
+func configChanged(running, desired RunnableThread) bool {
+	r, ok := running.(ConfigurableThread)
+	if !ok {
+		return false
+	}
+
+	d, ok := desired.(ConfigurableThread)
+	if !ok {
+		return false
+	}
+
+	return r.ConfigHash() != d.ConfigHash()
+}
+
 // stopThread stops the thread identified by the given key
 func (mgr *Manager) stopThread(key string) {
 	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
 	if _, ok := mgr.threads[key]; !ok {
 		return
 	}
 
 	mgr.threads[key].Stop()
 	delete(mgr.threads, key)
-	mgr.mu.Unlock()
 }
 
 // GetChannel returns the channel through which events can be received
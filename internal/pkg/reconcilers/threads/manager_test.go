@@ -34,6 +34,55 @@ func (trt *TestRunnableThread) Stop()              { trt.started = false }
 func (trt *TestRunnableThread) IsStarted() bool    { return trt.started }
 func (trt *TestRunnableThread) CanBeDeleted() bool { return true }
 
+type TestConfigurableThread struct {
+	TestRunnableThread
+	config string
+}
+
+func (tct *TestConfigurableThread) ConfigHash() string { return tct.config }
+
+func TestManager_RunThread_ConfigChanges(t *testing.T) {
+	running := &TestConfigurableThread{TestRunnableThread: TestRunnableThread{TestID: "test", started: true}, config: "a"}
+
+	tests := []struct {
+		name        string
+		desired     *TestConfigurableThread
+		wantRestart bool
+	}{
+		{
+			name:        "Keeps the running thread if the config is the same",
+			desired:     &TestConfigurableThread{TestRunnableThread: TestRunnableThread{TestID: "test"}, config: "a"},
+			wantRestart: false,
+		},
+		{
+			name:        "Restarts the thread if the config changes",
+			desired:     &TestConfigurableThread{TestRunnableThread: TestRunnableThread{TestID: "test"}, config: "b"},
+			wantRestart: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			running.started = true
+			mgr := &Manager{
+				channel: make(chan event.GenericEvent),
+				threads: map[string]RunnableThread{"key": running},
+			}
+
+			if err := mgr.runThread(context.TODO(), "key", tt.desired, logr.Discard()); err != nil {
+				t.Fatalf("Manager.RunThread() error = %v", err)
+			}
+
+			if got := mgr.threads["key"] == tt.desired; got != tt.wantRestart {
+				t.Errorf("Manager.RunThread() thread replaced = %v, want %v", got, tt.wantRestart)
+			}
+
+			if got := running.IsStarted(); got == tt.wantRestart {
+				t.Errorf("Manager.RunThread() running thread started = %v, want %v", got, !tt.wantRestart)
+			}
+		})
+	}
+}
+
 func TestManager_RunThread(t *testing.T) {
 	type fields struct {
 		channel chan event.GenericEvent
@@ -3,7 +3,10 @@ package events
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
+	"github.com/3scale-sre/basereconciler/util"
 	"github.com/3scale-sre/saas-operator/internal/pkg/reconcilers/threads"
 	redis "github.com/3scale-sre/saas-operator/internal/pkg/redis/server"
 	"github.com/3scale-sre/saas-operator/internal/pkg/redis/sharded"
@@ -72,18 +75,21 @@ func init() {
 		sdownCount, sdownSentinelCount, sdownClearedCount, sdownClearedSentinelCount)
 }
 
-// SentinelEventWatcher implements RunnableThread
-var _ threads.RunnableThread = &SentinelEventWatcher{}
+// SentinelEventWatcher implements ConfigurableThread
+var _ threads.ConfigurableThread = &SentinelEventWatcher{}
 
 type SentinelEventWatcher struct {
-	instance      client.Object
-	sentinelURI   string
-	exportMetrics bool
-	topology      *sharded.Cluster
-	eventsCh      chan event.GenericEvent
-	started       bool
-	cancel        context.CancelFunc
-	sentinel      *sharded.SentinelServer
+	instance        client.Object
+	sentinelURI     string
+	exportMetrics   bool
+	topology        *sharded.Cluster
+	eventsCh        chan event.GenericEvent
+	started         bool
+	cancel          context.CancelFunc
+	sentinel        *sharded.SentinelServer
+	pool            *redis.ServerPool
+	reconcileEvents []string
+	debounce        time.Duration
 }
 
 func NewSentinelEventWatcher(sentinelURI string, instance client.Object, topology *sharded.Cluster,
@@ -99,9 +105,34 @@ func NewSentinelEventWatcher(sentinelURI string, instance client.Object, topolog
 		exportMetrics: metrics,
 		topology:      topology,
 		sentinel:      sentinel,
+		pool:          pool,
 	}, nil
 }
 
+// WithReconcileEvents restricts the sentinel events that trigger a reconcile of
+// the owner resource to the given list (ie "+switch-master"). By default, all the
+// watched events trigger a reconcile.
+func (sew *SentinelEventWatcher) WithReconcileEvents(events ...string) *SentinelEventWatcher {
+	sew.reconcileEvents = events
+
+	return sew
+}
+
+// WithDebounce configures the watcher to wait until no new events have been received
+// for the given duration before triggering a reconcile of the owner resource. This
+// avoids several consecutive reconciles when events are received in bursts.
+func (sew *SentinelEventWatcher) WithDebounce(debounce time.Duration) *SentinelEventWatcher {
+	sew.debounce = debounce
+
+	return sew
+}
+
+// ConfigHash returns a hash of the options that the watcher reads when
+// it starts, so it is restarted when they change
+func (sew *SentinelEventWatcher) ConfigHash() string {
+	return util.Hash(fmt.Sprintf("%v/%s", sew.reconcileEvents, sew.debounce))
+}
+
 func (sew *SentinelEventWatcher) GetID() string {
 	return sew.sentinelURI
 }
@@ -154,11 +185,37 @@ func (sew *SentinelEventWatcher) Start(parentCtx context.Context, l logr.Logger)
 
 		log.Info("event watcher running")
 
+		var debounceTimer *time.Timer
+		// a nil channel blocks forever, so the debounce case
+		// of the select is only active when a timer is pending
+		var debounceCh <-chan time.Time
+
+		defer func() {
+			if debounceTimer != nil {
+				debounceTimer.Stop()
+			}
+		}()
+
 		for {
 			select {
 			case msg := <-ch:
 				log.V(1).Info("received event from sentinel", "event", msg.String())
-				sew.eventsCh <- event.GenericEvent{Object: sew.instance}
+
+				// the topology has changed, so previous discoveries
+				// made through this sentinel are no longer valid
+				sew.pool.InvalidateCachedDiscovery(sew.sentinel.ID())
+
+				if sew.shouldReconcile(msg.Channel) {
+					switch {
+					case sew.debounce == 0:
+						sew.eventsCh <- event.GenericEvent{Object: sew.instance}
+					case debounceTimer == nil:
+						debounceTimer = time.NewTimer(sew.debounce)
+						debounceCh = debounceTimer.C
+					default:
+						debounceTimer.Reset(sew.debounce)
+					}
+				}
 
 				rem, err := NewRedisEventMessage(msg)
 				if err == nil {
@@ -177,6 +234,12 @@ func (sew *SentinelEventWatcher) Start(parentCtx context.Context, l logr.Logger)
 					log.Error(err, "invalid event message")
 				}
 
+			case <-debounceCh:
+				log.V(1).Info("triggering reconcile after debounce period")
+
+				debounceTimer, debounceCh = nil, nil
+				sew.eventsCh <- event.GenericEvent{Object: sew.instance}
+
 			case <-ctx.Done():
 				log.Info("shutting down event watcher")
 
@@ -197,6 +260,16 @@ func (sew *SentinelEventWatcher) Stop() {
 	sew.cancel()
 }
 
+// shouldReconcile returns whether the received event should trigger a reconcile
+// of the owner resource
+func (sew *SentinelEventWatcher) shouldReconcile(event string) bool {
+	if len(sew.reconcileEvents) == 0 {
+		return true
+	}
+
+	return slices.Contains(sew.reconcileEvents, event)
+}
+
 func (sew *SentinelEventWatcher) metricsFromEvent(rem RedisEventMessage) {
 	switch rem.event {
 	case "+switch-master":
@@ -4,6 +4,7 @@ import (
 	"net"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 )
@@ -13,6 +14,7 @@ import (
 type ServerPool struct {
 	servers []*Server
 	mu      sync.Mutex
+	cache   discoveryCache
 }
 
 func NewServerPool(servers ...*Server) *ServerPool {
@@ -82,3 +84,66 @@ func (pool *ServerPool) GetServerAlias(hostport string) string {
 		return ""
 	}
 }
+
+// discoveryCache stores discovery results obtained through
+// sentinel servers, indexed by the ID of the sentinel server
+type discoveryCache struct {
+	mu          sync.Mutex
+	entries     map[string]map[string]discoveryCacheEntry
+	invalidated map[string]time.Time
+}
+
+type discoveryCacheEntry struct {
+	value     any
+	timestamp time.Time
+}
+
+// GetCachedDiscovery returns the discovery result stored for the given sentinel and key,
+// as long as it is not older than maxAge
+func (pool *ServerPool) GetCachedDiscovery(sentinelID, key string, maxAge time.Duration) (any, bool) {
+	pool.cache.mu.Lock()
+	defer pool.cache.mu.Unlock()
+
+	entry, ok := pool.cache.entries[sentinelID][key]
+	if !ok || time.Since(entry.timestamp) > maxAge {
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+// SetCachedDiscovery stores a discovery result for the given sentinel and key. The
+// 'started' param is the time at which the discovery began: if the cache for the
+// sentinel has been invalidated after that, the result is discarded as it might
+// not reflect the latest topology change.
+func (pool *ServerPool) SetCachedDiscovery(sentinelID, key string, value any, started time.Time) {
+	pool.cache.mu.Lock()
+	defer pool.cache.mu.Unlock()
+
+	if pool.cache.invalidated[sentinelID].After(started) {
+		return
+	}
+
+	if pool.cache.entries == nil {
+		pool.cache.entries = map[string]map[string]discoveryCacheEntry{}
+	}
+
+	if pool.cache.entries[sentinelID] == nil {
+		pool.cache.entries[sentinelID] = map[string]discoveryCacheEntry{}
+	}
+
+	pool.cache.entries[sentinelID][key] = discoveryCacheEntry{value: value, timestamp: started}
+}
+
+// InvalidateCachedDiscovery removes all the discovery results stored for the given sentinel
+func (pool *ServerPool) InvalidateCachedDiscovery(sentinelID string) {
+	pool.cache.mu.Lock()
+	defer pool.cache.mu.Unlock()
+
+	if pool.cache.invalidated == nil {
+		pool.cache.invalidated = map[string]time.Time{}
+	}
+
+	pool.cache.invalidated[sentinelID] = time.Now()
+	delete(pool.cache.entries, sentinelID)
+}
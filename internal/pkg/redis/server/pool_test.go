@@ -3,6 +3,7 @@ package server
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"k8s.io/utils/ptr"
 )
@@ -129,3 +130,50 @@ func TestServerPool_indexByHost(t *testing.T) {
 		})
 	}
 }
+
+func TestServerPool_CachedDiscovery(t *testing.T) {
+	t.Run("Returns a cached value if not expired", func(t *testing.T) {
+		pool := NewServerPool()
+		pool.SetCachedDiscovery("127.0.0.1:26379", "key", "value", time.Now())
+
+		got, ok := pool.GetCachedDiscovery("127.0.0.1:26379", "key", time.Minute)
+		if !ok || got != "value" {
+			t.Errorf("ServerPool.GetCachedDiscovery() = %v, %v, want %v, %v", got, ok, "value", true)
+		}
+	})
+
+	t.Run("Does not return expired values", func(t *testing.T) {
+		pool := NewServerPool()
+		pool.SetCachedDiscovery("127.0.0.1:26379", "key", "value", time.Now().Add(-2*time.Minute))
+
+		if got, ok := pool.GetCachedDiscovery("127.0.0.1:26379", "key", time.Minute); ok {
+			t.Errorf("ServerPool.GetCachedDiscovery() = %v, %v, want %v, %v", got, ok, nil, false)
+		}
+	})
+
+	t.Run("Invalidates the values of a sentinel", func(t *testing.T) {
+		pool := NewServerPool()
+		pool.SetCachedDiscovery("127.0.0.1:26379", "key", "value", time.Now())
+		pool.SetCachedDiscovery("127.0.0.1:26380", "key", "value", time.Now())
+		pool.InvalidateCachedDiscovery("127.0.0.1:26379")
+
+		if got, ok := pool.GetCachedDiscovery("127.0.0.1:26379", "key", time.Minute); ok {
+			t.Errorf("ServerPool.GetCachedDiscovery() = %v, %v, want %v, %v", got, ok, nil, false)
+		}
+
+		if _, ok := pool.GetCachedDiscovery("127.0.0.1:26380", "key", time.Minute); !ok {
+			t.Errorf("ServerPool.GetCachedDiscovery() = %v, want %v", ok, true)
+		}
+	})
+
+	t.Run("Discards values from discoveries started before an invalidation", func(t *testing.T) {
+		pool := NewServerPool()
+		started := time.Now().Add(-time.Second)
+		pool.InvalidateCachedDiscovery("127.0.0.1:26379")
+		pool.SetCachedDiscovery("127.0.0.1:26379", "key", "value", started)
+
+		if got, ok := pool.GetCachedDiscovery("127.0.0.1:26379", "key", time.Minute); ok {
+			t.Errorf("ServerPool.GetCachedDiscovery() = %v, %v, want %v, %v", got, ok, nil, false)
+		}
+	})
+}
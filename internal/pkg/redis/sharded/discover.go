@@ -3,6 +3,8 @@ package sharded
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 
 	"github.com/3scale-sre/saas-operator/internal/pkg/redis/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -30,6 +32,19 @@ func (set DiscoveryOptionSet) Has(opt DiscoveryOption) bool {
 	return false
 }
 
+// String returns a representation of the set that does not
+// depend on the order of the options
+func (set DiscoveryOptionSet) String() string {
+	opts := make([]int, 0, len(set))
+	for _, o := range set {
+		opts = append(opts, int(o))
+	}
+
+	sort.Ints(opts)
+
+	return fmt.Sprint(opts)
+}
+
 // Discover returns the characteristincs for a given
 // redis Server
 // It always gets the role first
@@ -83,7 +98,7 @@ func (srv *RedisServer) Discover(ctx context.Context, opts ...DiscoveryOption) e
 		srv.Config["slave-priority"] = slavePriority
 	}
 
-	if DiscoveryOptionSet(opts).Has(ReplicationInfoDiscoveryOpt) && role != client.Master {
+	if DiscoveryOptionSet(opts).Has(ReplicationInfoDiscoveryOpt) {
 		repinfo, err := srv.RedisInfo(ctx, "replication")
 		if err != nil {
 			logger.Error(err, fmt.Sprintf("unable to get %s|%s|%s replication info", srv.GetAlias(), srv.Role, srv.ID()))
@@ -91,6 +106,23 @@ func (srv *RedisServer) Discover(ctx context.Context, opts ...DiscoveryOption) e
 			return err
 		}
 
+		if role == client.Master {
+			// masters only report their replication offset
+			offset, _ := strconv.ParseInt(repinfo["master_repl_offset"], 10, 64)
+			srv.Replication = &ReplicationInfo{Offset: offset}
+
+			return nil
+		}
+
+		offset, _ := strconv.ParseInt(repinfo["slave_repl_offset"], 10, 64)
+		srv.Replication = &ReplicationInfo{
+			MasterHost:       repinfo["master_host"],
+			MasterPort:       repinfo["master_port"],
+			MasterLinkStatus: repinfo["master_link_status"],
+			SyncInProgress:   repinfo["master_sync_in_progress"] == "1",
+			Offset:           offset,
+		}
+
 		var syncInProgress string
 
 		switch flag := repinfo["master_sync_in_progress"]; flag {
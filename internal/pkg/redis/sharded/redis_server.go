@@ -3,6 +3,7 @@ package sharded
 import (
 	"context"
 	"fmt"
+	"net"
 
 	"github.com/3scale-sre/saas-operator/internal/pkg/redis/client"
 	redis "github.com/3scale-sre/saas-operator/internal/pkg/redis/server"
@@ -11,9 +12,42 @@ import (
 
 type RedisServer struct {
 	*redis.Server
-	Role   client.Role
-	Config map[string]string
-	Info   map[string]string
+	Role        client.Role
+	Config      map[string]string
+	Info        map[string]string
+	Replication *ReplicationInfo
+}
+
+// ReplicationInfo holds the replication details of a server
+// as reported by the 'INFO replication' command. Only the
+// Offset is populated for masters.
+type ReplicationInfo struct {
+	MasterHost       string
+	MasterPort       string
+	MasterLinkStatus string
+	SyncInProgress   bool
+	Offset           int64
+}
+
+// MasterHostPort returns the address of the master the slave replicates from
+func (ri *ReplicationInfo) MasterHostPort() string {
+	return net.JoinHostPort(ri.MasterHost, ri.MasterPort)
+}
+
+// LinkUp returns true if the replication link with the master is up
+func (ri *ReplicationInfo) LinkUp() bool {
+	return ri.MasterLinkStatus == "up"
+}
+
+// IsInitialized returns true if the server has been initialized as
+// a slave, as opposed to still pointing to the 127.0.0.1 placeholder
+// that redis servers get at startup
+func (srv *RedisServer) IsInitialized() bool {
+	if srv.Role == client.Master {
+		return true
+	}
+
+	return srv.Role == client.Slave && srv.Replication != nil && srv.Replication.MasterHost != "127.0.0.1"
 }
 
 func NewRedisServerFromPool(connectionString string, alias *string, pool *redis.ServerPool) (*RedisServer, error) {
@@ -106,3 +140,53 @@ func (srv *RedisServer) InitSlave(ctx context.Context, master *RedisServer) (boo
 
 	return false, nil
 }
+
+// RepairSlave points the slave to the given master if it is currently replicating from
+// a different address, which happens when the master Pod is rescheduled and gets a new IP.
+// Slaves that have not yet been initialized are left untouched. An error is returned if the
+// master has a lower replication offset than the slave, as a full resync would wipe the slave
+// data (for example when the master has been restarted and has lost its dataset).
+// Replication info must have been previously discovered using ReplicationInfoDiscoveryOpt.
+func (srv *RedisServer) RepairSlave(ctx context.Context, master *RedisServer) (bool, error) {
+	logger := log.FromContext(ctx, "function", "(*RedisServer).RepairSlave")
+
+	if !srv.IsInitialized() || srv.Role != client.Slave {
+		return false, nil
+	}
+
+	if srv.Replication.MasterHostPort() == master.ID() {
+		return false, nil
+	}
+
+	if master.Replication == nil || master.Replication.Offset < srv.Replication.Offset {
+		return false, fmt.Errorf("refusing to replicate %s|%s from %s|%s: the master is behind the slave",
+			srv.GetAlias(), srv.ID(), master.GetAlias(), master.ID())
+	}
+
+	if err := srv.RedisSlaveOf(ctx, master.GetHost(), master.GetPort()); err != nil {
+		return false, err
+	}
+
+	logger.Info(fmt.Sprintf("reconfigured %s|%s as slave of %s|%s (was slave of %s)",
+		srv.GetAlias(), srv.ID(), master.GetAlias(), master.ID(), srv.Replication.MasterHostPort()))
+
+	srv.Replication.MasterHost = master.GetHost()
+	srv.Replication.MasterPort = master.GetPort()
+
+	return true, nil
+}
+
+// Promote turns the slave into a master
+func (srv *RedisServer) Promote(ctx context.Context) error {
+	logger := log.FromContext(ctx, "function", "(*RedisServer).Promote")
+
+	if err := srv.RedisSlaveOf(ctx, "NO", "ONE"); err != nil {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("promoted %s|%s to master", srv.GetAlias(), srv.ID()))
+
+	srv.Role = client.Master
+
+	return nil
+}
@@ -310,3 +310,76 @@ func (shard *Shard) Init(ctx context.Context, masterHostPort string) ([]string,
 
 	return listChanged, merr.ErrorOrNil()
 }
+
+// IsInitialized returns true if any of the servers in the shard has already been
+// initialized, which means that the shard holds data that must be preserved.
+// Replication info must have been previously discovered using ReplicationInfoDiscoveryOpt.
+func (shard *Shard) IsInitialized() bool {
+	for _, srv := range shard.Servers {
+		if srv.IsInitialized() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PromoteSlave promotes to master the initialized slave with the highest replication
+// offset, so no data is lost when the shard master is gone. It returns an error if the shard
+// already has a master or there are no initialized slaves. Replication info must have been
+// previously discovered using ReplicationInfoDiscoveryOpt.
+func (shard *Shard) PromoteSlave(ctx context.Context) (*RedisServer, error) {
+	var candidate *RedisServer
+
+	for _, srv := range shard.Servers {
+		if srv.Role == client.Master {
+			return nil, fmt.Errorf("shard %s already has a master", shard.Name)
+		}
+
+		if !srv.IsInitialized() {
+			continue
+		}
+
+		if candidate == nil || srv.Replication.Offset > candidate.Replication.Offset {
+			candidate = srv
+		}
+	}
+
+	if candidate == nil {
+		return nil, fmt.Errorf("shard %s has no initialized slaves to promote", shard.Name)
+	}
+
+	if err := candidate.Promote(ctx); err != nil {
+		return nil, err
+	}
+
+	return candidate, nil
+}
+
+// RepairReplication points to the current master all the slaves in the shard that replicate
+// from a different address. It returns the list of reconfigured servers. The shard must have been
+// previously discovered using ReplicationInfoDiscoveryOpt.
+func (shard *Shard) RepairReplication(ctx context.Context) ([]string, error) {
+	merr := operatorutils.MultiError{}
+	listChanged := []string{}
+
+	master, err := shard.GetMaster()
+	if err != nil {
+		return listChanged, err
+	}
+
+	for _, srv := range shard.Servers {
+		changed, err := srv.RepairSlave(ctx, master)
+		if err != nil {
+			merr = append(merr, err)
+
+			continue
+		}
+
+		if changed {
+			listChanged = append(listChanged, srv.ID())
+		}
+	}
+
+	return listChanged, merr.ErrorOrNil()
+}
@@ -1007,6 +1007,200 @@ func TestShard_Init(t *testing.T) {
 	}
 }
 
+func TestShard_RepairReplication(t *testing.T) {
+	tests := []struct {
+		name    string
+		servers []*RedisServer
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "Points slaves with a stale master address to the current master",
+			servers: []*RedisServer{
+				{
+					Server:      redis.NewFakeServerWithFakeClient("127.0.0.1", "1000"),
+					Role:        client.Master,
+					Replication: &ReplicationInfo{Offset: 100},
+				},
+				{
+					Server: redis.NewFakeServerWithFakeClient("127.0.0.1", "2000",
+						client.FakeResponse{
+							InjectResponse: func() any { return nil },
+							InjectError:    func() error { return nil },
+						},
+					),
+					Role:        client.Slave,
+					Replication: &ReplicationInfo{MasterHost: "10.0.0.1", MasterPort: "1000", MasterLinkStatus: "down", Offset: 90},
+				},
+				{
+					Server:      redis.NewFakeServerWithFakeClient("127.0.0.1", "3000"),
+					Role:        client.Slave,
+					Replication: &ReplicationInfo{MasterHost: "127.0.0.1", MasterPort: "1000", MasterLinkStatus: "up"},
+				},
+				{
+					Server:      redis.NewFakeServerWithFakeClient("127.0.0.1", "4000"),
+					Role:        client.Slave,
+					Replication: &ReplicationInfo{MasterHost: "127.0.0.1", MasterPort: "6379", MasterLinkStatus: "down"},
+				},
+			},
+			want:    []string{"127.0.0.1:2000"},
+			wantErr: false,
+		},
+		{
+			name: "Returns error if there is no master",
+			servers: []*RedisServer{
+				{
+					Server:      redis.NewFakeServerWithFakeClient("127.0.0.1", "2000"),
+					Role:        client.Slave,
+					Replication: &ReplicationInfo{MasterHost: "10.0.0.1", MasterPort: "1000", MasterLinkStatus: "down"},
+				},
+			},
+			want:    []string{},
+			wantErr: true,
+		},
+		{
+			name: "Returns error if the master is behind the slave",
+			servers: []*RedisServer{
+				{
+					Server:      redis.NewFakeServerWithFakeClient("127.0.0.1", "1000"),
+					Role:        client.Master,
+					Replication: &ReplicationInfo{Offset: 0},
+				},
+				{
+					Server:      redis.NewFakeServerWithFakeClient("127.0.0.1", "2000"),
+					Role:        client.Slave,
+					Replication: &ReplicationInfo{MasterHost: "10.0.0.1", MasterPort: "1000", MasterLinkStatus: "down", Offset: 500},
+				},
+			},
+			want:    []string{},
+			wantErr: true,
+		},
+		{
+			name: "Returns error if SLAVEOF fails",
+			servers: []*RedisServer{
+				{
+					Server:      redis.NewFakeServerWithFakeClient("127.0.0.1", "1000"),
+					Role:        client.Master,
+					Replication: &ReplicationInfo{Offset: 100},
+				},
+				{
+					Server: redis.NewFakeServerWithFakeClient("127.0.0.1", "2000",
+						client.FakeResponse{
+							InjectResponse: func() any { return nil },
+							InjectError:    func() error { return errors.New("error") },
+						},
+					),
+					Role:        client.Slave,
+					Replication: &ReplicationInfo{MasterHost: "10.0.0.1", MasterPort: "1000", MasterLinkStatus: "down"},
+				},
+			},
+			want:    []string{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Shard{
+				Name:    "test",
+				Servers: tt.servers,
+			}
+
+			got, err := s.RepairReplication(context.TODO())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Shard.RepairReplication() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Shard.RepairReplication() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShard_PromoteSlave(t *testing.T) {
+	tests := []struct {
+		name    string
+		servers []*RedisServer
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Promotes the initialized slave with the highest offset",
+			servers: []*RedisServer{
+				{
+					Server:      redis.NewFakeServerWithFakeClient("127.0.0.1", "1000"),
+					Role:        client.Slave,
+					Replication: &ReplicationInfo{MasterHost: "127.0.0.1", MasterPort: "6379"},
+				},
+				{
+					Server:      redis.NewFakeServerWithFakeClient("127.0.0.1", "2000"),
+					Role:        client.Slave,
+					Replication: &ReplicationInfo{MasterHost: "10.0.0.1", MasterPort: "1000", Offset: 100},
+				},
+				{
+					Server: redis.NewFakeServerWithFakeClient("127.0.0.1", "3000",
+						client.FakeResponse{
+							InjectResponse: func() any { return nil },
+							InjectError:    func() error { return nil },
+						},
+					),
+					Role:        client.Slave,
+					Replication: &ReplicationInfo{MasterHost: "10.0.0.1", MasterPort: "1000", Offset: 200},
+				},
+			},
+			want:    "127.0.0.1:3000",
+			wantErr: false,
+		},
+		{
+			name: "Returns error if the shard already has a master",
+			servers: []*RedisServer{
+				{
+					Server: redis.NewFakeServerWithFakeClient("127.0.0.1", "1000"),
+					Role:   client.Master,
+				},
+				{
+					Server:      redis.NewFakeServerWithFakeClient("127.0.0.1", "2000"),
+					Role:        client.Slave,
+					Replication: &ReplicationInfo{MasterHost: "10.0.0.1", MasterPort: "1000", Offset: 100},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Returns error if there are no initialized slaves",
+			servers: []*RedisServer{
+				{
+					Server:      redis.NewFakeServerWithFakeClient("127.0.0.1", "1000"),
+					Role:        client.Slave,
+					Replication: &ReplicationInfo{MasterHost: "127.0.0.1", MasterPort: "6379"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Shard{
+				Name:    "test",
+				Servers: tt.servers,
+			}
+
+			got, err := s.PromoteSlave(context.TODO())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Shard.PromoteSlave() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if err == nil && (got.ID() != tt.want || got.Role != client.Master) {
+				t.Errorf("Shard.PromoteSlave() = %v|%v, want %v|master", got.ID(), got.Role, tt.want)
+			}
+		})
+	}
+}
+
 func TestShard_GetMasterAddr(t *testing.T) {
 	type fields struct {
 		Name    string
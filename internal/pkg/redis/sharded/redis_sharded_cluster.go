@@ -137,6 +137,46 @@ func (cluster *Cluster) SentinelDiscover(ctx context.Context, opts ...DiscoveryO
 		return append(merr, errors.New("unable to find a healthy sentinel server"))
 	}
 
+	return cluster.sentinelDiscover(ctx, sentinel, opts...)
+}
+
+// CachedSentinelDiscover updates the status of the cluster as seen from sentinel, but
+// reuses the result of a previous discovery, stored in the ServerPool, if it is not older
+// than maxAge. Only discoveries that complete without errors are cached. The shards of a
+// cluster populated from the cache are shared with other clusters and must be treated as
+// read-only.
+func (cluster *Cluster) CachedSentinelDiscover(ctx context.Context, maxAge time.Duration, opts ...DiscoveryOption) error {
+	key := DiscoveryOptionSet(opts).String()
+
+	for _, sentinel := range cluster.Sentinels {
+		if cached, ok := cluster.pool.GetCachedDiscovery(sentinel.ID(), key, maxAge); ok {
+			cluster.Shards = append([]*Shard{}, cached.([]*Shard)...)
+
+			return nil
+		}
+	}
+
+	merr := operatorutils.MultiError{}
+
+	// Get a healthy sentinel server
+	sentinel := cluster.GetSentinel(ctx)
+	if sentinel == nil {
+		return append(merr, errors.New("unable to find a healthy sentinel server"))
+	}
+
+	started := time.Now()
+	if err := cluster.sentinelDiscover(ctx, sentinel, opts...); err != nil {
+		return err
+	}
+
+	cluster.pool.SetCachedDiscovery(sentinel.ID(), key, append([]*Shard{}, cluster.Shards...), started)
+
+	return nil
+}
+
+func (cluster *Cluster) sentinelDiscover(ctx context.Context, sentinel *SentinelServer, opts ...DiscoveryOption) error {
+	merr := operatorutils.MultiError{}
+
 	masters, err := sentinel.SentinelMasters(ctx)
 	if err != nil {
 		return append(merr, err)
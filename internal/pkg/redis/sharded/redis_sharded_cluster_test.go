@@ -5,6 +5,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/3scale-sre/saas-operator/internal/pkg/redis/client"
 	redis "github.com/3scale-sre/saas-operator/internal/pkg/redis/server"
@@ -769,6 +770,53 @@ func TestCluster_SentinelDiscover(t *testing.T) {
 	}
 }
 
+func TestCluster_CachedSentinelDiscover(t *testing.T) {
+	t.Run("Returns the cached discovery without querying sentinel", func(t *testing.T) {
+		pool := redis.NewServerPool()
+		// the fake sentinel has no responses, so any call to it would fail
+		sentinel := NewSentinelServerFromParams(redis.NewFakeServerWithFakeClient("127.0.0.1", "26379"))
+		shards := []*Shard{
+			{
+				Name: "shard0",
+				Servers: []*RedisServer{
+					NewRedisServerFromParams(redis.NewFakeServerWithFakeClient("127.0.0.1", "1000"), client.Master, map[string]string{}),
+				},
+				pool: pool,
+			},
+		}
+		pool.SetCachedDiscovery(sentinel.ID(), DiscoveryOptionSet{OnlyMasterDiscoveryOpt}.String(), shards, time.Now())
+
+		cluster := &Cluster{Sentinels: []*SentinelServer{sentinel}, pool: pool}
+		if err := cluster.CachedSentinelDiscover(context.TODO(), time.Minute, OnlyMasterDiscoveryOpt); err != nil {
+			t.Errorf("Cluster.CachedSentinelDiscover() error = %v", err)
+		}
+
+		if diff := deep.Equal(cluster.Shards, shards); len(diff) > 0 {
+			t.Errorf("Cluster.CachedSentinelDiscover() got diff: %v", diff)
+		}
+	})
+
+	t.Run("Does not use cached results for other discovery options", func(t *testing.T) {
+		pool := redis.NewServerPool()
+		sentinel := NewSentinelServerFromParams(redis.NewFakeServerWithFakeClient("127.0.0.1", "26379",
+			client.FakeResponse{
+				// cmd: SentinelPing()
+				InjectResponse: func() any { return nil },
+				InjectError:    func() error { return errors.New("error") },
+			},
+		))
+		pool.SetCachedDiscovery(sentinel.ID(), DiscoveryOptionSet{OnlyMasterDiscoveryOpt}.String(), []*Shard{}, time.Now())
+
+		ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+		defer cancel()
+
+		cluster := &Cluster{Sentinels: []*SentinelServer{sentinel}, pool: pool}
+		if err := cluster.CachedSentinelDiscover(ctx, time.Minute, SlaveReadOnlyDiscoveryOpt); err == nil {
+			t.Errorf("Cluster.CachedSentinelDiscover() expected an error")
+		}
+	})
+}
+
 func TestCluster_GetSentinel(t *testing.T) {
 	type fields struct {
 		Shards    []*Shard
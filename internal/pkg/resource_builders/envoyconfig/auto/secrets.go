@@ -3,9 +3,12 @@ package auto
 import (
 	"github.com/3scale-sre/marin3r/api/envoy"
 	marin3rv1alpha1 "github.com/3scale-sre/marin3r/api/marin3r/v1alpha1"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_extensions_transport_sockets_quic_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
 	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/samber/lo"
+	"k8s.io/utils/ptr"
 )
 
 func GenerateSecrets(resources []envoy.Resource) ([]marin3rv1alpha1.EnvoySecretResource, error) {
@@ -31,22 +34,127 @@ func GenerateSecrets(resources []envoy.Resource) ([]marin3rv1alpha1.EnvoySecretR
 	return secrets, nil
 }
 
-func secretRefsFromListener(listener *envoy_config_listener_v3.Listener) ([]string, error) {
-	if listener.GetFilterChains()[0].GetTransportSocket() == nil {
+// GenerateValidationContextSecrets returns the secret resources, using the validation context
+// blueprint, for the certificate validation contexts that the passed resources fetch using SDS.
+func GenerateValidationContextSecrets(resources []envoy.Resource) ([]marin3rv1alpha1.Resource, error) {
+	refs := []string{}
+
+	for _, res := range resources {
+		switch o := res.(type) {
+		case *envoy_config_cluster_v3.Cluster:
+			secrets, err := validationContextRefsFromCluster(o)
+			if err != nil {
+				return nil, err
+			}
+
+			refs = append(refs, secrets...)
+
+		case *envoy_config_listener_v3.Listener:
+			secrets, err := validationContextRefsFromListener(o)
+			if err != nil {
+				return nil, err
+			}
+
+			refs = append(refs, secrets...)
+		}
+	}
+
+	secrets := []marin3rv1alpha1.Resource{}
+	for _, ref := range lo.Uniq(refs) {
+		secrets = append(secrets, marin3rv1alpha1.Resource{
+			Type:                  envoy.Secret,
+			GenerateFromTlsSecret: ptr.To(ref),
+			Blueprint:             ptr.To(marin3rv1alpha1.TlsValidationContext),
+		})
+	}
+
+	return secrets, nil
+}
+
+func validationContextRefsFromCluster(cluster *envoy_config_cluster_v3.Cluster) ([]string, error) {
+	if cluster.GetTransportSocket() == nil {
+		return nil, nil
+	}
+
+	proto, err := cluster.GetTransportSocket().GetTypedConfig().UnmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsContext, ok := proto.(*envoy_extensions_transport_sockets_tls_v3.UpstreamTlsContext)
+	if !ok {
 		return nil, nil
 	}
 
+	return validationContextRefsFromCommonTlsContext(tlsContext.GetCommonTlsContext()), nil
+}
+
+func validationContextRefsFromListener(listener *envoy_config_listener_v3.Listener) ([]string, error) {
+	secrets := []string{}
+
+	tlsContexts, err := downstreamTlsContextsFromListener(listener)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tlsContext := range tlsContexts {
+		secrets = append(secrets, validationContextRefsFromCommonTlsContext(tlsContext.GetCommonTlsContext())...)
+	}
+
+	return lo.Uniq(secrets), nil
+}
+
+func validationContextRefsFromCommonTlsContext(ctx *envoy_extensions_transport_sockets_tls_v3.CommonTlsContext) []string {
+	if sdsConfig := ctx.GetValidationContextSdsSecretConfig(); sdsConfig != nil {
+		return []string{sdsConfig.GetName()}
+	}
+
+	if sdsConfig := ctx.GetCombinedValidationContext().GetValidationContextSdsSecretConfig(); sdsConfig != nil {
+		return []string{sdsConfig.GetName()}
+	}
+
+	return nil
+}
+
+func secretRefsFromListener(listener *envoy_config_listener_v3.Listener) ([]string, error) {
 	secrets := []string{}
 
-	proto, err := listener.GetFilterChains()[0].GetTransportSocket().GetTypedConfig().UnmarshalNew()
+	tlsContexts, err := downstreamTlsContextsFromListener(listener)
 	if err != nil {
 		return nil, err
 	}
 
-	tlsContext := proto.(*envoy_extensions_transport_sockets_tls_v3.DownstreamTlsContext)
-	for _, sdsConfig := range tlsContext.GetCommonTlsContext().GetTlsCertificateSdsSecretConfigs() {
-		secrets = append(secrets, sdsConfig.GetName())
+	for _, tlsContext := range tlsContexts {
+		for _, sdsConfig := range tlsContext.GetCommonTlsContext().GetTlsCertificateSdsSecretConfigs() {
+			secrets = append(secrets, sdsConfig.GetName())
+		}
 	}
 
 	return lo.Uniq(secrets), nil
 }
+
+// downstreamTlsContextsFromListener returns the tls contexts of all the filter
+// chains of the listener, both for tls and quic transport sockets
+func downstreamTlsContextsFromListener(listener *envoy_config_listener_v3.Listener) ([]*envoy_extensions_transport_sockets_tls_v3.DownstreamTlsContext, error) {
+	tlsContexts := []*envoy_extensions_transport_sockets_tls_v3.DownstreamTlsContext{}
+
+	for _, chain := range listener.GetFilterChains() {
+		if chain.GetTransportSocket() == nil {
+			continue
+		}
+
+		proto, err := chain.GetTransportSocket().GetTypedConfig().UnmarshalNew()
+		if err != nil {
+			return nil, err
+		}
+
+		switch o := proto.(type) {
+		case *envoy_extensions_transport_sockets_tls_v3.DownstreamTlsContext:
+			tlsContexts = append(tlsContexts, o)
+		case *envoy_extensions_transport_sockets_quic_v3.QuicDownstreamTransport:
+			tlsContexts = append(tlsContexts, o.GetDownstreamTlsContext())
+		}
+	}
+
+	return tlsContexts, nil
+}
@@ -41,6 +41,57 @@ func Test_secretRefsFromListener(t *testing.T) {
 			want:    []string{"my_certificate"},
 			wantErr: false,
 		},
+		{
+			name: "returns the list of secrets used by a tcp listener",
+			args: args{
+				listener: func() *envoy_config_listener_v3.Listener {
+					l, _ := templates.ListenerTCP_v1("test", &saasv1alpha1.ListenerTcp{
+						Port:                  6380,
+						TLSMode:               ptr.To(saasv1alpha1.ListenerTcpTLSModeTerminate),
+						CertificateSecretName: ptr.To("my_certificate"),
+						Cluster:               ptr.To("default"),
+						SNIRoutes: []saasv1alpha1.ListenerTcpSNIRoute{
+							{ServerNames: []string{"a.example.com"}, Cluster: "a"},
+						},
+					})
+
+					return l.(*envoy_config_listener_v3.Listener)
+				}(),
+			},
+			want:    []string{"my_certificate"},
+			wantErr: false,
+		},
+		{
+			name: "returns the list of secrets used by an http3 listener",
+			args: args{
+				listener: func() *envoy_config_listener_v3.Listener {
+					l, _ := templates.ListenerHTTP3_v1("test", &saasv1alpha1.ListenerHttp3{
+						Port:                  8443,
+						RouteConfigName:       "my_route",
+						CertificateSecretName: "my_certificate",
+					})
+
+					return l.(*envoy_config_listener_v3.Listener)
+				}(),
+			},
+			want:    []string{"my_certificate"},
+			wantErr: false,
+		},
+		{
+			name: "returns no secrets for a plain tcp listener",
+			args: args{
+				listener: func() *envoy_config_listener_v3.Listener {
+					l, _ := templates.ListenerTCP_v1("test", &saasv1alpha1.ListenerTcp{
+						Port:    6379,
+						Cluster: ptr.To("default"),
+					})
+
+					return l.(*envoy_config_listener_v3.Listener)
+				}(),
+			},
+			want:    []string{},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -130,3 +181,116 @@ func TestGenerateSecrets(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateValidationContextSecrets(t *testing.T) {
+	type args struct {
+		resources []envoy.Resource
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    []marin3rv1alpha1.Resource
+		wantErr bool
+	}{
+		{
+			name: "Generates validation context secret resources",
+			args: args{
+				resources: []envoy.Resource{
+					func() envoy.Resource {
+						c, _ := templates.Cluster_v2("cluster1", &saasv1alpha1.Cluster{
+							Host:        "example.com",
+							Port:        443,
+							UpstreamTLS: &saasv1alpha1.ClusterUpstreamTLS{CASecretName: ptr.To("ca")},
+						})
+
+						return c
+					}(),
+					func() envoy.Resource {
+						c, _ := templates.Cluster_v2("cluster2", &saasv1alpha1.Cluster{
+							Host:        "example.com",
+							Port:        443,
+							UpstreamTLS: &saasv1alpha1.ClusterUpstreamTLS{CASecretName: ptr.To("ca")},
+						})
+
+						return c
+					}(),
+					func() envoy.Resource {
+						c, _ := templates.Cluster_v2("cluster3", &saasv1alpha1.Cluster{
+							Host:        "example.com",
+							Port:        443,
+							UpstreamTLS: &saasv1alpha1.ClusterUpstreamTLS{},
+						})
+
+						return c
+					}(),
+					func() envoy.Resource {
+						c, _ := templates.Cluster_v1("cluster4", &saasv1alpha1.Cluster{
+							Host:    "localhost",
+							Port:    8080,
+							IsHttp2: ptr.To(false),
+						})
+
+						return c
+					}(),
+				},
+			},
+			want: []marin3rv1alpha1.Resource{{
+				Type:                  envoy.Secret,
+				GenerateFromTlsSecret: ptr.To("ca"),
+				Blueprint:             ptr.To(marin3rv1alpha1.TlsValidationContext),
+			}},
+			wantErr: false,
+		},
+		{
+			name: "Generates validation context secret resources for listeners",
+			args: args{
+				resources: []envoy.Resource{
+					func() envoy.Resource {
+						l, _ := templates.ListenerHTTP_v1("listener1", &saasv1alpha1.ListenerHttp{
+							Port:                  8443,
+							RouteConfigName:       "my_route",
+							CertificateSecretName: ptr.To("my_certificate"),
+							EnableHttp2:           ptr.To(false),
+							ProxyProtocol:         ptr.To(false),
+							ClientValidation:      &saasv1alpha1.ListenerClientValidation{CASecretName: "client-ca"},
+						})
+
+						return l
+					}(),
+					func() envoy.Resource {
+						l, _ := templates.ListenerHTTP_v1("listener2", &saasv1alpha1.ListenerHttp{
+							Port:                  8080,
+							RouteConfigName:       "my_route",
+							CertificateSecretName: ptr.To("my_certificate"),
+							EnableHttp2:           ptr.To(false),
+							ProxyProtocol:         ptr.To(false),
+						})
+
+						return l
+					}(),
+				},
+			},
+			want: []marin3rv1alpha1.Resource{{
+				Type:                  envoy.Secret,
+				GenerateFromTlsSecret: ptr.To("client-ca"),
+				Blueprint:             ptr.To(marin3rv1alpha1.TlsValidationContext),
+			}},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GenerateValidationContextSecrets(tt.args.resources)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GenerateValidationContextSecrets() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GenerateValidationContextSecrets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
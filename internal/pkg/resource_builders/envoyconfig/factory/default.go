@@ -10,8 +10,12 @@ import (
 
 var f = EnvoyDynamicConfigFactory{
 	"ListenerHttp_v1":       RegisterTemplate(templates.ListenerHTTP_v1, &envoy_config_listener_v3.Listener{}),
+	"ListenerHttp3_v1":      RegisterTemplate(templates.ListenerHTTP3_v1, &envoy_config_listener_v3.Listener{}),
+	"ListenerTcp_v1":        RegisterTemplate(templates.ListenerTCP_v1, &envoy_config_listener_v3.Listener{}),
 	"Cluster_v1":            RegisterTemplate(templates.Cluster_v1, &envoy_config_cluster_v3.Cluster{}),
+	"Cluster_v2":            RegisterTemplate(templates.Cluster_v2, &envoy_config_cluster_v3.Cluster{}),
 	"RouteConfiguration_v1": RegisterTemplate(templates.RouteConfiguration_v1, &envoy_config_route_v3.RouteConfiguration{}),
+	"RouteConfiguration_v2": RegisterTemplate(templates.RouteConfiguration_v2, &envoy_config_route_v3.RouteConfiguration{}),
 	"Runtime_v1":            RegisterTemplate(templates.Runtime_v1, &envoy_service_runtime_v3.Runtime{}),
 	"RawConfig_v1":          RegisterTemplate(templates.RawConfig_v1, nil),
 }
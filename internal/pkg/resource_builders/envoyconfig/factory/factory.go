@@ -3,6 +3,8 @@ package factory
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/3scale-sre/marin3r/api/envoy"
 	descriptor "github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/envoyconfig/descriptor"
@@ -41,6 +43,23 @@ func (factory EnvoyDynamicConfigFactory) GetClass(v descriptor.EnvoyDynamicConfi
 	return class, nil
 }
 
+// GeneratorVersions returns the sorted list of generator versions registered
+// for the type of options of the given descriptor
+func (factory EnvoyDynamicConfigFactory) GeneratorVersions(v descriptor.EnvoyDynamicConfigDescriptor) []string {
+	prefix := reflect.TypeOf(v.GetOptions()).Elem().Name() + "_"
+	versions := []string{}
+
+	for name := range factory {
+		if version, ok := strings.CutPrefix(name, prefix); ok {
+			versions = append(versions, version)
+		}
+	}
+
+	sort.Strings(versions)
+
+	return versions
+}
+
 func (factory EnvoyDynamicConfigFactory) NewResource(desc descriptor.EnvoyDynamicConfigDescriptor) (envoy.Resource, error) {
 	class, err := factory.GetClass(desc)
 	if err != nil {
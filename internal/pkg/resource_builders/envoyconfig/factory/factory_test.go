@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/3scale-sre/marin3r/api/envoy"
@@ -117,3 +118,34 @@ func TestEnvoyDynamicConfigFactory_NewResource(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvoyDynamicConfigFactory_GeneratorVersions(t *testing.T) {
+	factory := EnvoyDynamicConfigFactory{
+		"testOptions_v2": RegisterTemplate(testTemplate, &envoy_service_runtime_v3.Runtime{}),
+		"testOptions_v1": RegisterTemplate(testTemplate, &envoy_service_runtime_v3.Runtime{}),
+	}
+
+	tests := []struct {
+		name       string
+		descriptor descriptor.EnvoyDynamicConfigDescriptor
+		want       []string
+	}{
+		{
+			name:       "Returns the versions registered for the options type",
+			descriptor: &testDescriptor{opts: &testOptions{}},
+			want:       []string{"v1", "v2"},
+		},
+		{
+			name:       "Returns an empty list for unregistered options types",
+			descriptor: &unregisteredType{opts: &opts{}},
+			want:       []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := factory.GeneratorVersions(tt.descriptor); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnvoyDynamicConfigFactory.GeneratorVersions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
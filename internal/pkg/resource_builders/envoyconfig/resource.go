@@ -15,6 +15,7 @@ import (
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_service_runtime_v3 "github.com/envoyproxy/go-control-plane/envoy/service/runtime/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -22,16 +23,22 @@ import (
 )
 
 func New(key types.NamespacedName, nodeID string, factory factory.EnvoyDynamicConfigFactory, resources ...descriptor.EnvoyDynamicConfigDescriptor) func(client.Object) (*marin3rv1alpha1.EnvoyConfig, error) {
-	return func(client.Object) (*marin3rv1alpha1.EnvoyConfig, error) {
-		protos := []envoy.Resource{}
+	return NewWithTrafficSplit(key, nodeID, factory, nil, resources...)
+}
 
-		for _, res := range resources {
-			proto, err := factory.NewResource(res)
-			if err != nil {
-				return nil, err
-			}
+// NewWithTrafficSplit is like New but the requests routed to local clusters are
+// split between the main and the canary Deployments as described by split. A nil
+// split generates the same config as New.
+func NewWithTrafficSplit(key types.NamespacedName, nodeID string, factory factory.EnvoyDynamicConfigFactory, split *TrafficSplit,
+	resources ...descriptor.EnvoyDynamicConfigDescriptor) func(client.Object) (*marin3rv1alpha1.EnvoyConfig, error) {
+	return func(client.Object) (*marin3rv1alpha1.EnvoyConfig, error) {
+		protos, err := generate(factory, split, resources...)
+		if err != nil {
+			return nil, err
+		}
 
-			protos = append(protos, proto)
+		if err := validate(protos); err != nil {
+			return nil, err
 		}
 
 		ec, err := newFromProtos(key, nodeID, protos)()
@@ -55,6 +62,18 @@ func newFromProtos(key types.NamespacedName, nodeID string, resources []envoy.Re
 			return nil, err
 		}
 
+		validationContexts, err := auto.GenerateValidationContextSecrets(resources)
+		if err != nil {
+			return nil, err
+		}
+
+		// validation context secrets can only be expressed using the 'spec.resources'
+		// format of the EnvoyConfig. The 'spec.envoyResources' format is kept otherwise
+		// so the already existing EnvoyConfigs don't change.
+		if len(validationContexts) > 0 {
+			return newFromProtosAsResources(key, nodeID, resources, secrets, validationContexts)
+		}
+
 		for i := range resources {
 			j, err := envoy_serializer_v3.JSON{}.Marshal(resources[i])
 			if err != nil {
@@ -104,3 +123,58 @@ func newFromProtos(key types.NamespacedName, nodeID string, resources []envoy.Re
 		}, nil
 	}
 }
+
+func newFromProtosAsResources(key types.NamespacedName, nodeID string, resources []envoy.Resource,
+	secrets []marin3rv1alpha1.EnvoySecretResource, validationContexts []marin3rv1alpha1.Resource) (*marin3rv1alpha1.EnvoyConfig, error) {
+	list := make([]marin3rv1alpha1.Resource, 0, len(resources)+len(secrets)+len(validationContexts))
+
+	for i := range resources {
+		j, err := envoy_serializer_v3.JSON{}.Marshal(resources[i])
+		if err != nil {
+			return nil, err
+		}
+
+		var rtype envoy.Type
+
+		switch resources[i].(type) {
+		case *envoy_config_cluster_v3.Cluster:
+			rtype = envoy.Cluster
+
+		case *envoy_config_route_v3.RouteConfiguration:
+			rtype = envoy.Route
+
+		case *envoy_config_listener_v3.Listener:
+			rtype = envoy.Listener
+
+		case *envoy_service_runtime_v3.Runtime:
+			rtype = envoy.Runtime
+
+		default:
+			return nil, errors.New("unknown dynamic configuration type")
+		}
+
+		list = append(list, marin3rv1alpha1.Resource{Type: rtype, Value: &runtime.RawExtension{Raw: []byte(j)}})
+	}
+
+	for _, secret := range secrets {
+		list = append(list, marin3rv1alpha1.Resource{
+			Type:                  envoy.Secret,
+			GenerateFromTlsSecret: ptr.To(secret.Name),
+			Blueprint:             ptr.To(marin3rv1alpha1.TlsCertificate),
+		})
+	}
+
+	list = append(list, validationContexts...)
+
+	return &marin3rv1alpha1.EnvoyConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+		Spec: marin3rv1alpha1.EnvoyConfigSpec{
+			EnvoyAPI:  ptr.To(envoy.APIv3),
+			NodeID:    nodeID,
+			Resources: list,
+		},
+	}, nil
+}
@@ -21,6 +21,7 @@ import (
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"google.golang.org/protobuf/types/known/durationpb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 )
@@ -66,6 +67,14 @@ func TestNew(t *testing.T) {
 							ProxyProtocol:               ptr.To(true),
 						},
 					},
+					"routeconfig": {
+						GeneratorVersion: ptr.To("v1"),
+						RouteConfiguration: &saasv1alpha1.RouteConfiguration{
+							VirtualHosts: []runtime.RawExtension{{
+								Raw: []byte(`{"name":"vhost","domains":["*"],"routes":[{"match":{"prefix":"/"},"route":{"cluster":"my_cluster"}}]}`),
+							}},
+						},
+					},
 				}.AsList(),
 			},
 			want: &marin3rv1alpha1.EnvoyConfig{
@@ -177,7 +186,20 @@ func TestNew(t *testing.T) {
                               per_connection_buffer_limit_bytes: 32768
 							`),
 						}},
-						Routes:   []marin3rv1alpha1.EnvoyResource{},
+						Routes: []marin3rv1alpha1.EnvoyResource{{
+							Value: heredoc.Doc(`
+                                name: routeconfig
+                                virtual_hosts:
+                                - domains:
+                                  - '*'
+                                  name: vhost
+                                  routes:
+                                  - match:
+                                      prefix: /
+                                    route:
+                                      cluster: my_cluster
+							`),
+						}},
 						Runtimes: []marin3rv1alpha1.EnvoyResource{},
 						Secrets:  []marin3rv1alpha1.EnvoySecretResource{{Name: "certificate"}},
 					},
@@ -185,6 +207,157 @@ func TestNew(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Generates an EnvoyConfig using the resources format if validation contexts are required",
+			args: args{
+				key:     types.NamespacedName{Name: "test", Namespace: "default"},
+				nodeID:  "test",
+				factory: factory.Default(),
+				resources: saasv1alpha1.MapOfEnvoyDynamicConfig{
+					"my_cluster": {
+						GeneratorVersion: ptr.To("v2"),
+						Cluster: &saasv1alpha1.Cluster{
+							Host:        "example.com",
+							Port:        443,
+							UpstreamTLS: &saasv1alpha1.ClusterUpstreamTLS{CASecretName: ptr.To("ca")},
+						},
+					},
+				}.AsList(),
+			},
+			want: &marin3rv1alpha1.EnvoyConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: marin3rv1alpha1.EnvoyConfigSpec{
+					NodeID:   "test",
+					EnvoyAPI: ptr.To(envoy.APIv3),
+					Resources: []marin3rv1alpha1.Resource{
+						{
+							Type: envoy.Cluster,
+							Value: &runtime.RawExtension{Raw: []byte(`{"name":"my_cluster","type":"STRICT_DNS","connect_timeout":"1s",` +
+								`"load_assignment":{"cluster_name":"my_cluster","endpoints":[{"lb_endpoints":[{"endpoint":{"address":` +
+								`{"socket_address":{"address":"example.com","port_value":443}}}}]}]},"dns_lookup_family":"V4_ONLY",` +
+								`"transport_socket":{"name":"envoy.transport_sockets.tls","typed_config":{"@type":` +
+								`"type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext","common_tls_context":` +
+								`{"tls_params":{"tls_minimum_protocol_version":"TLSv1_2","tls_maximum_protocol_version":"TLSv1_3"},` +
+								`"validation_context_sds_secret_config":{"name":"ca","sds_config":{"ads":{},"resource_api_version":"V3"}}},` +
+								`"sni":"example.com"}}}`)},
+						},
+						{
+							Type:                  envoy.Secret,
+							GenerateFromTlsSecret: ptr.To("ca"),
+							Blueprint:             ptr.To(marin3rv1alpha1.TlsValidationContext),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Fails if a listener references an undefined route configuration",
+			args: args{
+				key:     types.NamespacedName{Name: "test", Namespace: "default"},
+				nodeID:  "test",
+				factory: factory.Default(),
+				resources: saasv1alpha1.MapOfEnvoyDynamicConfig{
+					"my_listener": {
+						GeneratorVersion: ptr.To("v1"),
+						ListenerHttp: &saasv1alpha1.ListenerHttp{
+							Port:            8080,
+							RouteConfigName: "undefined",
+							EnableHttp2:     ptr.To(false),
+							ProxyProtocol:   ptr.To(false),
+						},
+					},
+				}.AsList(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails if a route references an undefined cluster",
+			args: args{
+				key:     types.NamespacedName{Name: "test", Namespace: "default"},
+				nodeID:  "test",
+				factory: factory.Default(),
+				resources: saasv1alpha1.MapOfEnvoyDynamicConfig{
+					"routeconfig": {
+						GeneratorVersion: ptr.To("v2"),
+						RouteConfiguration: &saasv1alpha1.RouteConfiguration{
+							TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+								Name:    "vhost",
+								Domains: []string{"*"},
+								Routes: []saasv1alpha1.Route{{
+									Match: saasv1alpha1.RouteMatch{Prefix: ptr.To("/")},
+									WeightedClusters: []saasv1alpha1.WeightedCluster{
+										{Name: "stable", Weight: 90},
+										{Name: "canary", Weight: 10},
+									},
+								}},
+							}},
+						},
+					},
+					"stable": {
+						GeneratorVersion: ptr.To("v1"),
+						Cluster:          &saasv1alpha1.Cluster{Host: "localhost", Port: 8080, IsHttp2: ptr.To(false)},
+					},
+				}.AsList(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails if the rate limit cluster is not defined",
+			args: args{
+				key:     types.NamespacedName{Name: "test", Namespace: "default"},
+				nodeID:  "test",
+				factory: factory.Default(),
+				resources: saasv1alpha1.MapOfEnvoyDynamicConfig{
+					"my_listener": {
+						GeneratorVersion: ptr.To("v1"),
+						ListenerHttp: &saasv1alpha1.ListenerHttp{
+							Port:            8080,
+							RouteConfigName: "routeconfig",
+							EnableHttp2:     ptr.To(false),
+							ProxyProtocol:   ptr.To(false),
+							RateLimitOptions: &saasv1alpha1.RateLimitOptions{
+								Domain:           "domain",
+								FailureModeDeny:  ptr.To(false),
+								Timeout:          metav1.Duration{Duration: 10 * time.Millisecond},
+								RateLimitCluster: "ratelimit",
+							},
+						},
+					},
+					"routeconfig": {
+						GeneratorVersion: ptr.To("v1"),
+						RouteConfiguration: &saasv1alpha1.RouteConfiguration{
+							VirtualHosts: []runtime.RawExtension{{
+								Raw: []byte(`{"name":"vhost","domains":["*"],"routes":[{"match":{"prefix":"/"},"direct_response":{"status":200}}]}`),
+							}},
+						},
+					},
+				}.AsList(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails if a resource does not pass protobuf validation",
+			args: args{
+				key:     types.NamespacedName{Name: "test", Namespace: "default"},
+				nodeID:  "test",
+				factory: factory.Default(),
+				resources: saasv1alpha1.MapOfEnvoyDynamicConfig{
+					"routeconfig": {
+						GeneratorVersion: ptr.To("v1"),
+						RouteConfiguration: &saasv1alpha1.RouteConfiguration{
+							VirtualHosts: []runtime.RawExtension{{
+								Raw: []byte(`{"name":"vhost","domains":[],"routes":[{"match":{"prefix":"/"},"direct_response":{"status":200}}]}`),
+							}},
+						},
+					},
+				}.AsList(),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
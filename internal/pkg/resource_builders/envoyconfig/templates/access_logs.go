@@ -0,0 +1,248 @@
+package templates
+
+import (
+	"fmt"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	envoy_config_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_access_loggers_file_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	envoy_extensions_access_loggers_grpc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	envoy_extensions_access_loggers_stream_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/stream/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	proto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/utils/ptr"
+)
+
+// AccessLogConfig_v1 renders the access log configuration of a listener. When
+// no options are given, a JSON access log with the default fields is written
+// to /dev/stdout.
+func AccessLogConfig_v1(name string, tls bool, opts *saasv1alpha1.AccessLogOptions) ([]*envoy_config_accesslog_v3.AccessLog, error) {
+	if opts == nil {
+		opts = &saasv1alpha1.AccessLogOptions{}
+	}
+
+	sink := ptr.Deref(opts.Sink, saasv1alpha1.AccessLogSink{Type: saasv1alpha1.AccessLogSinkFile})
+
+	var (
+		loggerName string
+		config     proto.Message
+	)
+
+	// a nil format leaves the format unset so envoy uses its default one
+	format := AccessLogFormat_v1(name, tls, opts)
+
+	switch sink.Type {
+	case saasv1alpha1.AccessLogSinkFile:
+		fileConfig := &envoy_extensions_access_loggers_file_v3.FileAccessLog{
+			Path: ptr.Deref(sink.Path, "/dev/stdout"),
+		}
+		if format != nil {
+			fileConfig.AccessLogFormat = &envoy_extensions_access_loggers_file_v3.FileAccessLog_LogFormat{LogFormat: format}
+		}
+
+		loggerName = "envoy.access_loggers.file"
+		config = fileConfig
+
+	case saasv1alpha1.AccessLogSinkStdout:
+		stdoutConfig := &envoy_extensions_access_loggers_stream_v3.StdoutAccessLog{}
+		if format != nil {
+			stdoutConfig.AccessLogFormat = &envoy_extensions_access_loggers_stream_v3.StdoutAccessLog_LogFormat{LogFormat: format}
+		}
+
+		loggerName = "envoy.access_loggers.stdout"
+		config = stdoutConfig
+
+	case saasv1alpha1.AccessLogSinkGRPC:
+		if sink.Cluster == nil {
+			return nil, fmt.Errorf("access log sink of type %s requires a cluster", sink.Type)
+		}
+
+		loggerName = "envoy.access_loggers.http_grpc"
+		config = &envoy_extensions_access_loggers_grpc_v3.HttpGrpcAccessLogConfig{
+			CommonConfig: &envoy_extensions_access_loggers_grpc_v3.CommonGrpcAccessLogConfig{
+				LogName: name,
+				GrpcService: &envoy_config_core_v3.GrpcService{
+					TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+						EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+							ClusterName: *sink.Cluster,
+						},
+					},
+				},
+				TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
+			},
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown access log sink type '%s'", sink.Type)
+	}
+
+	if v, ok := config.(validatable); ok {
+		if err := v.ValidateAll(); err != nil {
+			return nil, fmt.Errorf("invalid access log config: %w", err)
+		}
+	}
+
+	typed, err := anypb.New(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*envoy_config_accesslog_v3.AccessLog{{
+		Name:       loggerName,
+		Filter:     AccessLogFilter_v1(opts.Filter),
+		ConfigType: &envoy_config_accesslog_v3.AccessLog_TypedConfig{TypedConfig: typed},
+	}}, nil
+}
+
+// AccessLogFormat_v1 returns the format of the access log entries. The JSON
+// format has a default set of fields that can be extended or overridden. Returns
+// nil for the text format when no TextFormat is given, so envoy's default is used.
+func AccessLogFormat_v1(name string, tls bool, opts *saasv1alpha1.AccessLogOptions) *envoy_config_core_v3.SubstitutionFormatString {
+	if ptr.Deref(opts.Format, saasv1alpha1.AccessLogFormatJSON) == saasv1alpha1.AccessLogFormatText {
+		if opts.TextFormat == nil {
+			// use envoy's default format
+			return nil
+		}
+
+		return &envoy_config_core_v3.SubstitutionFormatString{
+			Format: &envoy_config_core_v3.SubstitutionFormatString_TextFormatSource{
+				TextFormatSource: &envoy_config_core_v3.DataSource{
+					Specifier: &envoy_config_core_v3.DataSource_InlineString{
+						InlineString: *opts.TextFormat,
+					},
+				},
+			},
+		}
+	}
+
+	fields := map[string]*structpb.Value{
+		"authority":             structpb.NewStringValue("%REQ(:AUTHORITY)%"),
+		"bytes_received":        structpb.NewStringValue("%BYTES_RECEIVED%"),
+		"bytes_sent":            structpb.NewStringValue("%BYTES_SENT%"),
+		"duration":              structpb.NewStringValue("%DURATION%"),
+		"method":                structpb.NewStringValue("%REQ(:METHOD)%"),
+		"path":                  structpb.NewStringValue("%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%"),
+		"protocol":              structpb.NewStringValue("%PROTOCOL%"),
+		"response_code":         structpb.NewStringValue("%RESPONSE_CODE%"),
+		"response_code_details": structpb.NewStringValue("%RESPONSE_CODE_DETAILS%"),
+		"response_flags":        structpb.NewStringValue("%RESPONSE_FLAGS%"),
+		"listener":              structpb.NewStringValue(name),
+		"upstream_cluster":      structpb.NewStringValue("%UPSTREAM_CLUSTER%"),
+		"upstream_service_time": structpb.NewStringValue("%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%"),
+		"user_agent":            structpb.NewStringValue("%REQ(USER-AGENT)%"),
+		"client_ip":             structpb.NewStringValue("%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%"),
+	}
+
+	if tls {
+		fields["downstream_tls_cipher"] = structpb.NewStringValue("%DOWNSTREAM_TLS_CIPHER%")
+		fields["downstream_tls_version"] = structpb.NewStringValue("%DOWNSTREAM_TLS_VERSION%")
+	}
+
+	for k, v := range opts.Fields {
+		if v == "" {
+			delete(fields, k)
+		} else {
+			fields[k] = structpb.NewStringValue(v)
+		}
+	}
+
+	return &envoy_config_core_v3.SubstitutionFormatString{
+		Format: &envoy_config_core_v3.SubstitutionFormatString_JsonFormat{
+			JsonFormat: &structpb.Struct{Fields: fields},
+		},
+	}
+}
+
+// AccessLogFilter_v1 translates the access log filter options into an
+// envoy AccessLogFilter. Returns nil if there is nothing to filter.
+func AccessLogFilter_v1(opts *saasv1alpha1.AccessLogFilter) *envoy_config_accesslog_v3.AccessLogFilter {
+	if opts == nil {
+		return nil
+	}
+
+	// status code and duration filters are OR'ed
+	matches := []*envoy_config_accesslog_v3.AccessLogFilter{}
+
+	if opts.StatusCodeMin != nil {
+		matches = append(matches, &envoy_config_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: &envoy_config_accesslog_v3.StatusCodeFilter{
+					Comparison: comparisonFilterGE_v1("access_log.status_code_min", *opts.StatusCodeMin),
+				},
+			},
+		})
+	}
+
+	if opts.MinDuration != nil {
+		matches = append(matches, &envoy_config_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_DurationFilter{
+				DurationFilter: &envoy_config_accesslog_v3.DurationFilter{
+					Comparison: comparisonFilterGE_v1("access_log.min_duration", uint32(opts.MinDuration.Milliseconds())),
+				},
+			},
+		})
+	}
+
+	// the rest of the filters are AND'ed
+	filters := []*envoy_config_accesslog_v3.AccessLogFilter{}
+
+	switch len(matches) {
+	case 0:
+	case 1:
+		filters = append(filters, matches[0])
+	default:
+		filters = append(filters, &envoy_config_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_OrFilter{
+				OrFilter: &envoy_config_accesslog_v3.OrFilter{Filters: matches},
+			},
+		})
+	}
+
+	if ptr.Deref(opts.ExcludeHealthChecks, false) {
+		filters = append(filters, &envoy_config_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_NotHealthCheckFilter{
+				NotHealthCheckFilter: &envoy_config_accesslog_v3.NotHealthCheckFilter{},
+			},
+		})
+	}
+
+	if opts.SamplePercent != nil {
+		filters = append(filters, &envoy_config_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_RuntimeFilter{
+				RuntimeFilter: &envoy_config_accesslog_v3.RuntimeFilter{
+					RuntimeKey: "access_log.sample_percent",
+					PercentSampled: &envoy_type_v3.FractionalPercent{
+						Numerator:   *opts.SamplePercent,
+						Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+					},
+				},
+			},
+		})
+	}
+
+	switch len(filters) {
+	case 0:
+		return nil
+	case 1:
+		return filters[0]
+	default:
+		return &envoy_config_accesslog_v3.AccessLogFilter{
+			FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_AndFilter{
+				AndFilter: &envoy_config_accesslog_v3.AndFilter{Filters: filters},
+			},
+		}
+	}
+}
+
+func comparisonFilterGE_v1(runtimeKey string, value uint32) *envoy_config_accesslog_v3.ComparisonFilter {
+	return &envoy_config_accesslog_v3.ComparisonFilter{
+		Op: envoy_config_accesslog_v3.ComparisonFilter_GE,
+		Value: &envoy_config_core_v3.RuntimeUInt32{
+			DefaultValue: value,
+			RuntimeKey:   runtimeKey,
+		},
+	}
+}
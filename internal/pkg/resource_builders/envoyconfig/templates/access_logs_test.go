@@ -0,0 +1,201 @@
+package templates
+
+import (
+	"testing"
+	"time"
+
+	envoy_serializer_v3 "github.com/3scale-sre/marin3r/api/envoy/serializer/v3"
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/MakeNowJust/heredoc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+func TestAccessLogConfig_v1(t *testing.T) {
+	type args struct {
+		name string
+		tls  bool
+		opts *saasv1alpha1.AccessLogOptions
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Generates a JSON access log with custom fields to stdout",
+			args: args{
+				name: "test",
+				tls:  false,
+				opts: &saasv1alpha1.AccessLogOptions{
+					Fields: map[string]string{
+						"request_id":            "%REQ(X-REQUEST-ID)%",
+						"upstream_host":         "%UPSTREAM_HOST%",
+						"user_agent":            "",
+						"upstream_service_time": "%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%",
+					},
+					Sink: &saasv1alpha1.AccessLogSink{Type: saasv1alpha1.AccessLogSinkStdout},
+				},
+			},
+			want: heredoc.Doc(`
+				name: envoy.access_loggers.stdout
+				typed_config:
+				  '@type': type.googleapis.com/envoy.extensions.access_loggers.stream.v3.StdoutAccessLog
+				  log_format:
+				    json_format:
+				      authority: '%REQ(:AUTHORITY)%'
+				      bytes_received: '%BYTES_RECEIVED%'
+				      bytes_sent: '%BYTES_SENT%'
+				      client_ip: '%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%'
+				      duration: '%DURATION%'
+				      listener: test
+				      method: '%REQ(:METHOD)%'
+				      path: '%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%'
+				      protocol: '%PROTOCOL%'
+				      request_id: '%REQ(X-REQUEST-ID)%'
+				      response_code: '%RESPONSE_CODE%'
+				      response_code_details: '%RESPONSE_CODE_DETAILS%'
+				      response_flags: '%RESPONSE_FLAGS%'
+				      upstream_cluster: '%UPSTREAM_CLUSTER%'
+				      upstream_host: '%UPSTREAM_HOST%'
+				      upstream_service_time: '%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%'
+			`),
+		},
+		{
+			name: "Generates a text access log to a file with filters",
+			args: args{
+				name: "test",
+				tls:  true,
+				opts: &saasv1alpha1.AccessLogOptions{
+					Format:     ptr.To(saasv1alpha1.AccessLogFormatText),
+					TextFormat: ptr.To("%REQ(:METHOD)% %RESPONSE_CODE% %DURATION%\n"),
+					Sink: &saasv1alpha1.AccessLogSink{
+						Type: saasv1alpha1.AccessLogSinkFile,
+						Path: ptr.To("/var/log/envoy/access.log"),
+					},
+					Filter: &saasv1alpha1.AccessLogFilter{
+						StatusCodeMin:       ptr.To(uint32(500)),
+						MinDuration:         &metav1.Duration{Duration: 2 * time.Second},
+						ExcludeHealthChecks: ptr.To(true),
+					},
+				},
+			},
+			want: heredoc.Doc(`
+				filter:
+				  and_filter:
+				    filters:
+				    - or_filter:
+				        filters:
+				        - status_code_filter:
+				            comparison:
+				              op: GE
+				              value:
+				                default_value: 500
+				                runtime_key: access_log.status_code_min
+				        - duration_filter:
+				            comparison:
+				              op: GE
+				              value:
+				                default_value: 2000
+				                runtime_key: access_log.min_duration
+				    - not_health_check_filter: {}
+				name: envoy.access_loggers.file
+				typed_config:
+				  '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+				  log_format:
+				    text_format_source:
+				      inline_string: |
+				        %REQ(:METHOD)% %RESPONSE_CODE% %DURATION%
+				  path: /var/log/envoy/access.log
+			`),
+		},
+		{
+			name: "Generates a text access log with envoy's default format",
+			args: args{
+				name: "test",
+				tls:  false,
+				opts: &saasv1alpha1.AccessLogOptions{
+					Format: ptr.To(saasv1alpha1.AccessLogFormatText),
+					Sink:   &saasv1alpha1.AccessLogSink{Type: saasv1alpha1.AccessLogSinkStdout},
+				},
+			},
+			want: heredoc.Doc(`
+				name: envoy.access_loggers.stdout
+				typed_config:
+				  '@type': type.googleapis.com/envoy.extensions.access_loggers.stream.v3.StdoutAccessLog
+			`),
+		},
+		{
+			name: "Generates a gRPC access log with sampling",
+			args: args{
+				name: "test",
+				tls:  false,
+				opts: &saasv1alpha1.AccessLogOptions{
+					Sink: &saasv1alpha1.AccessLogSink{
+						Type:    saasv1alpha1.AccessLogSinkGRPC,
+						Cluster: ptr.To("als"),
+					},
+					Filter: &saasv1alpha1.AccessLogFilter{
+						SamplePercent: ptr.To(uint32(10)),
+					},
+				},
+			},
+			want: heredoc.Doc(`
+				filter:
+				  runtime_filter:
+				    percent_sampled:
+				      numerator: 10
+				    runtime_key: access_log.sample_percent
+				name: envoy.access_loggers.http_grpc
+				typed_config:
+				  '@type': type.googleapis.com/envoy.extensions.access_loggers.grpc.v3.HttpGrpcAccessLogConfig
+				  common_config:
+				    grpc_service:
+				      envoy_grpc:
+				        cluster_name: als
+				    log_name: test
+				    transport_api_version: V3
+			`),
+		},
+		{
+			name: "Fails if the gRPC sink has no cluster",
+			args: args{
+				name: "test",
+				opts: &saasv1alpha1.AccessLogOptions{
+					Sink: &saasv1alpha1.AccessLogSink{Type: saasv1alpha1.AccessLogSinkGRPC},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AccessLogConfig_v1(tt.args.name, tt.args.tls, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AccessLogConfig_v1() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			j, err := envoy_serializer_v3.JSON{}.Marshal(got[0])
+			if err != nil {
+				t.Error(err)
+			}
+
+			y, err := yaml.JSONToYAML([]byte(j))
+			if err != nil {
+				t.Error(err)
+			}
+
+			if string(y) != tt.want {
+				t.Errorf("AccessLogConfig_v1():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
@@ -8,10 +8,13 @@ import (
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_extensions_upstreams_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func Cluster_v1(name string, opts any) (envoy.Resource, error) {
@@ -67,3 +70,152 @@ func Cluster_v1(name string, opts any) (envoy.Resource, error) {
 
 	return cluster, nil
 }
+
+func Cluster_v2(name string, opts any) (envoy.Resource, error) {
+	o := opts.(*saasv1alpha1.Cluster)
+
+	// start from the v1 cluster, which takes care of
+	// the endpoints and the upstream http protocol options
+	res, err := Cluster_v1(name, &saasv1alpha1.Cluster{Host: o.Host, Port: o.Port, IsHttp2: ptr.To(ptr.Deref(o.IsHttp2, false))})
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := res.(*envoy_config_cluster_v3.Cluster)
+	cluster.ConnectTimeout = durationpb.New(durationOrDefault(o.ConnectTimeout, 1*time.Second))
+
+	switch ptr.Deref(o.LbPolicy, saasv1alpha1.ClusterLbPolicyRoundRobin) {
+	case saasv1alpha1.ClusterLbPolicyLeastRequest:
+		cluster.LbPolicy = envoy_config_cluster_v3.Cluster_LEAST_REQUEST
+	case saasv1alpha1.ClusterLbPolicyRingHash:
+		cluster.LbPolicy = envoy_config_cluster_v3.Cluster_RING_HASH
+	default:
+		cluster.LbPolicy = envoy_config_cluster_v3.Cluster_ROUND_ROBIN
+	}
+
+	if o.HealthCheck != nil {
+		cluster.HealthChecks = []*envoy_config_core_v3.HealthCheck{HealthCheck_v1(o.Host, o.HealthCheck)}
+	}
+
+	if o.OutlierDetection != nil {
+		cluster.OutlierDetection = OutlierDetection_v1(o.OutlierDetection)
+	}
+
+	if o.CircuitBreakers != nil {
+		cluster.CircuitBreakers = CircuitBreakers_v1(o.CircuitBreakers)
+	}
+
+	if o.UpstreamTLS != nil {
+		cluster.TransportSocket = UpstreamTransportSocket_v1(ptr.Deref(o.UpstreamTLS.SNI, o.Host), o.UpstreamTLS.CASecretName)
+	}
+
+	return cluster, nil
+}
+
+func HealthCheck_v1(host string, opts *saasv1alpha1.ClusterHealthCheck) *envoy_config_core_v3.HealthCheck {
+	hc := &envoy_config_core_v3.HealthCheck{
+		Timeout:            durationpb.New(durationOrDefault(opts.Timeout, 1*time.Second)),
+		Interval:           durationpb.New(durationOrDefault(opts.Interval, 5*time.Second)),
+		HealthyThreshold:   wrapperspb.UInt32(ptr.Deref(opts.HealthyThreshold, 1)),
+		UnhealthyThreshold: wrapperspb.UInt32(ptr.Deref(opts.UnhealthyThreshold, 3)),
+	}
+
+	switch opts.Type {
+	case saasv1alpha1.ClusterHealthCheckTypeHTTP:
+		hc.HealthChecker = &envoy_config_core_v3.HealthCheck_HttpHealthCheck_{
+			HttpHealthCheck: &envoy_config_core_v3.HealthCheck_HttpHealthCheck{
+				Host: ptr.Deref(opts.Host, host),
+				Path: ptr.Deref(opts.Path, "/"),
+			},
+		}
+	default:
+		hc.HealthChecker = &envoy_config_core_v3.HealthCheck_TcpHealthCheck_{
+			TcpHealthCheck: &envoy_config_core_v3.HealthCheck_TcpHealthCheck{},
+		}
+	}
+
+	return hc
+}
+
+func OutlierDetection_v1(opts *saasv1alpha1.ClusterOutlierDetection) *envoy_config_cluster_v3.OutlierDetection {
+	od := &envoy_config_cluster_v3.OutlierDetection{
+		Consecutive_5Xx:           uint32OrNil(opts.Consecutive5xx),
+		ConsecutiveGatewayFailure: uint32OrNil(opts.ConsecutiveGatewayFailure),
+		MaxEjectionPercent:        uint32OrNil(opts.MaxEjectionPercent),
+	}
+
+	// gateway failures are not enforced by default
+	if opts.ConsecutiveGatewayFailure != nil {
+		od.EnforcingConsecutiveGatewayFailure = wrapperspb.UInt32(100)
+	}
+
+	if opts.Interval != nil {
+		od.Interval = durationpb.New(opts.Interval.Duration)
+	}
+
+	if opts.BaseEjectionTime != nil {
+		od.BaseEjectionTime = durationpb.New(opts.BaseEjectionTime.Duration)
+	}
+
+	return od
+}
+
+func CircuitBreakers_v1(opts *saasv1alpha1.ClusterCircuitBreakers) *envoy_config_cluster_v3.CircuitBreakers {
+	return &envoy_config_cluster_v3.CircuitBreakers{
+		Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{{
+			Priority:           envoy_config_core_v3.RoutingPriority_DEFAULT,
+			MaxConnections:     uint32OrNil(opts.MaxConnections),
+			MaxPendingRequests: uint32OrNil(opts.MaxPendingRequests),
+			MaxRequests:        uint32OrNil(opts.MaxRequests),
+			MaxRetries:         uint32OrNil(opts.MaxRetries),
+		}},
+	}
+}
+
+func UpstreamTransportSocket_v1(sni string, caSecretName *string) *envoy_config_core_v3.TransportSocket {
+	return &envoy_config_core_v3.TransportSocket{
+		Name: "envoy.transport_sockets.tls",
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{
+			TypedConfig: func() *anypb.Any {
+				tlsContext := &envoy_extensions_transport_sockets_tls_v3.UpstreamTlsContext{
+					Sni: sni,
+					CommonTlsContext: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext{
+						TlsParams: &envoy_extensions_transport_sockets_tls_v3.TlsParameters{
+							TlsMinimumProtocolVersion: envoy_extensions_transport_sockets_tls_v3.TlsParameters_TLSv1_2,
+							TlsMaximumProtocolVersion: envoy_extensions_transport_sockets_tls_v3.TlsParameters_TLSv1_3,
+						},
+					},
+				}
+
+				if caSecretName != nil {
+					tlsContext.CommonTlsContext.ValidationContextType = &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext_ValidationContextSdsSecretConfig{
+						ValidationContextSdsSecretConfig: SdsSecretConfig_v1(*caSecretName),
+					}
+				}
+
+				proto, err := anypb.New(tlsContext)
+				if err != nil {
+					panic(err)
+				}
+
+				return proto
+			}(),
+		},
+	}
+}
+
+func durationOrDefault(d *metav1.Duration, def time.Duration) time.Duration {
+	if d == nil {
+		return def
+	}
+
+	return d.Duration
+}
+
+func uint32OrNil(v *uint32) *wrapperspb.UInt32Value {
+	if v == nil {
+		return nil
+	}
+
+	return wrapperspb.UInt32(*v)
+}
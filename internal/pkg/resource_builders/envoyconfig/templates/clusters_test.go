@@ -2,10 +2,12 @@ package templates
 
 import (
 	"testing"
+	"time"
 
 	envoy_serializer_v3 "github.com/3scale-sre/marin3r/api/envoy/serializer/v3"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	"github.com/MakeNowJust/heredoc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 )
@@ -101,3 +103,179 @@ func TestCluster_v1(t *testing.T) {
 		})
 	}
 }
+
+func TestCluster_v2(t *testing.T) {
+	type args struct {
+		name string
+		opts any
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Generates a cluster with defaults",
+			args: args{
+				name: "my_cluster",
+				opts: &saasv1alpha1.Cluster{
+					Host: "localhost",
+					Port: 8080,
+				},
+			},
+			want: heredoc.Doc(`
+                connect_timeout: 1s
+                dns_lookup_family: V4_ONLY
+                load_assignment:
+                  cluster_name: my_cluster
+                  endpoints:
+                  - lb_endpoints:
+                    - endpoint:
+                        address:
+                          socket_address:
+                            address: localhost
+                            port_value: 8080
+                name: my_cluster
+                type: STRICT_DNS
+			`),
+		},
+		{
+			name: "Generates a cluster with all options",
+			args: args{
+				name: "my_cluster",
+				opts: &saasv1alpha1.Cluster{
+					Host:           "example.com",
+					Port:           443,
+					IsHttp2:        ptr.To(false),
+					ConnectTimeout: &metav1.Duration{Duration: 5 * time.Second},
+					LbPolicy:       ptr.To(saasv1alpha1.ClusterLbPolicyLeastRequest),
+					HealthCheck: &saasv1alpha1.ClusterHealthCheck{
+						Type: saasv1alpha1.ClusterHealthCheckTypeHTTP,
+						Path: ptr.To("/status"),
+					},
+					OutlierDetection: &saasv1alpha1.ClusterOutlierDetection{
+						Consecutive5xx:            ptr.To[uint32](5),
+						ConsecutiveGatewayFailure: ptr.To[uint32](3),
+						Interval:                  &metav1.Duration{Duration: 10 * time.Second},
+						BaseEjectionTime:          &metav1.Duration{Duration: 30 * time.Second},
+						MaxEjectionPercent:        ptr.To[uint32](50),
+					},
+					CircuitBreakers: &saasv1alpha1.ClusterCircuitBreakers{
+						MaxConnections:     ptr.To[uint32](1000),
+						MaxPendingRequests: ptr.To[uint32](100),
+					},
+					UpstreamTLS: &saasv1alpha1.ClusterUpstreamTLS{
+						CASecretName: ptr.To("ca"),
+					},
+				},
+			},
+			want: heredoc.Doc(`
+                circuit_breakers:
+                  thresholds:
+                  - max_connections: 1000
+                    max_pending_requests: 100
+                connect_timeout: 5s
+                dns_lookup_family: V4_ONLY
+                health_checks:
+                - healthy_threshold: 1
+                  http_health_check:
+                    host: example.com
+                    path: /status
+                  interval: 5s
+                  timeout: 1s
+                  unhealthy_threshold: 3
+                lb_policy: LEAST_REQUEST
+                load_assignment:
+                  cluster_name: my_cluster
+                  endpoints:
+                  - lb_endpoints:
+                    - endpoint:
+                        address:
+                          socket_address:
+                            address: example.com
+                            port_value: 443
+                name: my_cluster
+                outlier_detection:
+                  base_ejection_time: 30s
+                  consecutive_5xx: 5
+                  consecutive_gateway_failure: 3
+                  enforcing_consecutive_gateway_failure: 100
+                  interval: 10s
+                  max_ejection_percent: 50
+                transport_socket:
+                  name: envoy.transport_sockets.tls
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext
+                    common_tls_context:
+                      tls_params:
+                        tls_maximum_protocol_version: TLSv1_3
+                        tls_minimum_protocol_version: TLSv1_2
+                      validation_context_sds_secret_config:
+                        name: ca
+                        sds_config:
+                          ads: {}
+                          resource_api_version: V3
+                    sni: example.com
+                type: STRICT_DNS
+			`),
+		},
+		{
+			name: "Generates a cluster with tcp health checks",
+			args: args{
+				name: "my_cluster",
+				opts: &saasv1alpha1.Cluster{
+					Host:     "localhost",
+					Port:     8080,
+					LbPolicy: ptr.To(saasv1alpha1.ClusterLbPolicyRingHash),
+					HealthCheck: &saasv1alpha1.ClusterHealthCheck{
+						Type:               saasv1alpha1.ClusterHealthCheckTypeTCP,
+						Interval:           &metav1.Duration{Duration: 2 * time.Second},
+						UnhealthyThreshold: ptr.To[uint32](2),
+					},
+				},
+			},
+			want: heredoc.Doc(`
+                connect_timeout: 1s
+                dns_lookup_family: V4_ONLY
+                health_checks:
+                - healthy_threshold: 1
+                  interval: 2s
+                  tcp_health_check: {}
+                  timeout: 1s
+                  unhealthy_threshold: 2
+                lb_policy: RING_HASH
+                load_assignment:
+                  cluster_name: my_cluster
+                  endpoints:
+                  - lb_endpoints:
+                    - endpoint:
+                        address:
+                          socket_address:
+                            address: localhost
+                            port_value: 8080
+                name: my_cluster
+                type: STRICT_DNS
+			`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := Cluster_v2(tt.args.name, tt.args.opts)
+
+			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+
+			y, err := yaml.JSONToYAML([]byte(j))
+			if err != nil {
+				t.Error(err)
+			}
+
+			if string(y) != tt.want {
+				t.Errorf("Cluster_v2():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
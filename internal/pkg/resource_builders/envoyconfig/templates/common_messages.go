@@ -2,6 +2,7 @@ package templates
 
 import (
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 )
 
 func Address_v1(host string, port uint32) *envoy_config_core_v3.Address {
@@ -16,3 +17,15 @@ func Address_v1(host string, port uint32) *envoy_config_core_v3.Address {
 		},
 	}
 }
+
+func SdsSecretConfig_v1(name string) *envoy_extensions_transport_sockets_tls_v3.SdsSecretConfig {
+	return &envoy_extensions_transport_sockets_tls_v3.SdsSecretConfig{
+		Name: name,
+		SdsConfig: &envoy_config_core_v3.ConfigSource{
+			ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{
+				Ads: &envoy_config_core_v3.AggregatedConfigSource{},
+			},
+			ResourceApiVersion: envoy_config_core_v3.ApiVersion_V3,
+		},
+	}
+}
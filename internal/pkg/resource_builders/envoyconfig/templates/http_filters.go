@@ -0,0 +1,282 @@
+package templates
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	envoy_config_common_mutation_rules_v3 "github.com/envoyproxy/go-control-plane/envoy/config/common/mutation_rules/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_compression_gzip_compressor_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	envoy_extensions_filters_http_compressor_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	envoy_extensions_filters_http_cors_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	envoy_extensions_filters_http_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoy_extensions_filters_http_header_mutation_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	envoy_extensions_filters_http_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoy_extensions_filters_http_lua_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	http_connection_manager_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	proto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+)
+
+// validatable is implemented by all the envoy protobuffer messages
+type validatable interface {
+	ValidateAll() error
+}
+
+// HttpFilter_v1 renders the http filter that corresponds to the option set
+// in the given HttpFilter. It returns an error if none or more than one
+// option is set or if the resulting filter is not valid.
+func HttpFilter_v1(opts saasv1alpha1.HttpFilter) (*http_connection_manager_v3.HttpFilter, error) {
+	filters := []func() (*http_connection_manager_v3.HttpFilter, error){}
+
+	if opts.LocalRateLimit != nil {
+		filters = append(filters, func() (*http_connection_manager_v3.HttpFilter, error) {
+			return HttpFilterLocalRateLimit_v1(opts.LocalRateLimit)
+		})
+	}
+
+	if opts.ExtAuthz != nil {
+		filters = append(filters, func() (*http_connection_manager_v3.HttpFilter, error) {
+			return HttpFilterExtAuthz_v1(opts.ExtAuthz)
+		})
+	}
+
+	if opts.Cors != nil {
+		filters = append(filters, func() (*http_connection_manager_v3.HttpFilter, error) {
+			return HttpFilterCors_v1(opts.Cors)
+		})
+	}
+
+	if opts.HeaderMutation != nil {
+		filters = append(filters, func() (*http_connection_manager_v3.HttpFilter, error) {
+			return HttpFilterHeaderMutation_v1(opts.HeaderMutation)
+		})
+	}
+
+	if opts.Lua != nil {
+		filters = append(filters, func() (*http_connection_manager_v3.HttpFilter, error) {
+			return HttpFilterLua_v1(opts.Lua)
+		})
+	}
+
+	if opts.Compressor != nil {
+		filters = append(filters, func() (*http_connection_manager_v3.HttpFilter, error) {
+			return HttpFilterCompressor_v1(opts.Compressor)
+		})
+	}
+
+	if len(filters) != 1 {
+		return nil, fmt.Errorf("http filter must have exactly one option set, got %d", len(filters))
+	}
+
+	return filters[0]()
+}
+
+func HttpFilterLocalRateLimit_v1(opts *saasv1alpha1.HttpFilterLocalRateLimit) (*http_connection_manager_v3.HttpFilter, error) {
+	return typedHttpFilter("envoy.filters.http.local_ratelimit",
+		&envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit{
+			StatPrefix: "http_local_rate_limiter",
+			TokenBucket: &envoy_type_v3.TokenBucket{
+				MaxTokens:     opts.MaxTokens,
+				TokensPerFill: wrapperspb.UInt32(ptr.Deref(opts.TokensPerFill, 1)),
+				FillInterval:  durationpb.New(opts.FillInterval.Duration),
+			},
+			FilterEnabled:  runtimeFractionalPercent_v1("local_rate_limit_enabled", 100),
+			FilterEnforced: runtimeFractionalPercent_v1("local_rate_limit_enforced", 100),
+		},
+	)
+}
+
+func HttpFilterExtAuthz_v1(opts *saasv1alpha1.HttpFilterExtAuthz) (*http_connection_manager_v3.HttpFilter, error) {
+	timeout := durationpb.New(durationOrDefault(opts.Timeout, 200*time.Millisecond))
+
+	extAuthz := &envoy_extensions_filters_http_ext_authz_v3.ExtAuthz{
+		TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
+		FailureModeAllow:    ptr.Deref(opts.FailureModeAllow, false),
+	}
+
+	switch opts.Type {
+	case saasv1alpha1.HttpFilterExtAuthzTypeGRPC:
+		extAuthz.Services = &envoy_extensions_filters_http_ext_authz_v3.ExtAuthz_GrpcService{
+			GrpcService: &envoy_config_core_v3.GrpcService{
+				TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+						ClusterName: opts.Cluster,
+					},
+				},
+				Timeout: timeout,
+			},
+		}
+
+	case saasv1alpha1.HttpFilterExtAuthzTypeHTTP:
+		httpService := &envoy_extensions_filters_http_ext_authz_v3.HttpService{
+			ServerUri: &envoy_config_core_v3.HttpUri{
+				Uri: "http://" + opts.Cluster,
+				HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+					Cluster: opts.Cluster,
+				},
+				Timeout: timeout,
+			},
+			PathPrefix: ptr.Deref(opts.PathPrefix, ""),
+		}
+
+		if len(opts.AllowedRequestHeaders) > 0 {
+			httpService.AuthorizationRequest = &envoy_extensions_filters_http_ext_authz_v3.AuthorizationRequest{
+				AllowedHeaders: listStringMatcher_v1(opts.AllowedRequestHeaders),
+			}
+		}
+
+		if len(opts.AllowedUpstreamHeaders) > 0 {
+			httpService.AuthorizationResponse = &envoy_extensions_filters_http_ext_authz_v3.AuthorizationResponse{
+				AllowedUpstreamHeaders: listStringMatcher_v1(opts.AllowedUpstreamHeaders),
+			}
+		}
+
+		extAuthz.Services = &envoy_extensions_filters_http_ext_authz_v3.ExtAuthz_HttpService{
+			HttpService: httpService,
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown ext_authz type '%s'", opts.Type)
+	}
+
+	return typedHttpFilter("envoy.filters.http.ext_authz", extAuthz)
+}
+
+func HttpFilterCors_v1(_ *saasv1alpha1.HttpFilterCors) (*http_connection_manager_v3.HttpFilter, error) {
+	return typedHttpFilter("envoy.filters.http.cors", &envoy_extensions_filters_http_cors_v3.Cors{})
+}
+
+func HttpFilterHeaderMutation_v1(opts *saasv1alpha1.HttpFilterHeaderMutation) (*http_connection_manager_v3.HttpFilter, error) {
+	return typedHttpFilter("envoy.filters.http.header_mutation",
+		&envoy_extensions_filters_http_header_mutation_v3.HeaderMutation{
+			Mutations: &envoy_extensions_filters_http_header_mutation_v3.Mutations{
+				RequestMutations:  headerMutations_v1(opts.RequestHeadersToAdd, opts.RequestHeadersToRemove),
+				ResponseMutations: headerMutations_v1(opts.ResponseHeadersToAdd, opts.ResponseHeadersToRemove),
+			},
+		},
+	)
+}
+
+func HttpFilterLua_v1(opts *saasv1alpha1.HttpFilterLua) (*http_connection_manager_v3.HttpFilter, error) {
+	return typedHttpFilter("envoy.filters.http.lua",
+		&envoy_extensions_filters_http_lua_v3.Lua{
+			DefaultSourceCode: &envoy_config_core_v3.DataSource{
+				Specifier: &envoy_config_core_v3.DataSource_InlineString{
+					InlineString: opts.InlineCode,
+				},
+			},
+		},
+	)
+}
+
+func HttpFilterCompressor_v1(opts *saasv1alpha1.HttpFilterCompressor) (*http_connection_manager_v3.HttpFilter, error) {
+	gzip, err := anypb.New(&envoy_extensions_compression_gzip_compressor_v3.Gzip{})
+	if err != nil {
+		return nil, err
+	}
+
+	return typedHttpFilter("envoy.filters.http.compressor",
+		&envoy_extensions_filters_http_compressor_v3.Compressor{
+			ResponseDirectionConfig: &envoy_extensions_filters_http_compressor_v3.Compressor_ResponseDirectionConfig{
+				CommonConfig: &envoy_extensions_filters_http_compressor_v3.Compressor_CommonDirectionConfig{
+					MinContentLength: uint32OrNil(opts.MinContentLength),
+					ContentType:      opts.ContentTypes,
+				},
+			},
+			CompressorLibrary: &envoy_config_core_v3.TypedExtensionConfig{
+				Name:        "envoy.compression.gzip.compressor",
+				TypedConfig: gzip,
+			},
+		},
+	)
+}
+
+// typedHttpFilter validates the given filter config and wraps it
+// into an HttpFilter
+func typedHttpFilter(name string, config proto.Message) (*http_connection_manager_v3.HttpFilter, error) {
+	if v, ok := config.(validatable); ok {
+		if err := v.ValidateAll(); err != nil {
+			return nil, fmt.Errorf("invalid config for http filter '%s': %w", name, err)
+		}
+	}
+
+	typed, err := anypb.New(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http_connection_manager_v3.HttpFilter{
+		Name:       name,
+		ConfigType: &http_connection_manager_v3.HttpFilter_TypedConfig{TypedConfig: typed},
+	}, nil
+}
+
+func runtimeFractionalPercent_v1(key string, percent uint32) *envoy_config_core_v3.RuntimeFractionalPercent {
+	return &envoy_config_core_v3.RuntimeFractionalPercent{
+		DefaultValue: &envoy_type_v3.FractionalPercent{
+			Numerator:   percent,
+			Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+		},
+		RuntimeKey: key,
+	}
+}
+
+func listStringMatcher_v1(values []string) *envoy_type_matcher_v3.ListStringMatcher {
+	matcher := &envoy_type_matcher_v3.ListStringMatcher{
+		Patterns: make([]*envoy_type_matcher_v3.StringMatcher, 0, len(values)),
+	}
+
+	for _, v := range values {
+		matcher.Patterns = append(matcher.Patterns, &envoy_type_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: strings.ToLower(v)},
+			IgnoreCase:   true,
+		})
+	}
+
+	return matcher
+}
+
+func headerMutations_v1(add map[string]string, remove []string) []*envoy_config_common_mutation_rules_v3.HeaderMutation {
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	mutations := []*envoy_config_common_mutation_rules_v3.HeaderMutation{}
+
+	for _, h := range remove {
+		mutations = append(mutations, &envoy_config_common_mutation_rules_v3.HeaderMutation{
+			Action: &envoy_config_common_mutation_rules_v3.HeaderMutation_Remove{Remove: h},
+		})
+	}
+
+	// sort keys so the generated config is stable
+	keys := make([]string, 0, len(add))
+
+	for k := range add {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		mutations = append(mutations, &envoy_config_common_mutation_rules_v3.HeaderMutation{
+			Action: &envoy_config_common_mutation_rules_v3.HeaderMutation_Append{
+				Append: &envoy_config_core_v3.HeaderValueOption{
+					Header:       &envoy_config_core_v3.HeaderValue{Key: k, Value: add[k]},
+					AppendAction: envoy_config_core_v3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+				},
+			},
+		})
+	}
+
+	return mutations
+}
@@ -0,0 +1,232 @@
+package templates
+
+import (
+	"testing"
+	"time"
+
+	envoy_serializer_v3 "github.com/3scale-sre/marin3r/api/envoy/serializer/v3"
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/MakeNowJust/heredoc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+func TestHttpFilter_v1(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    saasv1alpha1.HttpFilter
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Generates a local ratelimit filter",
+			opts: saasv1alpha1.HttpFilter{
+				LocalRateLimit: &saasv1alpha1.HttpFilterLocalRateLimit{
+					MaxTokens:    100,
+					FillInterval: metav1.Duration{Duration: 1 * time.Second},
+				},
+			},
+			want: heredoc.Doc(`
+				name: envoy.filters.http.local_ratelimit
+				typed_config:
+				  '@type': type.googleapis.com/envoy.extensions.filters.http.local_ratelimit.v3.LocalRateLimit
+				  filter_enabled:
+				    default_value:
+				      numerator: 100
+				    runtime_key: local_rate_limit_enabled
+				  filter_enforced:
+				    default_value:
+				      numerator: 100
+				    runtime_key: local_rate_limit_enforced
+				  stat_prefix: http_local_rate_limiter
+				  token_bucket:
+				    fill_interval: 1s
+				    max_tokens: 100
+				    tokens_per_fill: 1
+			`),
+		},
+		{
+			name: "Generates a grpc ext_authz filter",
+			opts: saasv1alpha1.HttpFilter{
+				ExtAuthz: &saasv1alpha1.HttpFilterExtAuthz{
+					Type:             saasv1alpha1.HttpFilterExtAuthzTypeGRPC,
+					Cluster:          "authz",
+					FailureModeAllow: ptr.To(true),
+				},
+			},
+			want: heredoc.Doc(`
+				name: envoy.filters.http.ext_authz
+				typed_config:
+				  '@type': type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthz
+				  failure_mode_allow: true
+				  grpc_service:
+				    envoy_grpc:
+				      cluster_name: authz
+				    timeout: 0.200s
+				  transport_api_version: V3
+			`),
+		},
+		{
+			name: "Generates an http ext_authz filter",
+			opts: saasv1alpha1.HttpFilter{
+				ExtAuthz: &saasv1alpha1.HttpFilterExtAuthz{
+					Type:                   saasv1alpha1.HttpFilterExtAuthzTypeHTTP,
+					Cluster:                "authz",
+					Timeout:                &metav1.Duration{Duration: 1 * time.Second},
+					PathPrefix:             ptr.To("/auth"),
+					AllowedRequestHeaders:  []string{"Authorization"},
+					AllowedUpstreamHeaders: []string{"X-User"},
+				},
+			},
+			want: heredoc.Doc(`
+				name: envoy.filters.http.ext_authz
+				typed_config:
+				  '@type': type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthz
+				  http_service:
+				    authorization_request:
+				      allowed_headers:
+				        patterns:
+				        - exact: authorization
+				          ignore_case: true
+				    authorization_response:
+				      allowed_upstream_headers:
+				        patterns:
+				        - exact: x-user
+				          ignore_case: true
+				    path_prefix: /auth
+				    server_uri:
+				      cluster: authz
+				      timeout: 1s
+				      uri: http://authz
+				  transport_api_version: V3
+			`),
+		},
+		{
+			name: "Generates a cors filter",
+			opts: saasv1alpha1.HttpFilter{Cors: &saasv1alpha1.HttpFilterCors{}},
+			want: heredoc.Doc(`
+				name: envoy.filters.http.cors
+				typed_config:
+				  '@type': type.googleapis.com/envoy.extensions.filters.http.cors.v3.Cors
+			`),
+		},
+		{
+			name: "Generates a header mutation filter",
+			opts: saasv1alpha1.HttpFilter{
+				HeaderMutation: &saasv1alpha1.HttpFilterHeaderMutation{
+					RequestHeadersToAdd:     map[string]string{"x-b": "b", "x-a": "a"},
+					ResponseHeadersToRemove: []string{"server"},
+				},
+			},
+			want: heredoc.Doc(`
+				name: envoy.filters.http.header_mutation
+				typed_config:
+				  '@type': type.googleapis.com/envoy.extensions.filters.http.header_mutation.v3.HeaderMutation
+				  mutations:
+				    request_mutations:
+				    - append:
+				        append_action: OVERWRITE_IF_EXISTS_OR_ADD
+				        header:
+				          key: x-a
+				          value: a
+				    - append:
+				        append_action: OVERWRITE_IF_EXISTS_OR_ADD
+				        header:
+				          key: x-b
+				          value: b
+				    response_mutations:
+				    - remove: server
+			`),
+		},
+		{
+			name: "Generates a lua filter",
+			opts: saasv1alpha1.HttpFilter{
+				Lua: &saasv1alpha1.HttpFilterLua{
+					InlineCode: "function envoy_on_request(request_handle) end",
+				},
+			},
+			want: heredoc.Doc(`
+				name: envoy.filters.http.lua
+				typed_config:
+				  '@type': type.googleapis.com/envoy.extensions.filters.http.lua.v3.Lua
+				  default_source_code:
+				    inline_string: function envoy_on_request(request_handle) end
+			`),
+		},
+		{
+			name: "Generates a compressor filter",
+			opts: saasv1alpha1.HttpFilter{
+				Compressor: &saasv1alpha1.HttpFilterCompressor{
+					MinContentLength: ptr.To(uint32(100)),
+					ContentTypes:     []string{"application/json"},
+				},
+			},
+			want: heredoc.Doc(`
+				name: envoy.filters.http.compressor
+				typed_config:
+				  '@type': type.googleapis.com/envoy.extensions.filters.http.compressor.v3.Compressor
+				  compressor_library:
+				    name: envoy.compression.gzip.compressor
+				    typed_config:
+				      '@type': type.googleapis.com/envoy.extensions.compression.gzip.compressor.v3.Gzip
+				  response_direction_config:
+				    common_config:
+				      content_type:
+				      - application/json
+				      min_content_length: 100
+			`),
+		},
+		{
+			name:    "Fails if no option is set",
+			opts:    saasv1alpha1.HttpFilter{},
+			wantErr: true,
+		},
+		{
+			name: "Fails if more than one option is set",
+			opts: saasv1alpha1.HttpFilter{
+				Cors: &saasv1alpha1.HttpFilterCors{},
+				Lua:  &saasv1alpha1.HttpFilterLua{InlineCode: "function envoy_on_request(request_handle) end"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails validation",
+			opts: saasv1alpha1.HttpFilter{
+				LocalRateLimit: &saasv1alpha1.HttpFilterLocalRateLimit{
+					MaxTokens:    0,
+					FillInterval: metav1.Duration{Duration: 1 * time.Second},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HttpFilter_v1(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HttpFilter_v1() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+
+			y, err := yaml.JSONToYAML([]byte(j))
+			if err != nil {
+				t.Error(err)
+			}
+
+			if string(y) != tt.want {
+				t.Errorf("HttpFilter_v1():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
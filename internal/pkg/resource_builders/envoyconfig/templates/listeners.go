@@ -1,31 +1,41 @@
 package templates
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/3scale-sre/marin3r/api/envoy"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
-	envoy_config_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
-	envoy_extensions_access_loggers_file_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
 	envoy_extensions_filters_http_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
 	envoy_extensions_filters_http_router_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
 	envoy_extensions_filters_listener_proxy_protocol_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/proxy_protocol/v3"
 	envoy_extensions_filters_listener_tls_inspector_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/tls_inspector/v3"
 	http_connection_manager_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_extensions_filters_network_tcp_proxy_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	envoy_extensions_transport_sockets_quic_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
 	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
-	proto "google.golang.org/protobuf/proto"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
-	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
 )
 
 func ListenerHTTP_v1(name string, opts any) (envoy.Resource, error) {
 	o := opts.(*saasv1alpha1.ListenerHttp)
 
+	if o.ClientValidation != nil && o.CertificateSecretName == nil {
+		return nil, fmt.Errorf("client certificate validation requires the listener to have a certificate")
+	}
+
+	conMgr, err := HttpConnectionManager_v1(name, o)
+	if err != nil {
+		return nil, err
+	}
+
 	listener := &envoy_config_listener_v3.Listener{
 		Name:            name,
 		Address:         Address_v1("0.0.0.0", o.Port),
@@ -35,56 +45,7 @@ func ListenerHTTP_v1(name string, opts any) (envoy.Resource, error) {
 				Name: "envoy.filters.network.http_connection_manager",
 				ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{
 					TypedConfig: func() *anypb.Any {
-						proto, err := anypb.New(
-							func() proto.Message {
-								conMgr := &http_connection_manager_v3.HttpConnectionManager{
-									AccessLog: AccessLogConfig_v1(name, o.CertificateSecretName != nil),
-									CommonHttpProtocolOptions: func() *envoy_config_core_v3.HttpProtocolOptions {
-										po := &envoy_config_core_v3.HttpProtocolOptions{
-											IdleTimeout: durationpb.New(3600 * time.Second),
-										}
-										if o.MaxConnectionDuration != nil {
-											po.MaxConnectionDuration = durationpb.New(o.MaxConnectionDuration.Duration)
-										}
-										if o.AllowHeadersWithUnderscores != nil && *o.AllowHeadersWithUnderscores {
-											po.HeadersWithUnderscoresAction = envoy_config_core_v3.HttpProtocolOptions_ALLOW
-										} else {
-											po.HeadersWithUnderscoresAction = envoy_config_core_v3.HttpProtocolOptions_REJECT_REQUEST
-										}
-
-										return po
-									}(),
-
-									HttpFilters: HttpFilters_v1(o.RateLimitOptions),
-									HttpProtocolOptions: func() *envoy_config_core_v3.Http1ProtocolOptions {
-										if o.DefaultHostForHttp10 != nil {
-											return &envoy_config_core_v3.Http1ProtocolOptions{
-												AcceptHttp_10:         true,
-												DefaultHostForHttp_10: *o.DefaultHostForHttp10,
-											}
-										}
-
-										return &envoy_config_core_v3.Http1ProtocolOptions{}
-									}(),
-									Http2ProtocolOptions: &envoy_config_core_v3.Http2ProtocolOptions{
-										MaxConcurrentStreams:        wrapperspb.UInt32(100),
-										InitialStreamWindowSize:     wrapperspb.UInt32(65536),   // 64 KiB
-										InitialConnectionWindowSize: wrapperspb.UInt32(1048576), // 1 MiB
-									},
-									RequestTimeout:    durationpb.New(300 * time.Second),
-									RouteSpecifier:    RouteConfigFromAds_v1(o.RouteConfigName),
-									StatPrefix:        name,
-									StreamIdleTimeout: durationpb.New(300 * time.Second),
-									UseRemoteAddress:  wrapperspb.Bool(*o.ProxyProtocol),
-								}
-								if o.MaxRequestHeadersKb != nil {
-									conMgr.MaxRequestHeadersKb = wrapperspb.UInt32(*o.MaxRequestHeadersKb)
-								}
-
-								return conMgr
-							}(),
-						)
-
+						proto, err := anypb.New(conMgr)
 						if err != nil {
 							panic(err)
 						}
@@ -99,12 +60,84 @@ func ListenerHTTP_v1(name string, opts any) (envoy.Resource, error) {
 
 	// Apply TLS config if this is a HTTPS listener
 	if o.CertificateSecretName != nil {
-		listener.FilterChains[0].TransportSocket = TransportSocket_v1(*o.CertificateSecretName, *o.EnableHttp2)
+		listener.FilterChains[0].TransportSocket = TransportSocket_v1(*o.CertificateSecretName, *o.EnableHttp2, o.ClientValidation)
 	}
 
 	return listener, nil
 }
 
+// HttpConnectionManager_v1 returns the http connection manager network filter
+// config for the given http listener options
+func HttpConnectionManager_v1(name string, o *saasv1alpha1.ListenerHttp) (*http_connection_manager_v3.HttpConnectionManager, error) {
+	httpFilters, err := HttpFilters_v1(o.RateLimitOptions, o.HttpFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	accessLog, err := AccessLogConfig_v1(name, o.CertificateSecretName != nil, o.AccessLog)
+	if err != nil {
+		return nil, err
+	}
+
+	conMgr := &http_connection_manager_v3.HttpConnectionManager{
+		AccessLog: accessLog,
+		CommonHttpProtocolOptions: func() *envoy_config_core_v3.HttpProtocolOptions {
+			po := &envoy_config_core_v3.HttpProtocolOptions{
+				IdleTimeout: durationpb.New(3600 * time.Second),
+			}
+
+			if o.MaxConnectionDuration != nil {
+				po.MaxConnectionDuration = durationpb.New(o.MaxConnectionDuration.Duration)
+			}
+
+			if o.AllowHeadersWithUnderscores != nil && *o.AllowHeadersWithUnderscores {
+				po.HeadersWithUnderscoresAction = envoy_config_core_v3.HttpProtocolOptions_ALLOW
+			} else {
+				po.HeadersWithUnderscoresAction = envoy_config_core_v3.HttpProtocolOptions_REJECT_REQUEST
+			}
+
+			return po
+		}(),
+
+		HttpFilters: httpFilters,
+		HttpProtocolOptions: func() *envoy_config_core_v3.Http1ProtocolOptions {
+			if o.DefaultHostForHttp10 != nil {
+				return &envoy_config_core_v3.Http1ProtocolOptions{
+					AcceptHttp_10:         true,
+					DefaultHostForHttp_10: *o.DefaultHostForHttp10,
+				}
+			}
+
+			return &envoy_config_core_v3.Http1ProtocolOptions{}
+		}(),
+		Http2ProtocolOptions: &envoy_config_core_v3.Http2ProtocolOptions{
+			MaxConcurrentStreams:        wrapperspb.UInt32(100),
+			InitialStreamWindowSize:     wrapperspb.UInt32(65536),   // 64 KiB
+			InitialConnectionWindowSize: wrapperspb.UInt32(1048576), // 1 MiB
+		},
+		RequestTimeout:    durationpb.New(300 * time.Second),
+		RouteSpecifier:    RouteConfigFromAds_v1(o.RouteConfigName),
+		StatPrefix:        name,
+		StreamIdleTimeout: durationpb.New(300 * time.Second),
+		UseRemoteAddress:  wrapperspb.Bool(*o.ProxyProtocol),
+	}
+
+	if o.MaxRequestHeadersKb != nil {
+		conMgr.MaxRequestHeadersKb = wrapperspb.UInt32(*o.MaxRequestHeadersKb)
+	}
+
+	if o.ClientValidation != nil && ptr.Deref(o.ClientValidation.ForwardClientIdentity, true) {
+		conMgr.ForwardClientCertDetails = http_connection_manager_v3.HttpConnectionManager_SANITIZE_SET
+		conMgr.SetCurrentClientCertDetails = &http_connection_manager_v3.HttpConnectionManager_SetCurrentClientCertDetails{
+			Subject: wrapperspb.Bool(true),
+			Uri:     true,
+			Dns:     true,
+		}
+	}
+
+	return conMgr, nil
+}
+
 func ListenerFilters_v1(tls, proxyProtocol bool) []*envoy_config_listener_v3.ListenerFilter {
 	filters := []*envoy_config_listener_v3.ListenerFilter{}
 	if tls {
@@ -160,8 +193,18 @@ func RouteConfigFromAds_v1(name string) *http_connection_manager_v3.HttpConnecti
 	}
 }
 
-func HttpFilters_v1(rlOpts *saasv1alpha1.RateLimitOptions) []*http_connection_manager_v3.HttpFilter {
+func HttpFilters_v1(rlOpts *saasv1alpha1.RateLimitOptions, opts []saasv1alpha1.HttpFilter) ([]*http_connection_manager_v3.HttpFilter, error) {
 	filters := []*http_connection_manager_v3.HttpFilter{}
+
+	for idx, o := range opts {
+		filter, err := HttpFilter_v1(o)
+		if err != nil {
+			return nil, fmt.Errorf("httpFilters[%d]: %w", idx, err)
+		}
+
+		filters = append(filters, filter)
+	}
+
 	if rlOpts != nil {
 		filters = append(filters, HttpFilterRateLimit_v1(rlOpts))
 	}
@@ -182,7 +225,7 @@ func HttpFilters_v1(rlOpts *saasv1alpha1.RateLimitOptions) []*http_connection_ma
 		},
 	})
 
-	return filters
+	return filters, nil
 }
 
 func HttpFilterRateLimit_v1(opts *saasv1alpha1.RateLimitOptions) *http_connection_manager_v3.HttpFilter {
@@ -217,49 +260,21 @@ func HttpFilterRateLimit_v1(opts *saasv1alpha1.RateLimitOptions) *http_connectio
 	}
 }
 
-func AccessLogConfig_v1(name string, tls bool) []*envoy_config_accesslog_v3.AccessLog {
-	return []*envoy_config_accesslog_v3.AccessLog{{
-		Name: "envoy.access_loggers.file",
-		ConfigType: &envoy_config_accesslog_v3.AccessLog_TypedConfig{
+func TransportSocket_v1(secretName string, http2 bool, clientValidation *saasv1alpha1.ListenerClientValidation) *envoy_config_core_v3.TransportSocket {
+	return &envoy_config_core_v3.TransportSocket{
+		Name: "envoy.transport_sockets.tls",
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{
 			TypedConfig: func() *anypb.Any {
-				logfmt := &envoy_extensions_access_loggers_file_v3.FileAccessLog{
-					Path: "/dev/stdout",
-					AccessLogFormat: &envoy_extensions_access_loggers_file_v3.FileAccessLog_LogFormat{
-						LogFormat: &envoy_config_core_v3.SubstitutionFormatString{
-							Format: &envoy_config_core_v3.SubstitutionFormatString_JsonFormat{
-								JsonFormat: &structpb.Struct{
-									Fields: func() map[string]*structpb.Value {
-										m := map[string]*structpb.Value{
-											"authority":             structpb.NewStringValue("%REQ(:AUTHORITY)%"),
-											"bytes_received":        structpb.NewStringValue("%BYTES_RECEIVED%"),
-											"bytes_sent":            structpb.NewStringValue("%BYTES_SENT%"),
-											"duration":              structpb.NewStringValue("%DURATION%"),
-											"method":                structpb.NewStringValue("%REQ(:METHOD)%"),
-											"path":                  structpb.NewStringValue("%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%"),
-											"protocol":              structpb.NewStringValue("%PROTOCOL%"),
-											"response_code":         structpb.NewStringValue("%RESPONSE_CODE%"),
-											"response_code_details": structpb.NewStringValue("%RESPONSE_CODE_DETAILS%"),
-											"response_flags":        structpb.NewStringValue("%RESPONSE_FLAGS%"),
-											"listener":              structpb.NewStringValue(name),
-											"upstream_cluster":      structpb.NewStringValue("%UPSTREAM_CLUSTER%"),
-											"upstream_service_time": structpb.NewStringValue("%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%"),
-											"user_agent":            structpb.NewStringValue("%REQ(USER-AGENT)%"),
-											"client_ip":             structpb.NewStringValue("%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%"),
-										}
-										if tls {
-											m["downstream_tls_cipher"] = structpb.NewStringValue("%DOWNSTREAM_TLS_CIPHER%")
-											m["downstream_tls_version"] = structpb.NewStringValue("%DOWNSTREAM_TLS_VERSION%")
-										}
-
-										return m
-									}(),
-								},
-							},
-						},
-					},
-				}
-
-				proto, err := anypb.New(logfmt)
+				proto, err := anypb.New(DownstreamTlsContext_v1(secretName,
+					func() []string {
+						if http2 {
+							return []string{"h2,http/1.1"}
+						} else {
+							return []string{"http/1.1"}
+						}
+					}(),
+					clientValidation,
+				))
 				if err != nil {
 					panic(err)
 				}
@@ -267,46 +282,239 @@ func AccessLogConfig_v1(name string, tls bool) []*envoy_config_accesslog_v3.Acce
 				return proto
 			}(),
 		},
-	}}
+	}
 }
 
-func TransportSocket_v1(secretName string, http2 bool) *envoy_config_core_v3.TransportSocket {
-	return &envoy_config_core_v3.TransportSocket{
-		Name: "envoy.transport_sockets.tls",
-		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{
-			TypedConfig: func() *anypb.Any {
-				proto, err := anypb.New(&envoy_extensions_transport_sockets_tls_v3.DownstreamTlsContext{
-					CommonTlsContext: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext{
-						TlsCertificateSdsSecretConfigs: []*envoy_extensions_transport_sockets_tls_v3.SdsSecretConfig{
-							{
-								Name: secretName,
-								SdsConfig: &envoy_config_core_v3.ConfigSource{
-									ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{
-										Ads: &envoy_config_core_v3.AggregatedConfigSource{},
-									},
-									ResourceApiVersion: envoy_config_core_v3.ApiVersion_V3,
-								},
-							},
-						},
-						TlsParams: &envoy_extensions_transport_sockets_tls_v3.TlsParameters{
-							TlsMinimumProtocolVersion: envoy_extensions_transport_sockets_tls_v3.TlsParameters_TLSv1_2,
-							TlsMaximumProtocolVersion: envoy_extensions_transport_sockets_tls_v3.TlsParameters_TLSv1_3,
-						},
-						AlpnProtocols: func() []string {
-							if http2 {
-								return []string{"h2,http/1.1"}
-							} else {
-								return []string{"http/1.1"}
-							}
-						}(),
-					},
-				})
-				if err != nil {
-					panic(err)
-				}
+// DownstreamTlsContext_v1 returns a tls context that fetches the server certificate
+// using SDS and, optionally, validates client certificates
+func DownstreamTlsContext_v1(secretName string, alpn []string, clientValidation *saasv1alpha1.ListenerClientValidation) *envoy_extensions_transport_sockets_tls_v3.DownstreamTlsContext {
+	tlsContext := &envoy_extensions_transport_sockets_tls_v3.DownstreamTlsContext{
+		CommonTlsContext: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext{
+			TlsCertificateSdsSecretConfigs: []*envoy_extensions_transport_sockets_tls_v3.SdsSecretConfig{
+				SdsSecretConfig_v1(secretName),
+			},
+			TlsParams: &envoy_extensions_transport_sockets_tls_v3.TlsParameters{
+				TlsMinimumProtocolVersion: envoy_extensions_transport_sockets_tls_v3.TlsParameters_TLSv1_2,
+				TlsMaximumProtocolVersion: envoy_extensions_transport_sockets_tls_v3.TlsParameters_TLSv1_3,
+			},
+			AlpnProtocols: alpn,
+		},
+	}
 
-				return proto
+	if clientValidation != nil {
+		tlsContext.RequireClientCertificate = wrapperspb.Bool(
+			ptr.Deref(clientValidation.Mode, saasv1alpha1.ClientCertificateModeRequired) == saasv1alpha1.ClientCertificateModeRequired,
+		)
+		tlsContext.CommonTlsContext.ValidationContextType = ClientValidationContext_v1(clientValidation)
+	}
+
+	return tlsContext
+}
+
+// ClientValidationContext_v1 returns the validation context for client
+// certificates. The CA is fetched using SDS and combined with a static
+// validation context that holds the SAN matchers, if any.
+func ClientValidationContext_v1(opts *saasv1alpha1.ListenerClientValidation) *envoy_extensions_transport_sockets_tls_v3.CommonTlsContext_CombinedValidationContext {
+	matchers := []*envoy_extensions_transport_sockets_tls_v3.SubjectAltNameMatcher{}
+
+	for _, san := range opts.SubjectAltNames {
+		matchers = append(matchers, &envoy_extensions_transport_sockets_tls_v3.SubjectAltNameMatcher{
+			SanType: func() envoy_extensions_transport_sockets_tls_v3.SubjectAltNameMatcher_SanType {
+				switch san.Type {
+				case saasv1alpha1.SubjectAltNameTypeURI:
+					return envoy_extensions_transport_sockets_tls_v3.SubjectAltNameMatcher_URI
+				case saasv1alpha1.SubjectAltNameTypeEmail:
+					return envoy_extensions_transport_sockets_tls_v3.SubjectAltNameMatcher_EMAIL
+				case saasv1alpha1.SubjectAltNameTypeIP:
+					return envoy_extensions_transport_sockets_tls_v3.SubjectAltNameMatcher_IP_ADDRESS
+				default:
+					return envoy_extensions_transport_sockets_tls_v3.SubjectAltNameMatcher_DNS
+				}
 			}(),
+			Matcher: &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: san.Value},
+			},
+		})
+	}
+
+	return &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext_CombinedValidationContext{
+		CombinedValidationContext: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext_CombinedCertificateValidationContext{
+			DefaultValidationContext: &envoy_extensions_transport_sockets_tls_v3.CertificateValidationContext{
+				MatchTypedSubjectAltNames: matchers,
+			},
+			ValidationContextSdsSecretConfig: SdsSecretConfig_v1(opts.CASecretName),
+		},
+	}
+}
+
+func ListenerHTTP3_v1(name string, opts any) (envoy.Resource, error) {
+	o := opts.(*saasv1alpha1.ListenerHttp3)
+
+	conMgr, err := HttpConnectionManager_v1(name, &saasv1alpha1.ListenerHttp{
+		Port:                        o.Port,
+		RouteConfigName:             o.RouteConfigName,
+		CertificateSecretName:       ptr.To(o.CertificateSecretName),
+		RateLimitOptions:            o.RateLimitOptions,
+		AllowHeadersWithUnderscores: o.AllowHeadersWithUnderscores,
+		MaxConnectionDuration:       o.MaxConnectionDuration,
+		MaxRequestHeadersKb:         o.MaxRequestHeadersKb,
+		HttpFilters:                 o.HttpFilters,
+		AccessLog:                   o.AccessLog,
+		// only used to set use_remote_address: there is no proxy
+		// protocol in QUIC, so the remote address is the client's
+		ProxyProtocol: ptr.To(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	conMgr.CodecType = http_connection_manager_v3.HttpConnectionManager_HTTP3
+	conMgr.Http3ProtocolOptions = &envoy_config_core_v3.Http3ProtocolOptions{}
+	conMgr.HttpProtocolOptions = nil
+	conMgr.Http2ProtocolOptions = nil
+
+	address := Address_v1("0.0.0.0", o.Port)
+	address.GetSocketAddress().Protocol = envoy_config_core_v3.SocketAddress_UDP
+
+	listener := &envoy_config_listener_v3.Listener{
+		Name:    name,
+		Address: address,
+		UdpListenerConfig: &envoy_config_listener_v3.UdpListenerConfig{
+			QuicOptions: &envoy_config_listener_v3.QuicProtocolOptions{},
+			DownstreamSocketConfig: &envoy_config_core_v3.UdpSocketConfig{
+				PreferGro: wrapperspb.Bool(true),
+			},
 		},
+		FilterChains: []*envoy_config_listener_v3.FilterChain{{
+			Filters: []*envoy_config_listener_v3.Filter{{
+				Name: "envoy.filters.network.http_connection_manager",
+				ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{
+					TypedConfig: func() *anypb.Any {
+						proto, err := anypb.New(conMgr)
+						if err != nil {
+							panic(err)
+						}
+
+						return proto
+					}(),
+				},
+			}},
+			TransportSocket: &envoy_config_core_v3.TransportSocket{
+				Name: "envoy.transport_sockets.quic",
+				ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{
+					TypedConfig: func() *anypb.Any {
+						proto, err := anypb.New(&envoy_extensions_transport_sockets_quic_v3.QuicDownstreamTransport{
+							DownstreamTlsContext: DownstreamTlsContext_v1(o.CertificateSecretName, []string{"h3"}, nil),
+						})
+						if err != nil {
+							panic(err)
+						}
+
+						return proto
+					}(),
+				},
+			},
+		}},
 	}
+
+	return listener, nil
+}
+
+func ListenerTCP_v1(name string, opts any) (envoy.Resource, error) {
+	o := opts.(*saasv1alpha1.ListenerTcp)
+	mode := ptr.Deref(o.TLSMode, saasv1alpha1.ListenerTcpTLSModeNone)
+
+	if o.Cluster == nil && len(o.SNIRoutes) == 0 {
+		return nil, fmt.Errorf("tcp listener requires a cluster or at least one sni route")
+	}
+
+	if len(o.SNIRoutes) > 0 && mode == saasv1alpha1.ListenerTcpTLSModeNone {
+		return nil, fmt.Errorf("sni routes require tlsMode to be %s or %s",
+			saasv1alpha1.ListenerTcpTLSModePassthrough, saasv1alpha1.ListenerTcpTLSModeTerminate)
+	}
+
+	if mode == saasv1alpha1.ListenerTcpTLSModeTerminate && o.CertificateSecretName == nil {
+		return nil, fmt.Errorf("tlsMode %s requires a certificate", mode)
+	}
+
+	idleTimeout := durationOrDefault(o.IdleTimeout, 3600*time.Second)
+
+	chains := []*envoy_config_listener_v3.FilterChain{}
+
+	for _, route := range o.SNIRoutes {
+		chain, err := TcpProxyFilterChain_v1(name, route.Cluster, idleTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		chain.FilterChainMatch = &envoy_config_listener_v3.FilterChainMatch{
+			ServerNames: route.ServerNames,
+		}
+
+		chains = append(chains, chain)
+	}
+
+	if o.Cluster != nil {
+		// connections that do not match any sni route
+		chain, err := TcpProxyFilterChain_v1(name, *o.Cluster, idleTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		chains = append(chains, chain)
+	}
+
+	if mode == saasv1alpha1.ListenerTcpTLSModeTerminate {
+		for _, chain := range chains {
+			chain.TransportSocket = &envoy_config_core_v3.TransportSocket{
+				Name: "envoy.transport_sockets.tls",
+				ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{
+					TypedConfig: func() *anypb.Any {
+						proto, err := anypb.New(DownstreamTlsContext_v1(*o.CertificateSecretName, nil, nil))
+						if err != nil {
+							panic(err)
+						}
+
+						return proto
+					}(),
+				},
+			}
+		}
+	}
+
+	listener := &envoy_config_listener_v3.Listener{
+		Name:                          name,
+		Address:                       Address_v1("0.0.0.0", o.Port),
+		ListenerFilters:               ListenerFilters_v1(mode != saasv1alpha1.ListenerTcpTLSModeNone, ptr.Deref(o.ProxyProtocol, false)),
+		FilterChains:                  chains,
+		PerConnectionBufferLimitBytes: wrapperspb.UInt32(32768), // 32 KiB
+	}
+
+	if err := listener.ValidateAll(); err != nil {
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+// TcpProxyFilterChain_v1 returns a filter chain that proxies
+// connections to the given cluster
+func TcpProxyFilterChain_v1(name, cluster string, idleTimeout time.Duration) (*envoy_config_listener_v3.FilterChain, error) {
+	proxy, err := anypb.New(&envoy_extensions_filters_network_tcp_proxy_v3.TcpProxy{
+		StatPrefix: name,
+		ClusterSpecifier: &envoy_extensions_filters_network_tcp_proxy_v3.TcpProxy_Cluster{
+			Cluster: cluster,
+		},
+		IdleTimeout: durationpb.New(idleTimeout),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &envoy_config_listener_v3.FilterChain{
+		Filters: []*envoy_config_listener_v3.Filter{{
+			Name:       "envoy.filters.network.tcp_proxy",
+			ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{TypedConfig: proxy},
+		}},
+	}, nil
 }
@@ -138,6 +138,124 @@ func TestListenerHTTP_v1(t *testing.T) {
                 per_connection_buffer_limit_bytes: 32768
 			`),
 		},
+		{
+			name: "Generates https listener with client certificate validation",
+			args: args{
+				name: "test",
+				opts: &saasv1alpha1.ListenerHttp{
+					Port:                  8443,
+					RouteConfigName:       "my_route",
+					CertificateSecretName: ptr.To("my_certificate"),
+					EnableHttp2:           ptr.To(false),
+					ProxyProtocol:         ptr.To(false),
+					ClientValidation: &saasv1alpha1.ListenerClientValidation{
+						CASecretName: "client-ca",
+						Mode:         ptr.To(saasv1alpha1.ClientCertificateModeOptional),
+						SubjectAltNames: []saasv1alpha1.SubjectAltNameMatch{
+							{Type: saasv1alpha1.SubjectAltNameTypeDNS, Value: "client.example.com"},
+							{Type: saasv1alpha1.SubjectAltNameTypeURI, Value: "spiffe://example.com/client"},
+						},
+					},
+				},
+			},
+			want: heredoc.Doc(`
+                address:
+                  socket_address:
+                    address: 0.0.0.0
+                    port_value: 8443
+                filter_chains:
+                - filters:
+                  - name: envoy.filters.network.http_connection_manager
+                    typed_config:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                      access_log:
+                      - name: envoy.access_loggers.file
+                        typed_config:
+                          '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                          log_format:
+                            json_format:
+                              authority: '%REQ(:AUTHORITY)%'
+                              bytes_received: '%BYTES_RECEIVED%'
+                              bytes_sent: '%BYTES_SENT%'
+                              client_ip: '%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%'
+                              downstream_tls_cipher: '%DOWNSTREAM_TLS_CIPHER%'
+                              downstream_tls_version: '%DOWNSTREAM_TLS_VERSION%'
+                              duration: '%DURATION%'
+                              listener: test
+                              method: '%REQ(:METHOD)%'
+                              path: '%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%'
+                              protocol: '%PROTOCOL%'
+                              response_code: '%RESPONSE_CODE%'
+                              response_code_details: '%RESPONSE_CODE_DETAILS%'
+                              response_flags: '%RESPONSE_FLAGS%'
+                              upstream_cluster: '%UPSTREAM_CLUSTER%'
+                              upstream_service_time: '%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%'
+                              user_agent: '%REQ(USER-AGENT)%'
+                          path: /dev/stdout
+                      common_http_protocol_options:
+                        headers_with_underscores_action: REJECT_REQUEST
+                        idle_timeout: 3600s
+                      forward_client_cert_details: SANITIZE_SET
+                      http_filters:
+                      - name: envoy.filters.http.router
+                        typed_config:
+                          '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                      http_protocol_options: {}
+                      http2_protocol_options:
+                        initial_connection_window_size: 1048576
+                        initial_stream_window_size: 65536
+                        max_concurrent_streams: 100
+                      rds:
+                        config_source:
+                          ads: {}
+                          resource_api_version: V3
+                        route_config_name: my_route
+                      request_timeout: 300s
+                      set_current_client_cert_details:
+                        dns: true
+                        subject: true
+                        uri: true
+                      stat_prefix: test
+                      stream_idle_timeout: 300s
+                      use_remote_address: false
+                  transport_socket:
+                    name: envoy.transport_sockets.tls
+                    typed_config:
+                      '@type': type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.DownstreamTlsContext
+                      common_tls_context:
+                        alpn_protocols:
+                        - http/1.1
+                        combined_validation_context:
+                          default_validation_context:
+                            match_typed_subject_alt_names:
+                            - matcher:
+                                exact: client.example.com
+                              san_type: DNS
+                            - matcher:
+                                exact: spiffe://example.com/client
+                              san_type: URI
+                          validation_context_sds_secret_config:
+                            name: client-ca
+                            sds_config:
+                              ads: {}
+                              resource_api_version: V3
+                        tls_certificate_sds_secret_configs:
+                        - name: my_certificate
+                          sds_config:
+                            ads: {}
+                            resource_api_version: V3
+                        tls_params:
+                          tls_maximum_protocol_version: TLSv1_3
+                          tls_minimum_protocol_version: TLSv1_2
+                      require_client_certificate: false
+                listener_filters:
+                - name: envoy.filters.listener.tls_inspector
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.filters.listener.tls_inspector.v3.TlsInspector
+                name: test
+                per_connection_buffer_limit_bytes: 32768
+			`),
+		},
 		{
 			name: "Generates http listener",
 			args: args{
@@ -252,3 +370,329 @@ func TestListenerHTTP_v1(t *testing.T) {
 		})
 	}
 }
+
+func TestListenerHTTP3_v1(t *testing.T) {
+	type args struct {
+		name string
+		opts *saasv1alpha1.ListenerHttp3
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Generates http3 listener",
+			args: args{
+				name: "test",
+				opts: &saasv1alpha1.ListenerHttp3{
+					Port:                        8443,
+					RouteConfigName:             "my_route",
+					CertificateSecretName:       "my_certificate",
+					AllowHeadersWithUnderscores: ptr.To(false),
+				},
+			},
+			want: heredoc.Doc(`
+                address:
+                  socket_address:
+                    address: 0.0.0.0
+                    port_value: 8443
+                    protocol: UDP
+                filter_chains:
+                - filters:
+                  - name: envoy.filters.network.http_connection_manager
+                    typed_config:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                      access_log:
+                      - name: envoy.access_loggers.file
+                        typed_config:
+                          '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                          log_format:
+                            json_format:
+                              authority: '%REQ(:AUTHORITY)%'
+                              bytes_received: '%BYTES_RECEIVED%'
+                              bytes_sent: '%BYTES_SENT%'
+                              client_ip: '%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%'
+                              downstream_tls_cipher: '%DOWNSTREAM_TLS_CIPHER%'
+                              downstream_tls_version: '%DOWNSTREAM_TLS_VERSION%'
+                              duration: '%DURATION%'
+                              listener: test
+                              method: '%REQ(:METHOD)%'
+                              path: '%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%'
+                              protocol: '%PROTOCOL%'
+                              response_code: '%RESPONSE_CODE%'
+                              response_code_details: '%RESPONSE_CODE_DETAILS%'
+                              response_flags: '%RESPONSE_FLAGS%'
+                              upstream_cluster: '%UPSTREAM_CLUSTER%'
+                              upstream_service_time: '%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%'
+                              user_agent: '%REQ(USER-AGENT)%'
+                          path: /dev/stdout
+                      codec_type: HTTP3
+                      common_http_protocol_options:
+                        headers_with_underscores_action: REJECT_REQUEST
+                        idle_timeout: 3600s
+                      http_filters:
+                      - name: envoy.filters.http.router
+                        typed_config:
+                          '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                      http3_protocol_options: {}
+                      rds:
+                        config_source:
+                          ads: {}
+                          resource_api_version: V3
+                        route_config_name: my_route
+                      request_timeout: 300s
+                      stat_prefix: test
+                      stream_idle_timeout: 300s
+                      use_remote_address: true
+                  transport_socket:
+                    name: envoy.transport_sockets.quic
+                    typed_config:
+                      '@type': type.googleapis.com/envoy.extensions.transport_sockets.quic.v3.QuicDownstreamTransport
+                      downstream_tls_context:
+                        common_tls_context:
+                          alpn_protocols:
+                          - h3
+                          tls_certificate_sds_secret_configs:
+                          - name: my_certificate
+                            sds_config:
+                              ads: {}
+                              resource_api_version: V3
+                          tls_params:
+                            tls_maximum_protocol_version: TLSv1_3
+                            tls_minimum_protocol_version: TLSv1_2
+                name: test
+                udp_listener_config:
+                  downstream_socket_config:
+                    prefer_gro: true
+                  quic_options: {}
+			`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := ListenerHTTP3_v1(tt.args.name, tt.args.opts)
+
+			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+
+			y, err := yaml.JSONToYAML([]byte(j))
+			if err != nil {
+				t.Error(err)
+			}
+
+			if string(y) != tt.want {
+				t.Errorf("ListenerHTTP3_v1():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
+
+func TestListenerTCP_v1(t *testing.T) {
+	type args struct {
+		name string
+		opts *saasv1alpha1.ListenerTcp
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Generates tcp listener",
+			args: args{
+				name: "test",
+				opts: &saasv1alpha1.ListenerTcp{
+					Port:          6379,
+					ProxyProtocol: ptr.To(true),
+					Cluster:       ptr.To("redis"),
+				},
+			},
+			want: heredoc.Doc(`
+                address:
+                  socket_address:
+                    address: 0.0.0.0
+                    port_value: 6379
+                filter_chains:
+                - filters:
+                  - name: envoy.filters.network.tcp_proxy
+                    typed_config:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                      cluster: redis
+                      idle_timeout: 3600s
+                      stat_prefix: test
+                listener_filters:
+                - name: envoy.filters.listener.proxy_protocol
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.filters.listener.proxy_protocol.v3.ProxyProtocol
+                name: test
+                per_connection_buffer_limit_bytes: 32768
+			`),
+		},
+		{
+			name: "Generates tcp listener with tls passthrough and sni routing",
+			args: args{
+				name: "test",
+				opts: &saasv1alpha1.ListenerTcp{
+					Port:        443,
+					TLSMode:     ptr.To(saasv1alpha1.ListenerTcpTLSModePassthrough),
+					Cluster:     ptr.To("default"),
+					IdleTimeout: &metav1.Duration{Duration: 60 * time.Second},
+					SNIRoutes: []saasv1alpha1.ListenerTcpSNIRoute{
+						{ServerNames: []string{"a.example.com"}, Cluster: "a"},
+						{ServerNames: []string{"*.b.example.com"}, Cluster: "b"},
+					},
+				},
+			},
+			want: heredoc.Doc(`
+                address:
+                  socket_address:
+                    address: 0.0.0.0
+                    port_value: 443
+                filter_chains:
+                - filter_chain_match:
+                    server_names:
+                    - a.example.com
+                  filters:
+                  - name: envoy.filters.network.tcp_proxy
+                    typed_config:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                      cluster: a
+                      idle_timeout: 60s
+                      stat_prefix: test
+                - filter_chain_match:
+                    server_names:
+                    - '*.b.example.com'
+                  filters:
+                  - name: envoy.filters.network.tcp_proxy
+                    typed_config:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                      cluster: b
+                      idle_timeout: 60s
+                      stat_prefix: test
+                - filters:
+                  - name: envoy.filters.network.tcp_proxy
+                    typed_config:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                      cluster: default
+                      idle_timeout: 60s
+                      stat_prefix: test
+                listener_filters:
+                - name: envoy.filters.listener.tls_inspector
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.filters.listener.tls_inspector.v3.TlsInspector
+                name: test
+                per_connection_buffer_limit_bytes: 32768
+			`),
+		},
+		{
+			name: "Generates tcp listener with tls termination",
+			args: args{
+				name: "test",
+				opts: &saasv1alpha1.ListenerTcp{
+					Port:                  6380,
+					TLSMode:               ptr.To(saasv1alpha1.ListenerTcpTLSModeTerminate),
+					CertificateSecretName: ptr.To("my_certificate"),
+					Cluster:               ptr.To("redis"),
+				},
+			},
+			want: heredoc.Doc(`
+                address:
+                  socket_address:
+                    address: 0.0.0.0
+                    port_value: 6380
+                filter_chains:
+                - filters:
+                  - name: envoy.filters.network.tcp_proxy
+                    typed_config:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                      cluster: redis
+                      idle_timeout: 3600s
+                      stat_prefix: test
+                  transport_socket:
+                    name: envoy.transport_sockets.tls
+                    typed_config:
+                      '@type': type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.DownstreamTlsContext
+                      common_tls_context:
+                        tls_certificate_sds_secret_configs:
+                        - name: my_certificate
+                          sds_config:
+                            ads: {}
+                            resource_api_version: V3
+                        tls_params:
+                          tls_maximum_protocol_version: TLSv1_3
+                          tls_minimum_protocol_version: TLSv1_2
+                listener_filters:
+                - name: envoy.filters.listener.tls_inspector
+                  typed_config:
+                    '@type': type.googleapis.com/envoy.extensions.filters.listener.tls_inspector.v3.TlsInspector
+                name: test
+                per_connection_buffer_limit_bytes: 32768
+			`),
+		},
+		{
+			name: "Fails without cluster nor sni routes",
+			args: args{
+				name: "test",
+				opts: &saasv1alpha1.ListenerTcp{Port: 6379},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails with sni routes and no tls",
+			args: args{
+				name: "test",
+				opts: &saasv1alpha1.ListenerTcp{
+					Port:      443,
+					SNIRoutes: []saasv1alpha1.ListenerTcpSNIRoute{{ServerNames: []string{"a.example.com"}, Cluster: "a"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails with tls termination and no certificate",
+			args: args{
+				name: "test",
+				opts: &saasv1alpha1.ListenerTcp{
+					Port:    443,
+					TLSMode: ptr.To(saasv1alpha1.ListenerTcpTLSModeTerminate),
+					Cluster: ptr.To("a"),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ListenerTCP_v1(tt.args.name, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListenerTCP_v1() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+
+			y, err := yaml.JSONToYAML([]byte(j))
+			if err != nil {
+				t.Error(err)
+			}
+
+			if string(y) != tt.want {
+				t.Errorf("ListenerTCP_v1():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
@@ -1,11 +1,19 @@
 package templates
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/3scale-sre/marin3r/api/envoy"
 	envoy_serializer_v3 "github.com/3scale-sre/marin3r/api/envoy/serializer/v3"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	operatorutils "github.com/3scale-sre/saas-operator/internal/pkg/util"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
 )
 
 func RouteConfiguration_v1(name string, opts any) (envoy.Resource, error) {
@@ -35,3 +43,315 @@ func RouteConfiguration_v1(name string, opts any) (envoy.Resource, error) {
 
 	return rc, nil
 }
+
+func RouteConfiguration_v2(name string, opts any) (envoy.Resource, error) {
+	o := opts.(*saasv1alpha1.RouteConfiguration)
+
+	rc := &envoy_config_route_v3.RouteConfiguration{
+		Name:         name,
+		VirtualHosts: []*envoy_config_route_v3.VirtualHost{},
+	}
+
+	merr := operatorutils.MultiError{}
+
+	for _, vhost := range o.TypedVirtualHosts {
+		vh, err := VirtualHost_v1(vhost)
+		if err != nil {
+			merr = append(merr, fmt.Errorf("virtual host '%s': %w", vhost.Name, err))
+
+			continue
+		}
+
+		rc.VirtualHosts = append(rc.VirtualHosts, vh)
+	}
+
+	// raw virtual hosts are still allowed as an escape hatch
+	raw, err := RouteConfiguration_v1(name, &saasv1alpha1.RouteConfiguration{VirtualHosts: o.VirtualHosts})
+	if err != nil {
+		merr = append(merr, err)
+	} else {
+		rc.VirtualHosts = append(rc.VirtualHosts, raw.(*envoy_config_route_v3.RouteConfiguration).GetVirtualHosts()...)
+	}
+
+	if len(merr) > 0 {
+		return nil, merr
+	}
+
+	if err := rc.ValidateAll(); err != nil {
+		return nil, err
+	}
+
+	return rc, nil
+}
+
+func VirtualHost_v1(opts saasv1alpha1.VirtualHost) (*envoy_config_route_v3.VirtualHost, error) {
+	vh := &envoy_config_route_v3.VirtualHost{
+		Name:    opts.Name,
+		Domains: opts.Domains,
+		Routes:  make([]*envoy_config_route_v3.Route, 0, len(opts.Routes)),
+	}
+
+	for idx, route := range opts.Routes {
+		r, err := Route_v1(route)
+		if err != nil {
+			return nil, fmt.Errorf("routes[%d]: %w", idx, err)
+		}
+
+		vh.Routes = append(vh.Routes, r)
+	}
+
+	return vh, nil
+}
+
+func Route_v1(opts saasv1alpha1.Route) (*envoy_config_route_v3.Route, error) {
+	match, err := RouteMatch_v1(opts.Match)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkOneof("route",
+		oneofField{"cluster", opts.Cluster != nil},
+		oneofField{"weightedClusters", len(opts.WeightedClusters) > 0},
+		oneofField{"redirect", opts.Redirect != nil},
+		oneofField{"directResponse", opts.DirectResponse != nil},
+	); err != nil {
+		return nil, err
+	}
+
+	route := &envoy_config_route_v3.Route{Match: match}
+
+	switch {
+	case opts.Redirect != nil:
+		route.Action = &envoy_config_route_v3.Route_Redirect{Redirect: RedirectAction_v1(opts.Redirect)}
+
+	case opts.DirectResponse != nil:
+		dr := &envoy_config_route_v3.DirectResponseAction{Status: opts.DirectResponse.Status}
+
+		if opts.DirectResponse.Body != nil {
+			dr.Body = &envoy_config_core_v3.DataSource{
+				Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: *opts.DirectResponse.Body},
+			}
+		}
+
+		route.Action = &envoy_config_route_v3.Route_DirectResponse{DirectResponse: dr}
+
+	default:
+		route.Action = &envoy_config_route_v3.Route_Route{Route: RouteAction_v1(opts)}
+	}
+
+	return route, nil
+}
+
+func RouteMatch_v1(opts saasv1alpha1.RouteMatch) (*envoy_config_route_v3.RouteMatch, error) {
+	if err := checkOneof("route match",
+		oneofField{"prefix", opts.Prefix != nil},
+		oneofField{"path", opts.Path != nil},
+		oneofField{"regex", opts.Regex != nil},
+	); err != nil {
+		return nil, err
+	}
+
+	match := &envoy_config_route_v3.RouteMatch{}
+
+	switch {
+	case opts.Prefix != nil:
+		match.PathSpecifier = &envoy_config_route_v3.RouteMatch_Prefix{Prefix: *opts.Prefix}
+	case opts.Path != nil:
+		match.PathSpecifier = &envoy_config_route_v3.RouteMatch_Path{Path: *opts.Path}
+	default:
+		match.PathSpecifier = &envoy_config_route_v3.RouteMatch_SafeRegex{
+			SafeRegex: &envoy_type_matcher_v3.RegexMatcher{Regex: *opts.Regex},
+		}
+	}
+
+	for _, header := range opts.Headers {
+		hm, err := HeaderMatcher_v1(header)
+		if err != nil {
+			return nil, err
+		}
+
+		match.Headers = append(match.Headers, hm)
+	}
+
+	return match, nil
+}
+
+func HeaderMatcher_v1(opts saasv1alpha1.HeaderMatch) (*envoy_config_route_v3.HeaderMatcher, error) {
+	if err := checkOneof(fmt.Sprintf("header match '%s'", opts.Name),
+		oneofField{"exact", opts.Exact != nil},
+		oneofField{"prefix", opts.Prefix != nil},
+		oneofField{"regex", opts.Regex != nil},
+		oneofField{"present", opts.Present != nil},
+	); err != nil {
+		return nil, err
+	}
+
+	hm := &envoy_config_route_v3.HeaderMatcher{
+		Name:        opts.Name,
+		InvertMatch: ptr.Deref(opts.Invert, false),
+	}
+
+	switch {
+	case opts.Exact != nil:
+		hm.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_StringMatch{
+			StringMatch: &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: *opts.Exact},
+			},
+		}
+	case opts.Prefix != nil:
+		hm.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_StringMatch{
+			StringMatch: &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: *opts.Prefix},
+			},
+		}
+	case opts.Regex != nil:
+		hm.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_StringMatch{
+			StringMatch: &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+					SafeRegex: &envoy_type_matcher_v3.RegexMatcher{Regex: *opts.Regex},
+				},
+			},
+		}
+	default:
+		hm.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: *opts.Present}
+	}
+
+	return hm, nil
+}
+
+// oneofField is a field of a group of mutually exclusive fields
+type oneofField struct {
+	name string
+	set  bool
+}
+
+// checkOneof returns an error unless exactly one of the given fields is set.
+// The error names the conflicting fields when more than one is set.
+func checkOneof(kind string, fields ...oneofField) error {
+	names := make([]string, 0, len(fields))
+	set := []string{}
+
+	for _, f := range fields {
+		names = append(names, f.name)
+
+		if f.set {
+			set = append(set, f.name)
+		}
+	}
+
+	switch len(set) {
+	case 1:
+		return nil
+	case 0:
+		return fmt.Errorf("%s must have one of %s", kind, strings.Join(names, ", "))
+	default:
+		return fmt.Errorf("%s must have only one of %s, but has %s", kind, strings.Join(names, ", "), strings.Join(set, " and "))
+	}
+}
+
+func RouteAction_v1(opts saasv1alpha1.Route) *envoy_config_route_v3.RouteAction {
+	action := &envoy_config_route_v3.RouteAction{}
+
+	if opts.Cluster != nil {
+		action.ClusterSpecifier = &envoy_config_route_v3.RouteAction_Cluster{Cluster: *opts.Cluster}
+	} else {
+		clusters := make([]*envoy_config_route_v3.WeightedCluster_ClusterWeight, 0, len(opts.WeightedClusters))
+
+		for _, wc := range opts.WeightedClusters {
+			clusters = append(clusters, &envoy_config_route_v3.WeightedCluster_ClusterWeight{
+				Name:   wc.Name,
+				Weight: wrapperspb.UInt32(wc.Weight),
+			})
+		}
+
+		action.ClusterSpecifier = &envoy_config_route_v3.RouteAction_WeightedClusters{
+			WeightedClusters: &envoy_config_route_v3.WeightedCluster{Clusters: clusters},
+		}
+	}
+
+	if opts.Timeout != nil {
+		action.Timeout = durationpb.New(opts.Timeout.Duration)
+	}
+
+	if opts.Retries != nil {
+		action.RetryPolicy = &envoy_config_route_v3.RetryPolicy{
+			RetryOn:    opts.Retries.RetryOn,
+			NumRetries: wrapperspb.UInt32(ptr.Deref(opts.Retries.NumRetries, 1)),
+		}
+
+		if opts.Retries.PerTryTimeout != nil {
+			action.RetryPolicy.PerTryTimeout = durationpb.New(opts.Retries.PerTryTimeout.Duration)
+		}
+	}
+
+	for _, rl := range opts.RateLimits {
+		action.RateLimits = append(action.RateLimits, RateLimit_v1(rl))
+	}
+
+	return action
+}
+
+func RedirectAction_v1(opts *saasv1alpha1.RouteRedirect) *envoy_config_route_v3.RedirectAction {
+	redirect := &envoy_config_route_v3.RedirectAction{
+		HostRedirect: ptr.Deref(opts.HostRedirect, ""),
+		ResponseCode: func() envoy_config_route_v3.RedirectAction_RedirectResponseCode {
+			switch ptr.Deref(opts.ResponseCode, saasv1alpha1.RedirectResponseCodeMovedPermanently) {
+			case saasv1alpha1.RedirectResponseCodeFound:
+				return envoy_config_route_v3.RedirectAction_FOUND
+			case saasv1alpha1.RedirectResponseCodeSeeOther:
+				return envoy_config_route_v3.RedirectAction_SEE_OTHER
+			case saasv1alpha1.RedirectResponseCodeTemporaryRedirect:
+				return envoy_config_route_v3.RedirectAction_TEMPORARY_REDIRECT
+			case saasv1alpha1.RedirectResponseCodePermanentRedirect:
+				return envoy_config_route_v3.RedirectAction_PERMANENT_REDIRECT
+			default:
+				return envoy_config_route_v3.RedirectAction_MOVED_PERMANENTLY
+			}
+		}(),
+	}
+
+	if opts.PathRedirect != nil {
+		redirect.PathRewriteSpecifier = &envoy_config_route_v3.RedirectAction_PathRedirect{PathRedirect: *opts.PathRedirect}
+	}
+
+	if ptr.Deref(opts.HttpsRedirect, false) {
+		redirect.SchemeRewriteSpecifier = &envoy_config_route_v3.RedirectAction_HttpsRedirect{HttpsRedirect: true}
+	}
+
+	return redirect
+}
+
+func RateLimit_v1(opts saasv1alpha1.RouteRateLimit) *envoy_config_route_v3.RateLimit {
+	rl := &envoy_config_route_v3.RateLimit{
+		Actions: make([]*envoy_config_route_v3.RateLimit_Action, 0, len(opts.Actions)),
+	}
+
+	for _, a := range opts.Actions {
+		action := &envoy_config_route_v3.RateLimit_Action{}
+
+		switch {
+		case a.RemoteAddress != nil:
+			action.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_RemoteAddress_{
+				RemoteAddress: &envoy_config_route_v3.RateLimit_Action_RemoteAddress{},
+			}
+		case a.RequestHeader != nil:
+			action.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_RequestHeaders_{
+				RequestHeaders: &envoy_config_route_v3.RateLimit_Action_RequestHeaders{
+					HeaderName:    a.RequestHeader.HeaderName,
+					DescriptorKey: a.RequestHeader.DescriptorKey,
+				},
+			}
+		case a.GenericKey != nil:
+			action.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_GenericKey_{
+				GenericKey: &envoy_config_route_v3.RateLimit_Action_GenericKey{
+					DescriptorValue: a.GenericKey.DescriptorValue,
+					DescriptorKey:   ptr.Deref(a.GenericKey.DescriptorKey, ""),
+				},
+			}
+		}
+
+		rl.Actions = append(rl.Actions, action)
+	}
+
+	return rl
+}
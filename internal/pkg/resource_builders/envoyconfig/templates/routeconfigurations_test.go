@@ -2,11 +2,14 @@ package templates
 
 import (
 	"testing"
+	"time"
 
 	envoy_serializer_v3 "github.com/3scale-sre/marin3r/api/envoy/serializer/v3"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	"github.com/MakeNowJust/heredoc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 )
 
@@ -88,3 +91,299 @@ func TestRouteConfiguration_v1(t *testing.T) {
 		})
 	}
 }
+
+func TestRouteConfiguration_v2(t *testing.T) {
+	type args struct {
+		name string
+		opts *saasv1alpha1.RouteConfiguration
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Generates typed virtual hosts",
+			args: args{
+				name: "my_route",
+				opts: &saasv1alpha1.RouteConfiguration{
+					TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+						Name:    "example",
+						Domains: []string{"example.com"},
+						Routes: []saasv1alpha1.Route{
+							{
+								Match: saasv1alpha1.RouteMatch{
+									Path: ptr.To("/health"),
+								},
+								DirectResponse: &saasv1alpha1.RouteDirectResponse{Status: 200, Body: ptr.To("ok")},
+							},
+							{
+								Match: saasv1alpha1.RouteMatch{
+									Prefix: ptr.To("/api"),
+									Headers: []saasv1alpha1.HeaderMatch{
+										{Name: "x-canary", Exact: ptr.To("true")},
+										{Name: "x-debug", Present: ptr.To(true), Invert: ptr.To(true)},
+									},
+								},
+								WeightedClusters: []saasv1alpha1.WeightedCluster{
+									{Name: "stable", Weight: 90},
+									{Name: "canary", Weight: 10},
+								},
+								Timeout: &metav1.Duration{Duration: 30 * time.Second},
+								Retries: &saasv1alpha1.RouteRetryPolicy{
+									RetryOn:       "5xx",
+									NumRetries:    ptr.To[uint32](3),
+									PerTryTimeout: &metav1.Duration{Duration: 10 * time.Second},
+								},
+								RateLimits: []saasv1alpha1.RouteRateLimit{{
+									Actions: []saasv1alpha1.RateLimitAction{
+										{RemoteAddress: &saasv1alpha1.RateLimitActionRemoteAddress{}},
+										{RequestHeader: &saasv1alpha1.RateLimitActionRequestHeader{HeaderName: "x-api-key", DescriptorKey: "api_key"}},
+										{GenericKey: &saasv1alpha1.RateLimitActionGenericKey{DescriptorValue: "api"}},
+									},
+								}},
+							},
+							{
+								Match:   saasv1alpha1.RouteMatch{Regex: ptr.To("^/v[0-9]+/.*")},
+								Cluster: ptr.To("backend"),
+							},
+						},
+					}},
+					VirtualHosts: []runtime.RawExtension{
+						{
+							Raw: []byte(`{"name":"raw","domains":["raw.example.com"],"routes":[{"route":{"cluster":"raw_cluster"},"match":{"prefix":"/"}}]}`),
+						},
+					},
+				},
+			},
+			want: heredoc.Doc(`
+                name: my_route
+                virtual_hosts:
+                - domains:
+                  - example.com
+                  name: example
+                  routes:
+                  - direct_response:
+                      body:
+                        inline_string: ok
+                      status: 200
+                    match:
+                      path: /health
+                  - match:
+                      headers:
+                      - name: x-canary
+                        string_match:
+                          exact: "true"
+                      - invert_match: true
+                        name: x-debug
+                        present_match: true
+                      prefix: /api
+                    route:
+                      rate_limits:
+                      - actions:
+                        - remote_address: {}
+                        - request_headers:
+                            descriptor_key: api_key
+                            header_name: x-api-key
+                        - generic_key:
+                            descriptor_value: api
+                      retry_policy:
+                        num_retries: 3
+                        per_try_timeout: 10s
+                        retry_on: 5xx
+                      timeout: 30s
+                      weighted_clusters:
+                        clusters:
+                        - name: stable
+                          weight: 90
+                        - name: canary
+                          weight: 10
+                  - match:
+                      safe_regex:
+                        regex: ^/v[0-9]+/.*
+                    route:
+                      cluster: backend
+                - domains:
+                  - raw.example.com
+                  name: raw
+                  routes:
+                  - match:
+                      prefix: /
+                    route:
+                      cluster: raw_cluster
+			`),
+			wantErr: false,
+		},
+		{
+			name: "Generates a redirect",
+			args: args{
+				name: "my_route",
+				opts: &saasv1alpha1.RouteConfiguration{
+					TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+						Name:    "redirect",
+						Domains: []string{"*"},
+						Routes: []saasv1alpha1.Route{{
+							Match: saasv1alpha1.RouteMatch{Prefix: ptr.To("/")},
+							Redirect: &saasv1alpha1.RouteRedirect{
+								HostRedirect:  ptr.To("www.example.com"),
+								PathRedirect:  ptr.To("/new"),
+								HttpsRedirect: ptr.To(true),
+								ResponseCode:  ptr.To(saasv1alpha1.RedirectResponseCodeFound),
+							},
+						}},
+					}},
+				},
+			},
+			want: heredoc.Doc(`
+                name: my_route
+                virtual_hosts:
+                - domains:
+                  - '*'
+                  name: redirect
+                  routes:
+                  - match:
+                      prefix: /
+                    redirect:
+                      host_redirect: www.example.com
+                      https_redirect: true
+                      path_redirect: /new
+                      response_code: FOUND
+			`),
+			wantErr: false,
+		},
+		{
+			name: "Fails when a route has more than one action",
+			args: args{
+				name: "my_route",
+				opts: &saasv1alpha1.RouteConfiguration{
+					TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+						Name:    "example",
+						Domains: []string{"example.com"},
+						Routes: []saasv1alpha1.Route{{
+							Match:          saasv1alpha1.RouteMatch{Prefix: ptr.To("/")},
+							Cluster:        ptr.To("backend"),
+							DirectResponse: &saasv1alpha1.RouteDirectResponse{Status: 200},
+						}},
+					}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails when a route match has no path specifier",
+			args: args{
+				name: "my_route",
+				opts: &saasv1alpha1.RouteConfiguration{
+					TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+						Name:    "example",
+						Domains: []string{"example.com"},
+						Routes: []saasv1alpha1.Route{{
+							Match:   saasv1alpha1.RouteMatch{},
+							Cluster: ptr.To("backend"),
+						}},
+					}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails when a header match has several specifiers",
+			args: args{
+				name: "my_route",
+				opts: &saasv1alpha1.RouteConfiguration{
+					TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+						Name:    "example",
+						Domains: []string{"example.com"},
+						Routes: []saasv1alpha1.Route{{
+							Match: saasv1alpha1.RouteMatch{
+								Prefix:  ptr.To("/"),
+								Headers: []saasv1alpha1.HeaderMatch{{Name: "x-header", Exact: ptr.To("a"), Prefix: ptr.To("b")}},
+							},
+							Cluster: ptr.To("backend"),
+						}},
+					}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails envoy validation",
+			args: args{
+				name: "my_route",
+				opts: &saasv1alpha1.RouteConfiguration{
+					TypedVirtualHosts: []saasv1alpha1.VirtualHost{{
+						Name:    "example",
+						Domains: []string{"example.com"},
+						Routes: []saasv1alpha1.Route{{
+							Match:          saasv1alpha1.RouteMatch{Prefix: ptr.To("/")},
+							DirectResponse: &saasv1alpha1.RouteDirectResponse{Status: 99},
+						}},
+					}},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RouteConfiguration_v2(tt.args.name, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RouteConfiguration_v2() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+
+			y, err := yaml.JSONToYAML([]byte(j))
+			if err != nil {
+				t.Error(err)
+			}
+
+			if string(y) != tt.want {
+				t.Errorf("RouteConfiguration_v2():\n# got:\n%v\n# want:\n%v", string(y), tt.want)
+			}
+		})
+	}
+}
+
+func Test_checkOneof(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []oneofField
+		wantErr string
+	}{
+		{
+			name:    "One field set",
+			fields:  []oneofField{{"cluster", true}, {"redirect", false}},
+			wantErr: "",
+		},
+		{
+			name:    "No field set",
+			fields:  []oneofField{{"cluster", false}, {"redirect", false}},
+			wantErr: "route must have one of cluster, redirect",
+		},
+		{
+			name:    "Several fields set",
+			fields:  []oneofField{{"cluster", true}, {"weightedClusters", true}, {"redirect", false}},
+			wantErr: "route must have only one of cluster, weightedClusters, redirect, but has cluster and weightedClusters",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkOneof("route", tt.fields...)
+			if (err == nil && tt.wantErr != "") || (err != nil && err.Error() != tt.wantErr) {
+				t.Errorf("checkOneof() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,23 +1,38 @@
 package templates
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/3scale-sre/marin3r/api/envoy"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	envoy_service_runtime_v3 "github.com/envoyproxy/go-control-plane/envoy/service/runtime/v3"
 	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/utils/ptr"
 )
 
 func Runtime_v1(name string, opts any) (envoy.Resource, error) {
 	o := opts.(*saasv1alpha1.Runtime)
 
-	layer, _ := structpb.NewStruct(map[string]any{
+	for name := range o.ListenerConnectionLimits {
+		if !slices.Contains(o.ListenerNames, name) {
+			return nil, fmt.Errorf("connection limit set for listener '%s' which is not in the list of listeners", name)
+		}
+	}
+
+	fields := map[string]any{
 		"envoy": map[string]any{
 			"resource_limits": map[string]any{
 				"listener": func() map[string]any {
 					m := map[string]any{}
 					for _, name := range o.ListenerNames {
+						limit, ok := o.ListenerConnectionLimits[name]
+						if !ok {
+							limit = ptr.Deref(o.ListenerConnectionLimit, 10000)
+						}
 						m[name] = map[string]any{
-							"connection_limit": 10000,
+							"connection_limit": limit,
 						}
 					}
 
@@ -26,12 +41,56 @@ func Runtime_v1(name string, opts any) (envoy.Resource, error) {
 			},
 		},
 		"overload": map[string]any{
-			"global_downstream_max_connections": 50000,
+			"global_downstream_max_connections": ptr.Deref(o.GlobalDownstreamMaxConnections, 50000),
 		},
-	})
+	}
+
+	for _, key := range o.Keys {
+		value, err := runtimeKeyValue(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if isManagedRuntimeKey(key.Name) {
+			return nil, fmt.Errorf("runtime key '%s' is managed by the template", key.Name)
+		}
+		fields[key.Name] = value
+	}
+
+	layer, err := structpb.NewStruct(fields)
+	if err != nil {
+		return nil, err
+	}
 
 	return &envoy_service_runtime_v3.Runtime{
 		Name:  name,
 		Layer: layer,
 	}, nil
 }
+
+func runtimeKeyValue(key saasv1alpha1.RuntimeKey) (any, error) {
+	values := []any{}
+	if key.Bool != nil {
+		values = append(values, *key.Bool)
+	}
+	if key.Integer != nil {
+		values = append(values, *key.Integer)
+	}
+	if key.String != nil {
+		values = append(values, *key.String)
+	}
+
+	if len(values) != 1 {
+		return nil, fmt.Errorf("runtime key '%s' must have exactly one value set, got %d", key.Name, len(values))
+	}
+
+	return values[0], nil
+}
+
+// isManagedRuntimeKey returns true for the keys that the template
+// already sets from other options
+func isManagedRuntimeKey(name string) bool {
+	return name == "envoy" || name == "overload" ||
+		name == "overload.global_downstream_max_connections" ||
+		strings.HasPrefix(name, "envoy.resource_limits.listener.")
+}
@@ -6,6 +6,7 @@ import (
 	envoy_serializer_v3 "github.com/3scale-sre/marin3r/api/envoy/serializer/v3"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	"github.com/MakeNowJust/heredoc"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 )
 
@@ -16,9 +17,10 @@ func TestRuntime_v1(t *testing.T) {
 	}
 
 	tests := []struct {
-		name string
-		args args
-		want string
+		name    string
+		args    args
+		want    string
+		wantErr bool
 	}{
 		{
 			name: "Generates runtime",
@@ -42,10 +44,84 @@ func TestRuntime_v1(t *testing.T) {
                 name: runtime
 			`),
 		},
+		{
+			name: "Generates runtime with custom limits and keys",
+			args: args{
+				name: "runtime",
+				opts: &saasv1alpha1.Runtime{
+					ListenerNames:                  []string{"listener1", "listener2"},
+					ListenerConnectionLimit:        ptr.To(uint32(500)),
+					ListenerConnectionLimits:       map[string]uint32{"listener2": 2000},
+					GlobalDownstreamMaxConnections: ptr.To(uint32(5000)),
+					Keys: []saasv1alpha1.RuntimeKey{
+						{Name: "envoy.reloadable_features.some_flag", Bool: ptr.To(false)},
+						{Name: "overload.premature_reset_total_stream_count", Integer: ptr.To(int64(500))},
+					},
+				},
+			},
+			want: heredoc.Doc(`
+                layer:
+                  envoy:
+                    resource_limits:
+                      listener:
+                        listener1:
+                          connection_limit: 500
+                        listener2:
+                          connection_limit: 2000
+                  envoy.reloadable_features.some_flag: false
+                  overload:
+                    global_downstream_max_connections: 5000
+                  overload.premature_reset_total_stream_count: 500
+                name: runtime
+			`),
+		},
+		{
+			name: "Fails if a key has no value",
+			args: args{
+				name: "runtime",
+				opts: &saasv1alpha1.Runtime{
+					ListenerNames: []string{"listener1"},
+					Keys:          []saasv1alpha1.RuntimeKey{{Name: "envoy.reloadable_features.some_flag"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails if a key is managed by the template",
+			args: args{
+				name: "runtime",
+				opts: &saasv1alpha1.Runtime{
+					ListenerNames: []string{"listener1"},
+					Keys: []saasv1alpha1.RuntimeKey{
+						{Name: "overload.global_downstream_max_connections", Integer: ptr.To(int64(10))},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails if a connection limit is set for an unknown listener",
+			args: args{
+				name: "runtime",
+				opts: &saasv1alpha1.Runtime{
+					ListenerNames:            []string{"listener1"},
+					ListenerConnectionLimits: map[string]uint32{"listener2": 10},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, _ := Runtime_v1(tt.args.name, tt.args.opts)
+			got, err := Runtime_v1(tt.args.name, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Runtime_v1() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if tt.wantErr {
+				return
+			}
 
 			j, err := envoy_serializer_v3.JSON{}.Marshal(got)
 			if err != nil {
@@ -0,0 +1,128 @@
+package envoyconfig
+
+import (
+	"github.com/3scale-sre/marin3r/api/envoy"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	mainClusterSuffix   string = "_main"
+	canaryClusterSuffix string = "_canary"
+)
+
+// TrafficSplit describes how the requests routed to the local clusters (the
+// ones pointing to the pod itself) are split between the main and the canary
+// Deployments of a workload
+type TrafficSplit struct {
+	// Weight is the percentage of requests sent to the canary
+	Weight uint32
+	// MainHost is the hostname that resolves to the pods of the main Deployment
+	MainHost string
+	// CanaryHost is the hostname that resolves to the pods of the canary Deployment
+	CanaryHost string
+}
+
+// CanaryClusterName returns the name of the cluster that sends
+// traffic to the canary for the given local cluster
+func CanaryClusterName(cluster string) string {
+	return cluster + canaryClusterSuffix
+}
+
+// apply adds a main and a canary cluster for each local cluster and replaces
+// the routes to local clusters with weighted routes to those clusters
+func (ts *TrafficSplit) apply(resources []envoy.Resource) []envoy.Resource {
+	if ts == nil {
+		return resources
+	}
+
+	out := make([]envoy.Resource, 0, len(resources))
+	local := map[string]bool{}
+
+	for _, res := range resources {
+		out = append(out, res)
+
+		if cluster, ok := res.(*envoy_config_cluster_v3.Cluster); ok && isLocalCluster(cluster) {
+			local[cluster.GetName()] = true
+			out = append(out,
+				upstreamCluster(cluster, cluster.GetName()+mainClusterSuffix, ts.MainHost),
+				upstreamCluster(cluster, CanaryClusterName(cluster.GetName()), ts.CanaryHost),
+			)
+		}
+	}
+
+	for _, res := range out {
+		rc, ok := res.(*envoy_config_route_v3.RouteConfiguration)
+		if !ok {
+			continue
+		}
+
+		for _, vhost := range rc.GetVirtualHosts() {
+			for _, route := range vhost.GetRoutes() {
+				action := route.GetRoute()
+				if action == nil || !local[action.GetCluster()] {
+					continue
+				}
+
+				cluster := action.GetCluster()
+				action.ClusterSpecifier = &envoy_config_route_v3.RouteAction_WeightedClusters{
+					WeightedClusters: &envoy_config_route_v3.WeightedCluster{
+						Clusters: []*envoy_config_route_v3.WeightedCluster_ClusterWeight{
+							{Name: cluster + mainClusterSuffix, Weight: wrapperspb.UInt32(100 - ts.Weight)},
+							{Name: CanaryClusterName(cluster), Weight: wrapperspb.UInt32(ts.Weight)},
+						},
+					},
+				}
+			}
+		}
+	}
+
+	return out
+}
+
+// isLocalCluster returns true if all the endpoints of
+// the cluster point to the pod itself
+func isLocalCluster(cluster *envoy_config_cluster_v3.Cluster) bool {
+	found := false
+
+	for _, lep := range cluster.GetLoadAssignment().GetEndpoints() {
+		for _, ep := range lep.GetLbEndpoints() {
+			switch ep.GetEndpoint().GetAddress().GetSocketAddress().GetAddress() {
+			case "127.0.0.1", "localhost", "::1":
+				found = true
+			default:
+				return false
+			}
+		}
+	}
+
+	return found
+}
+
+// upstreamCluster returns a copy of the cluster with the given name
+// and with all its endpoints pointing to the given host
+func upstreamCluster(cluster *envoy_config_cluster_v3.Cluster, name, host string) *envoy_config_cluster_v3.Cluster {
+	upstream := proto.Clone(cluster).(*envoy_config_cluster_v3.Cluster)
+	upstream.Name = name
+	upstream.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_Type{Type: envoy_config_cluster_v3.Cluster_STRICT_DNS}
+	upstream.LoadAssignment.ClusterName = name
+
+	for _, lep := range upstream.GetLoadAssignment().GetEndpoints() {
+		for _, ep := range lep.GetLbEndpoints() {
+			sa := ep.GetEndpoint().GetAddress().GetSocketAddress()
+			ep.GetEndpoint().Address = &envoy_config_core_v3.Address{
+				Address: &envoy_config_core_v3.Address_SocketAddress{
+					SocketAddress: &envoy_config_core_v3.SocketAddress{
+						Address:       host,
+						PortSpecifier: sa.GetPortSpecifier(),
+					},
+				},
+			}
+		}
+	}
+
+	return upstream
+}
@@ -0,0 +1,230 @@
+package envoyconfig
+
+import (
+	"testing"
+
+	"github.com/3scale-sre/marin3r/api/envoy"
+	envoy_serializer_v3 "github.com/3scale-sre/marin3r/api/envoy/serializer/v3"
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/envoyconfig/factory"
+	"github.com/MakeNowJust/heredoc"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+func TestTrafficSplit_apply(t *testing.T) {
+	resources := saasv1alpha1.MapOfEnvoyDynamicConfig{
+		"local": {
+			GeneratorVersion: ptr.To("v1"),
+			Cluster:          &saasv1alpha1.Cluster{Host: "127.0.0.1", Port: 3000, IsHttp2: ptr.To(false)},
+		},
+		"remote": {
+			GeneratorVersion: ptr.To("v1"),
+			Cluster:          &saasv1alpha1.Cluster{Host: "example.com", Port: 443, IsHttp2: ptr.To(false)},
+		},
+		"route": {
+			GeneratorVersion: ptr.To("v1"),
+			RouteConfiguration: &saasv1alpha1.RouteConfiguration{
+				VirtualHosts: []runtime.RawExtension{{
+					Raw: []byte(`{"name":"vhost","domains":["*"],"routes":[` +
+						`{"match":{"prefix":"/remote"},"route":{"cluster":"remote"}},` +
+						`{"match":{"prefix":"/"},"route":{"cluster":"local"}}]}`),
+				}},
+			},
+		},
+	}.AsList()
+
+	tests := []struct {
+		name  string
+		split *TrafficSplit
+		want  []string
+	}{
+		{
+			name:  "Does nothing without a split",
+			split: nil,
+			want: []string{
+				heredoc.Doc(`
+					connect_timeout: 1s
+					dns_lookup_family: V4_ONLY
+					load_assignment:
+					  cluster_name: local
+					  endpoints:
+					  - lb_endpoints:
+					    - endpoint:
+					        address:
+					          socket_address:
+					            address: 127.0.0.1
+					            port_value: 3000
+					name: local
+					type: STRICT_DNS
+				`),
+				heredoc.Doc(`
+					connect_timeout: 1s
+					dns_lookup_family: V4_ONLY
+					load_assignment:
+					  cluster_name: remote
+					  endpoints:
+					  - lb_endpoints:
+					    - endpoint:
+					        address:
+					          socket_address:
+					            address: example.com
+					            port_value: 443
+					name: remote
+					type: STRICT_DNS
+				`),
+				heredoc.Doc(`
+					name: route
+					virtual_hosts:
+					- domains:
+					  - '*'
+					  name: vhost
+					  routes:
+					  - match:
+					      prefix: /remote
+					    route:
+					      cluster: remote
+					  - match:
+					      prefix: /
+					    route:
+					      cluster: local
+				`),
+			},
+		},
+		{
+			name: "Splits the traffic of local clusters",
+			split: &TrafficSplit{
+				Weight:     20,
+				MainHost:   "main-upstream.ns.svc.cluster.local",
+				CanaryHost: "canary-upstream.ns.svc.cluster.local",
+			},
+			want: []string{
+				heredoc.Doc(`
+					connect_timeout: 1s
+					dns_lookup_family: V4_ONLY
+					load_assignment:
+					  cluster_name: local
+					  endpoints:
+					  - lb_endpoints:
+					    - endpoint:
+					        address:
+					          socket_address:
+					            address: 127.0.0.1
+					            port_value: 3000
+					name: local
+					type: STRICT_DNS
+				`),
+				heredoc.Doc(`
+					connect_timeout: 1s
+					dns_lookup_family: V4_ONLY
+					load_assignment:
+					  cluster_name: local_main
+					  endpoints:
+					  - lb_endpoints:
+					    - endpoint:
+					        address:
+					          socket_address:
+					            address: main-upstream.ns.svc.cluster.local
+					            port_value: 3000
+					name: local_main
+					type: STRICT_DNS
+				`),
+				heredoc.Doc(`
+					connect_timeout: 1s
+					dns_lookup_family: V4_ONLY
+					load_assignment:
+					  cluster_name: local_canary
+					  endpoints:
+					  - lb_endpoints:
+					    - endpoint:
+					        address:
+					          socket_address:
+					            address: canary-upstream.ns.svc.cluster.local
+					            port_value: 3000
+					name: local_canary
+					type: STRICT_DNS
+				`),
+				heredoc.Doc(`
+					connect_timeout: 1s
+					dns_lookup_family: V4_ONLY
+					load_assignment:
+					  cluster_name: remote
+					  endpoints:
+					  - lb_endpoints:
+					    - endpoint:
+					        address:
+					          socket_address:
+					            address: example.com
+					            port_value: 443
+					name: remote
+					type: STRICT_DNS
+				`),
+				heredoc.Doc(`
+					name: route
+					virtual_hosts:
+					- domains:
+					  - '*'
+					  name: vhost
+					  routes:
+					  - match:
+					      prefix: /remote
+					    route:
+					      cluster: remote
+					  - match:
+					      prefix: /
+					    route:
+					      weighted_clusters:
+					        clusters:
+					        - name: local_main
+					          weight: 80
+					        - name: local_canary
+					          weight: 20
+				`),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			protos, err := generate(factory.Default(), tt.split, resources...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := validate(protos); err != nil {
+				t.Fatal(err)
+			}
+
+			got := serialize(t, protos)
+			if len(got) != len(tt.want) {
+				t.Fatalf("TrafficSplit.apply() got %d resources, want %d:\n%s", len(got), len(tt.want), got)
+			}
+
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("TrafficSplit.apply():\n# got:\n%v\n# want:\n%v", got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func serialize(t *testing.T, resources []envoy.Resource) []string {
+	out := make([]string, 0, len(resources))
+
+	for _, res := range resources {
+		j, err := envoy_serializer_v3.JSON{}.Marshal(res)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		y, err := yaml.JSONToYAML([]byte(j))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		out = append(out, string(y))
+	}
+
+	return out
+}
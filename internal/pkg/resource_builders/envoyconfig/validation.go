@@ -0,0 +1,220 @@
+package envoyconfig
+
+import (
+	"fmt"
+
+	"github.com/3scale-sre/marin3r/api/envoy"
+	descriptor "github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/envoyconfig/descriptor"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/envoyconfig/factory"
+	operatorutils "github.com/3scale-sre/saas-operator/internal/pkg/util"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_http_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	http_connection_manager_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_extensions_filters_network_tcp_proxy_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	envoy_service_runtime_v3 "github.com/envoyproxy/go-control-plane/envoy/service/runtime/v3"
+)
+
+// ValidationError is returned when one of the generated envoy resources
+// is not valid, either because it does not pass the protobuf validation
+// rules or because it references a resource that is not defined
+type ValidationError struct {
+	// Resource is the kind and name of the invalid resource
+	Resource string
+	Err      error
+}
+
+// Ensure the Error interface is implemented
+var _ error = &ValidationError{}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid envoy resource '%s': %s", e.Resource, e.Err.Error())
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Validate generates the envoy resources from the given descriptors and validates
+// them, without publishing anything. It returns the list of all the errors found.
+func Validate(factory factory.EnvoyDynamicConfigFactory, split *TrafficSplit, resources ...descriptor.EnvoyDynamicConfigDescriptor) error {
+	protos, err := generate(factory, split, resources...)
+	if err != nil {
+		return err
+	}
+
+	return validate(protos)
+}
+
+func generate(factory factory.EnvoyDynamicConfigFactory, split *TrafficSplit, resources ...descriptor.EnvoyDynamicConfigDescriptor) ([]envoy.Resource, error) {
+	protos := []envoy.Resource{}
+
+	for _, res := range resources {
+		proto, err := factory.NewResource(res)
+		if err != nil {
+			return nil, &ValidationError{Resource: res.GetName(), Err: err}
+		}
+
+		protos = append(protos, proto)
+	}
+
+	return split.apply(protos), nil
+}
+
+// validate runs the protobuf validation rules of each resource and checks that the
+// references between resources (rds route configurations, route clusters and rate
+// limit clusters) point to resources that are part of the same config.
+func validate(resources []envoy.Resource) error {
+	merr := operatorutils.MultiError{}
+	clusters := map[string]bool{}
+	routes := map[string]bool{}
+
+	for _, res := range resources {
+		switch o := res.(type) {
+		case *envoy_config_cluster_v3.Cluster:
+			clusters[o.GetName()] = true
+		case *envoy_config_route_v3.RouteConfiguration:
+			routes[o.GetName()] = true
+		}
+	}
+
+	for _, res := range resources {
+		name := resourceName(res)
+
+		if err := res.(interface{ ValidateAll() error }).ValidateAll(); err != nil {
+			merr = append(merr, &ValidationError{Resource: name, Err: err})
+
+			continue
+		}
+
+		refs, err := references(res)
+		if err != nil {
+			merr = append(merr, &ValidationError{Resource: name, Err: err})
+
+			continue
+		}
+
+		for _, route := range refs.routes {
+			if !routes[route] {
+				merr = append(merr, &ValidationError{Resource: name,
+					Err: fmt.Errorf("route configuration '%s' is not defined", route)})
+			}
+		}
+
+		for _, cluster := range refs.clusters {
+			if !clusters[cluster] {
+				merr = append(merr, &ValidationError{Resource: name,
+					Err: fmt.Errorf("cluster '%s' is not defined", cluster)})
+			}
+		}
+	}
+
+	return merr.ErrorOrNil()
+}
+
+type resourceRefs struct {
+	routes   []string
+	clusters []string
+}
+
+func references(res envoy.Resource) (*resourceRefs, error) {
+	refs := &resourceRefs{}
+
+	switch o := res.(type) {
+	case *envoy_config_route_v3.RouteConfiguration:
+		for _, vhost := range o.GetVirtualHosts() {
+			for _, route := range vhost.GetRoutes() {
+				action := route.GetRoute()
+				if action == nil {
+					continue
+				}
+
+				if cluster := action.GetCluster(); cluster != "" {
+					refs.clusters = append(refs.clusters, cluster)
+				}
+
+				for _, wc := range action.GetWeightedClusters().GetClusters() {
+					refs.clusters = append(refs.clusters, wc.GetName())
+				}
+			}
+		}
+
+	case *envoy_config_listener_v3.Listener:
+		for _, chain := range o.GetFilterChains() {
+			for _, filter := range chain.GetFilters() {
+				if filter.GetTypedConfig() == nil {
+					continue
+				}
+
+				proto, err := filter.GetTypedConfig().UnmarshalNew()
+				if err != nil {
+					return nil, err
+				}
+
+				switch f := proto.(type) {
+				case *http_connection_manager_v3.HttpConnectionManager:
+					if rds := f.GetRds(); rds != nil {
+						refs.routes = append(refs.routes, rds.GetRouteConfigName())
+					}
+
+					clusters, err := rateLimitClusters(f)
+					if err != nil {
+						return nil, err
+					}
+
+					refs.clusters = append(refs.clusters, clusters...)
+
+				case *envoy_extensions_filters_network_tcp_proxy_v3.TcpProxy:
+					if cluster := f.GetCluster(); cluster != "" {
+						refs.clusters = append(refs.clusters, cluster)
+					}
+
+					for _, wc := range f.GetWeightedClusters().GetClusters() {
+						refs.clusters = append(refs.clusters, wc.GetName())
+					}
+				}
+			}
+		}
+	}
+
+	return refs, nil
+}
+
+func rateLimitClusters(hcm *http_connection_manager_v3.HttpConnectionManager) ([]string, error) {
+	clusters := []string{}
+
+	for _, filter := range hcm.GetHttpFilters() {
+		if filter.GetTypedConfig() == nil {
+			continue
+		}
+
+		proto, err := filter.GetTypedConfig().UnmarshalNew()
+		if err != nil {
+			return nil, err
+		}
+
+		if rl, ok := proto.(*envoy_extensions_filters_http_ratelimit_v3.RateLimit); ok {
+			if cluster := rl.GetRateLimitService().GetGrpcService().GetEnvoyGrpc().GetClusterName(); cluster != "" {
+				clusters = append(clusters, cluster)
+			}
+		}
+	}
+
+	return clusters, nil
+}
+
+func resourceName(res envoy.Resource) string {
+	switch o := res.(type) {
+	case *envoy_config_cluster_v3.Cluster:
+		return "cluster/" + o.GetName()
+	case *envoy_config_route_v3.RouteConfiguration:
+		return "route/" + o.GetName()
+	case *envoy_config_listener_v3.Listener:
+		return "listener/" + o.GetName()
+	case *envoy_service_runtime_v3.Runtime:
+		return "runtime/" + o.GetName()
+	default:
+		return fmt.Sprintf("%T", res)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -27,7 +28,7 @@ func New(key types.NamespacedName, labels map[string]string, cfg saasv1alpha1.Ho
 					Name:       key.Name,
 				},
 				MinReplicas: cfg.MinReplicas,
-				Behavior:    cfg.Behavior,
+				Behavior:    Behavior(cfg.Behavior),
 			},
 			Status: autoscalingv2.HorizontalPodAutoscalerStatus{
 				Conditions: []autoscalingv2.HorizontalPodAutoscalerCondition{},
@@ -53,6 +54,54 @@ func New(key types.NamespacedName, labels map[string]string, cfg saasv1alpha1.Ho
 			}
 		}
 
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, cfg.Metrics...)
+
 		return &hpa, nil
 	}
 }
+
+// Behavior returns a copy of the given behavior with the defaults that the API server
+// sets for the scaling rules that are not set, so it can be compared with the live object
+func Behavior(behavior *autoscalingv2.HorizontalPodAutoscalerBehavior) *autoscalingv2.HorizontalPodAutoscalerBehavior {
+	if behavior == nil {
+		return nil
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscalerBehavior{
+		ScaleUp: scalingRules(behavior.ScaleUp, autoscalingv2.HPAScalingRules{
+			StabilizationWindowSeconds: ptr.To[int32](0),
+			SelectPolicy:               ptr.To(autoscalingv2.MaxChangePolicySelect),
+			Policies: []autoscalingv2.HPAScalingPolicy{
+				{Type: autoscalingv2.PodsScalingPolicy, Value: 4, PeriodSeconds: 15},
+				{Type: autoscalingv2.PercentScalingPolicy, Value: 100, PeriodSeconds: 15},
+			},
+		}),
+		ScaleDown: scalingRules(behavior.ScaleDown, autoscalingv2.HPAScalingRules{
+			SelectPolicy: ptr.To(autoscalingv2.MaxChangePolicySelect),
+			Policies: []autoscalingv2.HPAScalingPolicy{
+				{Type: autoscalingv2.PercentScalingPolicy, Value: 100, PeriodSeconds: 15},
+			},
+		}),
+	}
+}
+
+func scalingRules(rules *autoscalingv2.HPAScalingRules, def autoscalingv2.HPAScalingRules) *autoscalingv2.HPAScalingRules {
+	if rules == nil {
+		return &def
+	}
+
+	out := rules.DeepCopy()
+	if out.StabilizationWindowSeconds == nil {
+		out.StabilizationWindowSeconds = def.StabilizationWindowSeconds
+	}
+
+	if out.SelectPolicy == nil {
+		out.SelectPolicy = def.SelectPolicy
+	}
+
+	if out.Policies == nil {
+		out.Policies = def.Policies
+	}
+
+	return out
+}
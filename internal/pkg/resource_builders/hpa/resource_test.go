@@ -0,0 +1,87 @@
+package hpa
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestNew(t *testing.T) {
+	external := autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ExternalMetricSourceType,
+		External: &autoscalingv2.ExternalMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{
+				Name:     "sidekiq_queue_size",
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"queue": "default"}},
+			},
+			Target: autoscalingv2.MetricTarget{
+				Type:         autoscalingv2.AverageValueMetricType,
+				AverageValue: ptr.To(resource.MustParse("100")),
+			},
+		},
+	}
+
+	got, err := New(types.NamespacedName{Name: "system-sidekiq", Namespace: "ns"}, nil, saasv1alpha1.HorizontalPodAutoscalerSpec{
+		MinReplicas:         ptr.To[int32](2),
+		MaxReplicas:         ptr.To[int32](8),
+		ResourceName:        ptr.To("cpu"),
+		ResourceUtilization: ptr.To[int32](90),
+		Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+			ScaleDown: &autoscalingv2.HPAScalingRules{
+				StabilizationWindowSeconds: ptr.To[int32](600),
+				Policies: []autoscalingv2.HPAScalingPolicy{
+					{Type: autoscalingv2.PodsScalingPolicy, Value: 1, PeriodSeconds: 60},
+				},
+			},
+		},
+		Metrics: []autoscalingv2.MetricSpec{external},
+	})(nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	wantMetrics := []autoscalingv2.MetricSpec{
+		{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: ptr.To[int32](90),
+				},
+			},
+		},
+		external,
+	}
+	if diff := cmp.Diff(got.Spec.Metrics, wantMetrics); len(diff) > 0 {
+		t.Errorf("New() got metrics diff %v", diff)
+	}
+
+	wantBehavior := &autoscalingv2.HorizontalPodAutoscalerBehavior{
+		ScaleUp: &autoscalingv2.HPAScalingRules{
+			StabilizationWindowSeconds: ptr.To[int32](0),
+			SelectPolicy:               ptr.To(autoscalingv2.MaxChangePolicySelect),
+			Policies: []autoscalingv2.HPAScalingPolicy{
+				{Type: autoscalingv2.PodsScalingPolicy, Value: 4, PeriodSeconds: 15},
+				{Type: autoscalingv2.PercentScalingPolicy, Value: 100, PeriodSeconds: 15},
+			},
+		},
+		ScaleDown: &autoscalingv2.HPAScalingRules{
+			StabilizationWindowSeconds: ptr.To[int32](600),
+			SelectPolicy:               ptr.To(autoscalingv2.MaxChangePolicySelect),
+			Policies: []autoscalingv2.HPAScalingPolicy{
+				{Type: autoscalingv2.PodsScalingPolicy, Value: 1, PeriodSeconds: 60},
+			},
+		},
+	}
+	if diff := cmp.Diff(got.Spec.Behavior, wantBehavior); len(diff) > 0 {
+		t.Errorf("New() got behavior diff %v", diff)
+	}
+}
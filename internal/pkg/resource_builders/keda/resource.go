@@ -0,0 +1,122 @@
+package keda
+
+import (
+	"fmt"
+	"strconv"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	kedav1alpha1 "github.com/3scale-sre/saas-operator/internal/pkg/keda/v1alpha1"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/hpa"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// New returns a basereconciler_types.GeneratorFunction function that will return a KEDA
+// ScaledObject resource when called
+func New(key types.NamespacedName, labels map[string]string, cfg saasv1alpha1.KEDAScaledObjectSpec) func(client.Object) (*kedav1alpha1.ScaledObject, error) {
+	return func(client.Object) (*kedav1alpha1.ScaledObject, error) {
+		so := &kedav1alpha1.ScaledObject{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    labels,
+			},
+			Spec: kedav1alpha1.ScaledObjectSpec{
+				ScaleTargetRef: &kedav1alpha1.ScaleTarget{
+					APIVersion: appsv1.SchemeGroupVersion.String(),
+					Kind:       "Deployment",
+					Name:       key.Name,
+				},
+				PollingInterval:  cfg.PollingInterval,
+				CooldownPeriod:   cfg.CooldownPeriod,
+				IdleReplicaCount: cfg.IdleReplicas,
+				MinReplicaCount:  cfg.MinReplicas,
+				MaxReplicaCount:  cfg.MaxReplicas,
+				Triggers:         make([]kedav1alpha1.ScaleTriggers, 0, len(cfg.Triggers)),
+			},
+		}
+
+		if cfg.Behavior != nil {
+			so.Spec.Advanced = &kedav1alpha1.AdvancedConfig{
+				HorizontalPodAutoscalerConfig: &kedav1alpha1.HorizontalPodAutoscalerConfig{
+					Behavior: hpa.Behavior(cfg.Behavior),
+				},
+			}
+		}
+
+		for idx, t := range cfg.Triggers {
+			trigger, err := scaleTrigger(t)
+			if err != nil {
+				return nil, fmt.Errorf("invalid KEDA trigger %d: %w", idx, err)
+			}
+
+			so.Spec.Triggers = append(so.Spec.Triggers, trigger)
+		}
+
+		return so, nil
+	}
+}
+
+func scaleTrigger(t saasv1alpha1.KEDATriggerSpec) (kedav1alpha1.ScaleTriggers, error) {
+	trigger := kedav1alpha1.ScaleTriggers{}
+
+	if t.Name != nil {
+		trigger.Name = *t.Name
+	}
+
+	if t.AuthenticationRef != nil {
+		trigger.AuthenticationRef = &kedav1alpha1.AuthenticationRef{Name: *t.AuthenticationRef}
+	}
+
+	switch {
+	case t.RedisListLength != nil && t.Postgres != nil:
+		return trigger, fmt.Errorf("only one of 'redisListLength' or 'postgres' can be set")
+
+	case t.RedisListLength != nil:
+		trigger.Type = "redis"
+		trigger.Metadata = map[string]string{
+			"address":  t.RedisListLength.Address,
+			"listName": t.RedisListLength.ListName,
+		}
+		setInt(trigger.Metadata, "listLength", t.RedisListLength.ListLength)
+		setInt(trigger.Metadata, "activationListLength", t.RedisListLength.ActivationListLength)
+		setInt(trigger.Metadata, "databaseIndex", t.RedisListLength.DatabaseIndex)
+
+		if t.RedisListLength.EnableTLS != nil {
+			trigger.Metadata["enableTLS"] = strconv.FormatBool(*t.RedisListLength.EnableTLS)
+		}
+
+	case t.Postgres != nil:
+		trigger.Type = "postgresql"
+		trigger.Metadata = map[string]string{
+			"query":            t.Postgres.Query,
+			"targetQueryValue": t.Postgres.TargetQueryValue,
+		}
+		setString(trigger.Metadata, "activationTargetQueryValue", t.Postgres.ActivationTargetQueryValue)
+		setString(trigger.Metadata, "connectionFromEnv", t.Postgres.ConnectionFromEnv)
+		setString(trigger.Metadata, "host", t.Postgres.Host)
+		setString(trigger.Metadata, "port", t.Postgres.Port)
+		setString(trigger.Metadata, "dbName", t.Postgres.DBName)
+		setString(trigger.Metadata, "userName", t.Postgres.UserName)
+		setString(trigger.Metadata, "sslmode", t.Postgres.SSLMode)
+
+	default:
+		return trigger, fmt.Errorf("one of 'redisListLength' or 'postgres' must be set")
+	}
+
+	return trigger, nil
+}
+
+func setString(metadata map[string]string, key string, value *string) {
+	if value != nil {
+		metadata[key] = *value
+	}
+}
+
+func setInt(metadata map[string]string, key string, value *int32) {
+	if value != nil {
+		metadata[key] = strconv.Itoa(int(*value))
+	}
+}
@@ -0,0 +1,146 @@
+package keda
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	kedav1alpha1 "github.com/3scale-sre/saas-operator/internal/pkg/keda/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestNew(t *testing.T) {
+	key := types.NamespacedName{Name: "backend-worker", Namespace: "ns"}
+	labels := map[string]string{"app": "backend"}
+
+	tests := []struct {
+		name    string
+		cfg     saasv1alpha1.KEDAScaledObjectSpec
+		want    *kedav1alpha1.ScaledObject
+		wantErr bool
+	}{
+		{
+			name: "Generates a ScaledObject with Redis and Postgres triggers",
+			cfg: saasv1alpha1.KEDAScaledObjectSpec{
+				MinReplicas:     ptr.To[int32](2),
+				MaxReplicas:     ptr.To[int32](10),
+				PollingInterval: ptr.To[int32](15),
+				Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+					ScaleDown: &autoscalingv2.HPAScalingRules{StabilizationWindowSeconds: ptr.To[int32](600)},
+				},
+				Triggers: []saasv1alpha1.KEDATriggerSpec{
+					{
+						Name: ptr.To("queue"),
+						RedisListLength: &saasv1alpha1.KEDARedisListLengthTrigger{
+							Address:       "redis:6379",
+							ListName:      "resque:queue:priority",
+							ListLength:    ptr.To[int32](100),
+							DatabaseIndex: ptr.To[int32](1),
+							EnableTLS:     ptr.To(false),
+						},
+					},
+					{
+						AuthenticationRef: ptr.To("postgres-auth"),
+						Postgres: &saasv1alpha1.KEDAPostgresTrigger{
+							Query:            "SELECT COUNT(*) FROM que_jobs",
+							TargetQueryValue: "50",
+							Host:             ptr.To("postgres"),
+							DBName:           ptr.To("zync"),
+							SSLMode:          ptr.To("disable"),
+						},
+					},
+				},
+			},
+			want: &kedav1alpha1.ScaledObject{
+				ObjectMeta: metav1.ObjectMeta{Name: "backend-worker", Namespace: "ns", Labels: labels},
+				Spec: kedav1alpha1.ScaledObjectSpec{
+					ScaleTargetRef:  &kedav1alpha1.ScaleTarget{APIVersion: "apps/v1", Kind: "Deployment", Name: "backend-worker"},
+					PollingInterval: ptr.To[int32](15),
+					MinReplicaCount: ptr.To[int32](2),
+					MaxReplicaCount: ptr.To[int32](10),
+					Advanced: &kedav1alpha1.AdvancedConfig{
+						HorizontalPodAutoscalerConfig: &kedav1alpha1.HorizontalPodAutoscalerConfig{
+							Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+								ScaleUp: &autoscalingv2.HPAScalingRules{
+									StabilizationWindowSeconds: ptr.To[int32](0),
+									SelectPolicy:               ptr.To(autoscalingv2.MaxChangePolicySelect),
+									Policies: []autoscalingv2.HPAScalingPolicy{
+										{Type: autoscalingv2.PodsScalingPolicy, Value: 4, PeriodSeconds: 15},
+										{Type: autoscalingv2.PercentScalingPolicy, Value: 100, PeriodSeconds: 15},
+									},
+								},
+								ScaleDown: &autoscalingv2.HPAScalingRules{
+									StabilizationWindowSeconds: ptr.To[int32](600),
+									SelectPolicy:               ptr.To(autoscalingv2.MaxChangePolicySelect),
+									Policies: []autoscalingv2.HPAScalingPolicy{
+										{Type: autoscalingv2.PercentScalingPolicy, Value: 100, PeriodSeconds: 15},
+									},
+								},
+							},
+						},
+					},
+					Triggers: []kedav1alpha1.ScaleTriggers{
+						{
+							Type: "redis",
+							Name: "queue",
+							Metadata: map[string]string{
+								"address":       "redis:6379",
+								"listName":      "resque:queue:priority",
+								"listLength":    "100",
+								"databaseIndex": "1",
+								"enableTLS":     "false",
+							},
+						},
+						{
+							Type:              "postgresql",
+							AuthenticationRef: &kedav1alpha1.AuthenticationRef{Name: "postgres-auth"},
+							Metadata: map[string]string{
+								"query":            "SELECT COUNT(*) FROM que_jobs",
+								"targetQueryValue": "50",
+								"host":             "postgres",
+								"dbName":           "zync",
+								"sslmode":          "disable",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Fails if a trigger has no scaler",
+			cfg: saasv1alpha1.KEDAScaledObjectSpec{
+				Triggers: []saasv1alpha1.KEDATriggerSpec{{Name: ptr.To("empty")}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Fails if a trigger has more than one scaler",
+			cfg: saasv1alpha1.KEDAScaledObjectSpec{
+				Triggers: []saasv1alpha1.KEDATriggerSpec{{
+					RedisListLength: &saasv1alpha1.KEDARedisListLengthTrigger{Address: "redis:6379", ListName: "queue"},
+					Postgres:        &saasv1alpha1.KEDAPostgresTrigger{Query: "SELECT 1", TargetQueryValue: "1"},
+				}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(key, labels, tt.cfg)(nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("New() got diff %v", diff)
+			}
+		})
+	}
+}
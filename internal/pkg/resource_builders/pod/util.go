@@ -85,35 +85,85 @@ func ExecProbe(command string, cfg saasv1alpha1.ProbeSpec) *corev1.Probe {
 	}
 }
 
-// Affinity returns a corev1.Affinity struct
-func Affinity(podAntiAffinitySelector map[string]string, nodeAffinity *corev1.NodeAffinity) *corev1.Affinity {
-	return &corev1.Affinity{
-		NodeAffinity: nodeAffinity,
-		PodAntiAffinity: &corev1.PodAntiAffinity{
-			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
-				{
-					Weight: 100,
-					PodAffinityTerm: corev1.PodAffinityTerm{
-						TopologyKey: corev1.LabelHostname,
-						LabelSelector: &metav1.LabelSelector{
-							MatchLabels: podAntiAffinitySelector,
-						},
-					},
+// Affinity returns a corev1.Affinity struct with the given node affinity and the pod
+// anti-affinity rules of the preset, which select the pods matching podAntiAffinitySelector
+func Affinity(podAntiAffinitySelector map[string]string, nodeAffinity *corev1.NodeAffinity,
+	preset saasv1alpha1.PodAntiAffinityPreset) *corev1.Affinity {
+	term := func(topologyKey string) corev1.PodAffinityTerm {
+		return corev1.PodAffinityTerm{
+			TopologyKey: topologyKey,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: podAntiAffinitySelector,
+			},
+		}
+	}
+
+	switch preset {
+	case saasv1alpha1.PodAntiAffinityPresetNone:
+		if nodeAffinity == nil {
+			return nil
+		}
+
+		return &corev1.Affinity{NodeAffinity: nodeAffinity}
+
+	case saasv1alpha1.PodAntiAffinityPresetHard:
+		return &corev1.Affinity{
+			NodeAffinity: nodeAffinity,
+			PodAntiAffinity: &corev1.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+					term(corev1.LabelHostname),
 				},
-				{
-					Weight: 99,
-					PodAffinityTerm: corev1.PodAffinityTerm{
-						TopologyKey: corev1.LabelTopologyZone,
-						LabelSelector: &metav1.LabelSelector{
-							MatchLabels: podAntiAffinitySelector,
-						},
-					},
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+					{Weight: 100, PodAffinityTerm: term(corev1.LabelTopologyZone)},
 				},
 			},
-		},
+		}
+
+	default:
+		return &corev1.Affinity{
+			NodeAffinity: nodeAffinity,
+			PodAntiAffinity: &corev1.PodAntiAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+					{Weight: 100, PodAffinityTerm: term(corev1.LabelHostname)},
+					{Weight: 99, PodAffinityTerm: term(corev1.LabelTopologyZone)},
+				},
+			},
+		}
 	}
 }
 
+// TopologySpreadConstraints returns the given constraints, selecting the pods
+// matching selector in the constraints that do not have a labelSelector
+func TopologySpreadConstraints(selector map[string]string,
+	constraints []corev1.TopologySpreadConstraint) []corev1.TopologySpreadConstraint {
+	if len(constraints) == 0 {
+		return nil
+	}
+
+	list := make([]corev1.TopologySpreadConstraint, 0, len(constraints))
+
+	for _, c := range constraints {
+		c = *c.DeepCopy()
+		if c.LabelSelector == nil {
+			c.LabelSelector = &metav1.LabelSelector{MatchLabels: selector}
+		}
+
+		list = append(list, c)
+	}
+
+	return list
+}
+
+// SecurityContext returns the given corev1.PodSecurityContext, or the
+// empty one the API server defaults to if it is not set
+func SecurityContext(sc *corev1.PodSecurityContext) *corev1.PodSecurityContext {
+	if sc == nil {
+		return &corev1.PodSecurityContext{}
+	}
+
+	return sc
+}
+
 // ContainerPortTCP returns a TCP corev1.ContainerPort
 func ContainerPortTCP(name string, port int32) corev1.ContainerPort {
 	return corev1.ContainerPort{
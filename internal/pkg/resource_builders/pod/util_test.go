@@ -0,0 +1,119 @@
+package pod
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAffinity(t *testing.T) {
+	selector := map[string]string{"app": "test"}
+	term := func(topologyKey string) corev1.PodAffinityTerm {
+		return corev1.PodAffinityTerm{
+			TopologyKey:   topologyKey,
+			LabelSelector: &metav1.LabelSelector{MatchLabels: selector},
+		}
+	}
+	nodeAffinity := &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "role", Operator: corev1.NodeSelectorOpExists}},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		nodeAffinity *corev1.NodeAffinity
+		preset       saasv1alpha1.PodAntiAffinityPreset
+		want         *corev1.Affinity
+	}{
+		{
+			name:         "Soft preset",
+			nodeAffinity: nodeAffinity,
+			preset:       saasv1alpha1.PodAntiAffinityPresetSoft,
+			want: &corev1.Affinity{
+				NodeAffinity: nodeAffinity,
+				PodAntiAffinity: &corev1.PodAntiAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+						{Weight: 100, PodAffinityTerm: term(corev1.LabelHostname)},
+						{Weight: 99, PodAffinityTerm: term(corev1.LabelTopologyZone)},
+					},
+				},
+			},
+		},
+		{
+			name:   "Hard preset",
+			preset: saasv1alpha1.PodAntiAffinityPresetHard,
+			want: &corev1.Affinity{
+				PodAntiAffinity: &corev1.PodAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{term(corev1.LabelHostname)},
+					PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+						{Weight: 100, PodAffinityTerm: term(corev1.LabelTopologyZone)},
+					},
+				},
+			},
+		},
+		{
+			name:         "None preset keeps the node affinity",
+			nodeAffinity: nodeAffinity,
+			preset:       saasv1alpha1.PodAntiAffinityPresetNone,
+			want:         &corev1.Affinity{NodeAffinity: nodeAffinity},
+		},
+		{
+			name:   "None preset without node affinity",
+			preset: saasv1alpha1.PodAntiAffinityPresetNone,
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Affinity(selector, tt.nodeAffinity, tt.preset)
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("Affinity() got diff %v", diff)
+			}
+		})
+	}
+}
+
+func TestTopologySpreadConstraints(t *testing.T) {
+	selector := map[string]string{"app": "test"}
+	custom := &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}}
+
+	tests := []struct {
+		name        string
+		constraints []corev1.TopologySpreadConstraint
+		want        []corev1.TopologySpreadConstraint
+	}{
+		{
+			name:        "No constraints",
+			constraints: []corev1.TopologySpreadConstraint{},
+			want:        nil,
+		},
+		{
+			name: "Selects the pods of the component unless a selector is set",
+			constraints: []corev1.TopologySpreadConstraint{
+				{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.ScheduleAnyway},
+				{MaxSkew: 1, TopologyKey: corev1.LabelHostname, WhenUnsatisfiable: corev1.DoNotSchedule, LabelSelector: custom},
+			},
+			want: []corev1.TopologySpreadConstraint{
+				{
+					MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.ScheduleAnyway,
+					LabelSelector: &metav1.LabelSelector{MatchLabels: selector},
+				},
+				{MaxSkew: 1, TopologyKey: corev1.LabelHostname, WhenUnsatisfiable: corev1.DoNotSchedule, LabelSelector: custom},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TopologySpreadConstraints(selector, tt.constraints)
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("TopologySpreadConstraints() got diff %v", diff)
+			}
+		})
+	}
+}
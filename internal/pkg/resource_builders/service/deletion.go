@@ -0,0 +1,42 @@
+package service
+
+import (
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Removals holds the owned Services that are no longer generated
+// and that must not be deleted by the resource pruner
+type Removals struct {
+	// Orphan are the Services that must be released from their
+	// owner, as their endpoint has the Orphan deletion policy
+	Orphan []corev1.Service
+	// Blocked are the LoadBalancer Services that must be kept
+	// until their deletion is explicitly allowed
+	Blocked []corev1.Service
+}
+
+// ServiceRemovals classifies the owned Services that are not in the set of generated
+// Services. Services that are neither orphaned nor blocked can be deleted. LoadBalancer
+// Services are protected from deletion, as it releases the load balancer and its
+// addresses, unless they are annotated with saasv1alpha1.AllowDeletionAnnotation.
+func ServiceRemovals(owned []corev1.Service, generated map[string]bool) Removals {
+	removals := Removals{}
+
+	for _, svc := range owned {
+		if generated[svc.GetName()] {
+			continue
+		}
+
+		switch {
+		case svc.GetAnnotations()[saasv1alpha1.DeletionPolicyAnnotation] == string(saasv1alpha1.DeletionPolicyOrphan):
+			removals.Orphan = append(removals.Orphan, svc)
+
+		case svc.Spec.Type == corev1.ServiceTypeLoadBalancer &&
+			svc.GetAnnotations()[saasv1alpha1.AllowDeletionAnnotation] != "true":
+			removals.Blocked = append(removals.Blocked, svc)
+		}
+	}
+
+	return removals
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServiceRemovals(t *testing.T) {
+	svc := func(name string, typ corev1.ServiceType, annotations map[string]string) corev1.Service {
+		return corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations},
+			Spec:       corev1.ServiceSpec{Type: typ},
+		}
+	}
+
+	type args struct {
+		owned     []corev1.Service
+		generated map[string]bool
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want Removals
+	}{
+		{
+			name: "Nothing to remove",
+			args: args{
+				owned:     []corev1.Service{svc("gateway-nlb", corev1.ServiceTypeLoadBalancer, nil)},
+				generated: map[string]bool{"gateway-nlb": true},
+			},
+			want: Removals{},
+		},
+		{
+			name: "Blocks the deletion of LoadBalancer Services",
+			args: args{
+				owned: []corev1.Service{
+					svc("gateway-nlb", corev1.ServiceTypeLoadBalancer, nil),
+					svc("gateway-svc", corev1.ServiceTypeClusterIP, nil),
+				},
+				generated: map[string]bool{},
+			},
+			want: Removals{Blocked: []corev1.Service{svc("gateway-nlb", corev1.ServiceTypeLoadBalancer, nil)}},
+		},
+		{
+			name: "Allows the deletion of annotated LoadBalancer Services",
+			args: args{
+				owned: []corev1.Service{
+					svc("gateway-nlb", corev1.ServiceTypeLoadBalancer, map[string]string{saasv1alpha1.AllowDeletionAnnotation: "true"}),
+				},
+				generated: map[string]bool{},
+			},
+			want: Removals{},
+		},
+		{
+			name: "Orphans Services with the Orphan deletion policy",
+			args: args{
+				owned: []corev1.Service{
+					svc("gateway-nlb", corev1.ServiceTypeLoadBalancer, map[string]string{saasv1alpha1.DeletionPolicyAnnotation: "Orphan"}),
+					svc("gateway-svc", corev1.ServiceTypeClusterIP, map[string]string{saasv1alpha1.DeletionPolicyAnnotation: "Orphan"}),
+					svc("gateway-awslb", corev1.ServiceTypeLoadBalancer, map[string]string{saasv1alpha1.DeletionPolicyAnnotation: "Orphan"}),
+				},
+				generated: map[string]bool{"gateway-awslb": true},
+			},
+			want: Removals{Orphan: []corev1.Service{
+				svc("gateway-nlb", corev1.ServiceTypeLoadBalancer, map[string]string{saasv1alpha1.DeletionPolicyAnnotation: "Orphan"}),
+				svc("gateway-svc", corev1.ServiceTypeClusterIP, map[string]string{saasv1alpha1.DeletionPolicyAnnotation: "Orphan"}),
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ServiceRemovals(tt.args.owned, tt.args.generated)
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("ServiceRemovals() got diff %v", diff)
+			}
+		})
+	}
+}
@@ -45,6 +45,12 @@ func (sd *ServiceDescriptor) Service(prefix, suffix string) *corev1.Service {
 			opts.Name = fmt.Sprintf("%s-%s-%s-nlb", prefix, strings.ToLower(sd.EndpointName), suffix)
 			spec.NetworkLoadBalancerConfig = saasv1alpha1.InitializeNetworkLoadBalancerSpec(spec.NetworkLoadBalancerConfig, saasv1alpha1.DefaultNetworkLoadBalancerSpec)
 			opts.Annotations = NLBServiceAnnotations(*spec.NetworkLoadBalancerConfig, spec.ExternalDnsHostnames)
+
+		case saasv1alpha1.ServiceTypeAWSLoadBalancer:
+			opts.Type = corev1.ServiceTypeLoadBalancer
+			opts.Name = fmt.Sprintf("%s-%s-%s-awslb", prefix, strings.ToLower(sd.EndpointName), suffix)
+			spec.AWSLoadBalancerConfig = saasv1alpha1.InitializeAWSLoadBalancerSpec(spec.AWSLoadBalancerConfig, saasv1alpha1.DefaultAWSLoadBalancerSpec)
+			opts.Annotations = AWSLoadBalancerServiceAnnotations(*spec.AWSLoadBalancerConfig, spec.ExternalDnsHostnames)
 		}
 
 		// service name override
@@ -60,6 +66,44 @@ func (sd *ServiceDescriptor) Service(prefix, suffix string) *corev1.Service {
 		}
 	}
 
+	if strategy == saasv1alpha1.GatewayAPIStrategy || strategy == saasv1alpha1.IngressStrategy {
+		var nameOverride *string
+
+		var portsOverride []corev1.ServicePort
+
+		if strategy == saasv1alpha1.GatewayAPIStrategy {
+			nameOverride = sd.PublishingStrategy.GatewayAPI.ServiceNameOverride
+			portsOverride = sd.PublishingStrategy.GatewayAPI.ServicePortsOverride
+		} else {
+			nameOverride = sd.PublishingStrategy.Ingress.ServiceNameOverride
+			portsOverride = sd.PublishingStrategy.Ingress.ServicePortsOverride
+		}
+
+		opts.Type = corev1.ServiceTypeClusterIP
+		opts.Name = fmt.Sprintf("%s-%s-%s", prefix, strings.ToLower(sd.EndpointName), suffix)
+		opts.Annotations = map[string]string{}
+
+		// service name override
+		if nameOverride != nil {
+			opts.Name = *nameOverride
+		}
+
+		// Add service ports
+		if len(portsOverride) > 0 {
+			opts.Ports = portsOverride
+		} else {
+			opts.Ports = sd.PortDefinitions
+		}
+	}
+
+	if sd.DeletionPolicy != nil && *sd.DeletionPolicy == saasv1alpha1.DeletionPolicyOrphan {
+		if opts.Annotations == nil {
+			opts.Annotations = map[string]string{}
+		}
+
+		opts.Annotations[saasv1alpha1.DeletionPolicyAnnotation] = string(saasv1alpha1.DeletionPolicyOrphan)
+	}
+
 	return opts.Service().DeepCopy()
 }
 
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// Route returns the Gateway API route that sends the traffic of the endpoint to
+// the given Service. The route is a HTTPRoute, GRPCRoute or TLSRoute depending
+// on the configuration of the GatewayAPI publishing strategy.
+func (sd *ServiceDescriptor) Route(prefix string, svc *corev1.Service) (client.Object, error) {
+	spec := sd.PublishingStrategy.GatewayAPI
+	spec.Default()
+
+	port, err := backendPort(spec.BackendPort, svc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate route for endpoint %s: %w", sd.EndpointName, err)
+	}
+
+	meta := metav1.ObjectMeta{Name: fmt.Sprintf("%s-%s", prefix, strings.ToLower(sd.EndpointName))}
+	common := gatewayv1.CommonRouteSpec{ParentRefs: parentRefs(spec.ParentRefs)}
+	backend := gatewayv1.BackendRef{
+		// set the API defaults explicitly to avoid diffs with the live object
+		BackendObjectReference: gatewayv1.BackendObjectReference{
+			Group: ptr.To(gatewayv1.Group("")),
+			Kind:  ptr.To(gatewayv1.Kind("Service")),
+			Name:  gatewayv1.ObjectName(svc.GetName()),
+			Port:  ptr.To(gatewayv1.PortNumber(port)),
+		},
+		Weight: ptr.To[int32](1),
+	}
+
+	switch *spec.RouteType {
+	case saasv1alpha1.GRPCRouteType:
+		return &gatewayv1.GRPCRoute{
+			ObjectMeta: meta,
+			Spec: gatewayv1.GRPCRouteSpec{
+				CommonRouteSpec: common,
+				Hostnames:       hostnames(spec.Hostnames),
+				Rules:           []gatewayv1.GRPCRouteRule{{BackendRefs: []gatewayv1.GRPCBackendRef{{BackendRef: backend}}}},
+			},
+		}, nil
+
+	case saasv1alpha1.TLSRouteType:
+		return &gatewayv1alpha2.TLSRoute{
+			ObjectMeta: meta,
+			Spec: gatewayv1alpha2.TLSRouteSpec{
+				CommonRouteSpec: common,
+				Hostnames:       hostnames(spec.Hostnames),
+				Rules:           []gatewayv1alpha2.TLSRouteRule{{BackendRefs: []gatewayv1.BackendRef{backend}}},
+			},
+		}, nil
+
+	default:
+		return &gatewayv1.HTTPRoute{
+			ObjectMeta: meta,
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: common,
+				Hostnames:       hostnames(spec.Hostnames),
+				Rules: []gatewayv1.HTTPRouteRule{{
+					Matches:     pathMatches(spec.PathMatches),
+					BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: backend}},
+				}},
+			},
+		}, nil
+	}
+}
+
+// backendPort returns the given port if the Service has it,
+// or the first port of the Service if no port is given
+func backendPort(port *int32, svc *corev1.Service) (int32, error) {
+	if port != nil {
+		if !lo.ContainsBy(svc.Spec.Ports, func(p corev1.ServicePort) bool { return p.Port == *port }) {
+			return 0, fmt.Errorf("service %s has no port %d", svc.GetName(), *port)
+		}
+
+		return *port, nil
+	}
+
+	if len(svc.Spec.Ports) == 0 {
+		return 0, fmt.Errorf("service %s has no ports", svc.GetName())
+	}
+
+	return svc.Spec.Ports[0].Port, nil
+}
+
+func parentRefs(refs []saasv1alpha1.GatewayParentRef) []gatewayv1.ParentReference {
+	return lo.Map(refs, func(ref saasv1alpha1.GatewayParentRef, _ int) gatewayv1.ParentReference {
+		return gatewayv1.ParentReference{
+			Group:       ptr.To(gatewayv1.Group(gatewayv1.GroupVersion.Group)),
+			Kind:        ptr.To(gatewayv1.Kind("Gateway")),
+			Namespace:   (*gatewayv1.Namespace)(ref.Namespace),
+			Name:        gatewayv1.ObjectName(ref.Name),
+			SectionName: (*gatewayv1.SectionName)(ref.SectionName),
+		}
+	})
+}
+
+func hostnames(hosts []string) []gatewayv1.Hostname {
+	if len(hosts) == 0 {
+		return nil
+	}
+
+	return lo.Map(hosts, func(host string, _ int) gatewayv1.Hostname { return gatewayv1.Hostname(host) })
+}
+
+func pathMatches(matches []saasv1alpha1.GatewayAPIPathMatch) []gatewayv1.HTTPRouteMatch {
+	if len(matches) == 0 {
+		// match all paths, which is also the API default
+		return []gatewayv1.HTTPRouteMatch{{
+			Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchPathPrefix), Value: ptr.To("/")},
+		}}
+	}
+
+	return lo.Map(matches, func(m saasv1alpha1.GatewayAPIPathMatch, _ int) gatewayv1.HTTPRouteMatch {
+		return gatewayv1.HTTPRouteMatch{
+			Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchType(*m.Type)), Value: ptr.To(m.Value)},
+		}
+	})
+}
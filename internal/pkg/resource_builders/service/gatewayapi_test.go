@@ -0,0 +1,133 @@
+package service
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func TestServiceDescriptor_Route(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "apicast-production-gateway-svc"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "gateway-http", Port: 80}, {Name: "gateway-https", Port: 443}},
+		},
+	}
+	parentRefs := []gatewayv1.ParentReference{{
+		Group:       ptr.To(gatewayv1.Group("gateway.networking.k8s.io")),
+		Kind:        ptr.To(gatewayv1.Kind("Gateway")),
+		Namespace:   ptr.To(gatewayv1.Namespace("edge")),
+		Name:        "edge",
+		SectionName: ptr.To(gatewayv1.SectionName("https")),
+	}}
+	backendRef := func(port gatewayv1.PortNumber) gatewayv1.BackendRef {
+		return gatewayv1.BackendRef{
+			BackendObjectReference: gatewayv1.BackendObjectReference{
+				Group: ptr.To(gatewayv1.Group("")),
+				Kind:  ptr.To(gatewayv1.Kind("Service")),
+				Name:  "apicast-production-gateway-svc",
+				Port:  ptr.To(port),
+			},
+			Weight: ptr.To[int32](1),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		spec    saasv1alpha1.GatewayAPISpec
+		want    client.Object
+		wantErr bool
+	}{
+		{
+			name: "Generates a HTTPRoute",
+			spec: saasv1alpha1.GatewayAPISpec{
+				ParentRefs:  []saasv1alpha1.GatewayParentRef{{Name: "edge", Namespace: ptr.To("edge"), SectionName: ptr.To("https")}},
+				Hostnames:   []string{"api.example.com"},
+				PathMatches: []saasv1alpha1.GatewayAPIPathMatch{{Value: "/api"}, {Type: ptr.To(saasv1alpha1.ExactMatchType), Value: "/status"}},
+			},
+			want: &gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "apicast-production-gateway"},
+				Spec: gatewayv1.HTTPRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: parentRefs},
+					Hostnames:       []gatewayv1.Hostname{"api.example.com"},
+					Rules: []gatewayv1.HTTPRouteRule{{
+						Matches: []gatewayv1.HTTPRouteMatch{
+							{Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchPathPrefix), Value: ptr.To("/api")}},
+							{Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchExact), Value: ptr.To("/status")}},
+						},
+						BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: backendRef(80)}},
+					}},
+				},
+			},
+		},
+		{
+			name: "Generates a GRPCRoute",
+			spec: saasv1alpha1.GatewayAPISpec{
+				RouteType:   ptr.To(saasv1alpha1.GRPCRouteType),
+				ParentRefs:  []saasv1alpha1.GatewayParentRef{{Name: "edge", Namespace: ptr.To("edge"), SectionName: ptr.To("https")}},
+				BackendPort: ptr.To[int32](443),
+			},
+			want: &gatewayv1.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "apicast-production-gateway"},
+				Spec: gatewayv1.GRPCRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: parentRefs},
+					Rules:           []gatewayv1.GRPCRouteRule{{BackendRefs: []gatewayv1.GRPCBackendRef{{BackendRef: backendRef(443)}}}},
+				},
+			},
+		},
+		{
+			name: "Generates a TLSRoute",
+			spec: saasv1alpha1.GatewayAPISpec{
+				RouteType:   ptr.To(saasv1alpha1.TLSRouteType),
+				ParentRefs:  []saasv1alpha1.GatewayParentRef{{Name: "edge", Namespace: ptr.To("edge"), SectionName: ptr.To("https")}},
+				Hostnames:   []string{"api.example.com"},
+				BackendPort: ptr.To[int32](443),
+			},
+			want: &gatewayv1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "apicast-production-gateway"},
+				Spec: gatewayv1alpha2.TLSRouteSpec{
+					CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: parentRefs},
+					Hostnames:       []gatewayv1.Hostname{"api.example.com"},
+					Rules:           []gatewayv1alpha2.TLSRouteRule{{BackendRefs: []gatewayv1.BackendRef{backendRef(443)}}},
+				},
+			},
+		},
+		{
+			name: "Fails if the Service does not have the backend port",
+			spec: saasv1alpha1.GatewayAPISpec{
+				ParentRefs:  []saasv1alpha1.GatewayParentRef{{Name: "edge"}},
+				BackendPort: ptr.To[int32](8080),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sd := &ServiceDescriptor{
+				PublishingStrategy: saasv1alpha1.PublishingStrategy{
+					Strategy:     saasv1alpha1.GatewayAPIStrategy,
+					EndpointName: "Gateway",
+					GatewayAPI:   &tt.spec,
+				},
+			}
+
+			got, err := sd.Route("apicast-production", svc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceDescriptor.Route() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("ServiceDescriptor.Route() got diff %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Ingresses returns the resources that send the traffic of the endpoint to the given
+// Service: a single Ingress, or a Route for each hostname when Routes are used.
+// The defaultKind is used when the kind is not explicitly set, and an empty
+// defaultKind means Ingress.
+func (sd *ServiceDescriptor) Ingresses(prefix string, svc *corev1.Service, defaultKind saasv1alpha1.IngressKind) ([]client.Object, error) {
+	spec := sd.PublishingStrategy.Ingress
+	spec.Default()
+
+	port, err := backendPort(spec.BackendPort, svc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate ingress for endpoint %s: %w", sd.EndpointName, err)
+	}
+
+	name := fmt.Sprintf("%s-%s", prefix, strings.ToLower(sd.EndpointName))
+
+	if ptr.Deref(spec.Kind, defaultKind) == saasv1alpha1.IngressKindRoute {
+		if len(spec.ExternalDnsHostnames) == 0 {
+			return nil, fmt.Errorf("unable to generate route for endpoint %s: at least one hostname is required", sd.EndpointName)
+		}
+
+		routes := make([]client.Object, 0, len(spec.ExternalDnsHostnames))
+		for idx, host := range spec.ExternalDnsHostnames {
+			route := openShiftRoute(spec, svc, port, host)
+			route.SetName(name)
+			if idx > 0 {
+				route.SetName(fmt.Sprintf("%s-%d", name, idx))
+			}
+
+			routes = append(routes, route)
+		}
+
+		return routes, nil
+	}
+
+	ingress := kubernetesIngress(spec, svc, port)
+	ingress.SetName(name)
+
+	return []client.Object{ingress}, nil
+}
+
+func kubernetesIngress(spec *saasv1alpha1.IngressSpec, svc *corev1.Service, port int32) *networkingv1.Ingress {
+	annotations := ingressAnnotations(spec, saasv1alpha1.IngressKindIngress)
+
+	paths := []networkingv1.HTTPIngressPath{{
+		Path:     *spec.Path,
+		PathType: ptr.To(networkingv1.PathTypePrefix),
+		Backend: networkingv1.IngressBackend{
+			Service: &networkingv1.IngressServiceBackend{
+				Name: svc.GetName(),
+				Port: networkingv1.ServiceBackendPort{Number: port},
+			},
+		},
+	}}
+
+	rules := []networkingv1.IngressRule{}
+	if len(spec.ExternalDnsHostnames) == 0 {
+		rules = append(rules, networkingv1.IngressRule{
+			IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{Paths: paths}},
+		})
+	}
+
+	for _, host := range spec.ExternalDnsHostnames {
+		rules = append(rules, networkingv1.IngressRule{
+			Host:             host,
+			IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{Paths: paths}},
+		})
+	}
+
+	var tls []networkingv1.IngressTLS
+	if spec.TLS != nil {
+		tls = []networkingv1.IngressTLS{{Hosts: spec.ExternalDnsHostnames, SecretName: ptr.Deref(spec.TLS.SecretName, "")}}
+
+		// Ingresses are converted to Routes in OpenShift, where the termination
+		// can be configured with an annotation
+		if *spec.TLS.Termination != saasv1alpha1.TLSTerminationEdge {
+			annotations["route.openshift.io/termination"] = string(*spec.TLS.Termination)
+		}
+	}
+
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: spec.IngressClassName,
+			TLS:              tls,
+			Rules:            rules,
+		},
+	}
+}
+
+func openShiftRoute(spec *saasv1alpha1.IngressSpec, svc *corev1.Service, port int32, host string) *routev1.Route {
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{Annotations: ingressAnnotations(spec, saasv1alpha1.IngressKindRoute)},
+		Spec: routev1.RouteSpec{
+			Host: host,
+			Path: *spec.Path,
+			// set the API defaults explicitly to avoid diffs with the live object
+			To:             routev1.RouteTargetReference{Kind: "Service", Name: svc.GetName(), Weight: ptr.To[int32](100)},
+			Port:           &routev1.RoutePort{TargetPort: routeTargetPort(svc, port)},
+			WildcardPolicy: routev1.WildcardPolicyNone,
+		},
+	}
+
+	if spec.TLS != nil {
+		route.Spec.TLS = &routev1.TLSConfig{
+			Termination:                   routev1.TLSTerminationType(*spec.TLS.Termination),
+			InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyType(ptr.Deref(spec.TLS.InsecureEdgeTerminationPolicy, "")),
+		}
+
+		if *spec.TLS.Termination == saasv1alpha1.TLSTerminationReencrypt {
+			route.Spec.TLS.DestinationCACertificate = ptr.Deref(spec.TLS.DestinationCACertificate, "")
+		}
+
+		// passthrough Routes cannot match on the path as requests are not decrypted
+		if *spec.TLS.Termination == saasv1alpha1.TLSTerminationPassthrough {
+			route.Spec.Path = ""
+		}
+	}
+
+	return route
+}
+
+// routeTargetPort returns the name of the Service port, or its
+// target port if unnamed, as Routes expect one of those
+func routeTargetPort(svc *corev1.Service, port int32) intstr.IntOrString {
+	p, _ := lo.Find(svc.Spec.Ports, func(p corev1.ServicePort) bool { return p.Port == port })
+	if p.Name != "" {
+		return intstr.FromString(p.Name)
+	}
+
+	if p.TargetPort.String() != "0" {
+		return p.TargetPort
+	}
+
+	return intstr.FromInt32(port)
+}
+
+// ingressAnnotations returns the annotations of the generated resources. The timeout
+// is also set for NGINX in Ingresses, as they might not be served by OpenShift routers.
+func ingressAnnotations(spec *saasv1alpha1.IngressSpec, kind saasv1alpha1.IngressKind) map[string]string {
+	annotations := map[string]string{}
+
+	if spec.Timeout != nil {
+		seconds := strconv.Itoa(int(spec.Timeout.Seconds()))
+		annotations["haproxy.router.openshift.io/timeout"] = seconds + "s"
+
+		if kind == saasv1alpha1.IngressKindIngress {
+			annotations["nginx.ingress.kubernetes.io/proxy-read-timeout"] = seconds
+			annotations["nginx.ingress.kubernetes.io/proxy-send-timeout"] = seconds
+		}
+	}
+
+	for k, v := range spec.Annotations {
+		annotations[k] = v
+	}
+
+	return annotations
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestServiceDescriptor_Ingresses(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "mapping-service-http-svc"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "http", Port: 80}, {Port: 8443, TargetPort: intstr.FromInt32(9443)}},
+		},
+	}
+	paths := func(path string) *networkingv1.HTTPIngressRuleValue {
+		return &networkingv1.HTTPIngressRuleValue{Paths: []networkingv1.HTTPIngressPath{{
+			Path:     path,
+			PathType: ptr.To(networkingv1.PathTypePrefix),
+			Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+				Name: "mapping-service-http-svc",
+				Port: networkingv1.ServiceBackendPort{Number: 80},
+			}},
+		}}}
+	}
+	routeSpec := func(host string) routev1.RouteSpec {
+		return routev1.RouteSpec{
+			Host:           host,
+			Path:           "/",
+			To:             routev1.RouteTargetReference{Kind: "Service", Name: "mapping-service-http-svc", Weight: ptr.To[int32](100)},
+			Port:           &routev1.RoutePort{TargetPort: intstr.FromString("http")},
+			WildcardPolicy: routev1.WildcardPolicyNone,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		spec        saasv1alpha1.IngressSpec
+		defaultKind saasv1alpha1.IngressKind
+		want        []client.Object
+		wantErr     bool
+	}{
+		{
+			name: "Generates an Ingress by default",
+			spec: saasv1alpha1.IngressSpec{},
+			want: []client.Object{&networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "mapping-service-http", Annotations: map[string]string{}},
+				Spec: networkingv1.IngressSpec{
+					Rules: []networkingv1.IngressRule{{IngressRuleValue: networkingv1.IngressRuleValue{HTTP: paths("/")}}},
+				},
+			}},
+		},
+		{
+			name:        "Generates an Ingress with TLS and timeouts",
+			defaultKind: saasv1alpha1.IngressKindRoute,
+			spec: saasv1alpha1.IngressSpec{
+				Kind:                 ptr.To(saasv1alpha1.IngressKindIngress),
+				ExternalDnsHostnames: []string{"mapping.example.com", "mapping.example.net"},
+				IngressClassName:     ptr.To("nginx"),
+				Path:                 ptr.To("/api"),
+				TLS:                  &saasv1alpha1.IngressTLSSpec{Termination: ptr.To(saasv1alpha1.TLSTerminationReencrypt), SecretName: ptr.To("tls")},
+				Timeout:              &metav1.Duration{Duration: 2 * time.Minute},
+				Annotations:          map[string]string{"key": "value"},
+			},
+			want: []client.Object{&networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "mapping-service-http", Annotations: map[string]string{
+					"haproxy.router.openshift.io/timeout":            "120s",
+					"nginx.ingress.kubernetes.io/proxy-read-timeout": "120",
+					"nginx.ingress.kubernetes.io/proxy-send-timeout": "120",
+					"route.openshift.io/termination":                 "reencrypt",
+					"key":                                            "value",
+				}},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: ptr.To("nginx"),
+					TLS:              []networkingv1.IngressTLS{{Hosts: []string{"mapping.example.com", "mapping.example.net"}, SecretName: "tls"}},
+					Rules: []networkingv1.IngressRule{
+						{Host: "mapping.example.com", IngressRuleValue: networkingv1.IngressRuleValue{HTTP: paths("/api")}},
+						{Host: "mapping.example.net", IngressRuleValue: networkingv1.IngressRuleValue{HTTP: paths("/api")}},
+					},
+				},
+			}},
+		},
+		{
+			name: "Generates a Route for each hostname",
+			spec: saasv1alpha1.IngressSpec{
+				Kind:                 ptr.To(saasv1alpha1.IngressKindRoute),
+				ExternalDnsHostnames: []string{"mapping.example.com", "mapping.example.net"},
+				TLS:                  &saasv1alpha1.IngressTLSSpec{InsecureEdgeTerminationPolicy: ptr.To(saasv1alpha1.InsecureEdgeTerminationPolicyRedirect)},
+				Timeout:              &metav1.Duration{Duration: 30 * time.Second},
+			},
+			want: func() []client.Object {
+				tls := &routev1.TLSConfig{Termination: routev1.TLSTerminationEdge, InsecureEdgeTerminationPolicy: "Redirect"}
+				annotations := map[string]string{"haproxy.router.openshift.io/timeout": "30s"}
+				first, second := routeSpec("mapping.example.com"), routeSpec("mapping.example.net")
+				first.TLS, second.TLS = tls, tls
+
+				return []client.Object{
+					&routev1.Route{ObjectMeta: metav1.ObjectMeta{Name: "mapping-service-http", Annotations: annotations}, Spec: first},
+					&routev1.Route{ObjectMeta: metav1.ObjectMeta{Name: "mapping-service-http-1", Annotations: annotations}, Spec: second},
+				}
+			}(),
+		},
+		{
+			name:        "Generates a Route if it is the default kind",
+			spec:        saasv1alpha1.IngressSpec{ExternalDnsHostnames: []string{"mapping.example.com"}},
+			defaultKind: saasv1alpha1.IngressKindRoute,
+			want: []client.Object{
+				&routev1.Route{ObjectMeta: metav1.ObjectMeta{Name: "mapping-service-http", Annotations: map[string]string{}}, Spec: routeSpec("mapping.example.com")},
+			},
+		},
+		{
+			name: "Generates a passthrough Route",
+			spec: saasv1alpha1.IngressSpec{
+				Kind:                 ptr.To(saasv1alpha1.IngressKindRoute),
+				ExternalDnsHostnames: []string{"mapping.example.com"},
+				TLS:                  &saasv1alpha1.IngressTLSSpec{Termination: ptr.To(saasv1alpha1.TLSTerminationPassthrough)},
+				BackendPort:          ptr.To[int32](8443),
+			},
+			want: func() []client.Object {
+				spec := routeSpec("mapping.example.com")
+				spec.Path = ""
+				spec.Port = &routev1.RoutePort{TargetPort: intstr.FromInt32(9443)}
+				spec.TLS = &routev1.TLSConfig{Termination: routev1.TLSTerminationPassthrough}
+
+				return []client.Object{
+					&routev1.Route{ObjectMeta: metav1.ObjectMeta{Name: "mapping-service-http", Annotations: map[string]string{}}, Spec: spec},
+				}
+			}(),
+		},
+		{
+			name:    "Fails if a Route has no hostnames",
+			spec:    saasv1alpha1.IngressSpec{Kind: ptr.To(saasv1alpha1.IngressKindRoute)},
+			wantErr: true,
+		},
+		{
+			name:    "Fails if the Service does not have the backend port",
+			spec:    saasv1alpha1.IngressSpec{BackendPort: ptr.To[int32](8080)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sd := &ServiceDescriptor{
+				PublishingStrategy: saasv1alpha1.PublishingStrategy{
+					Strategy:     saasv1alpha1.IngressStrategy,
+					EndpointName: "HTTP",
+					Ingress:      &tt.spec,
+				},
+			}
+
+			got, err := sd.Ingresses("mapping-service", svc, tt.defaultKind)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServiceDescriptor.Ingresses() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("ServiceDescriptor.Ingresses() got diff %v", diff)
+			}
+		})
+	}
+}
@@ -107,8 +107,20 @@ func MergeWithDefaultPublishingStrategy(defaults []ServiceDescriptor, in *saasv1
 			switch i.PublishingStrategy.Strategy {
 			case saasv1alpha1.SimpleStrategy:
 				i.Marin3rSidecar = nil
+				i.GatewayAPI = nil
+				i.Ingress = nil
 			case saasv1alpha1.Marin3rSidecarStrategy:
 				i.Simple = nil
+				i.GatewayAPI = nil
+				i.Ingress = nil
+			case saasv1alpha1.GatewayAPIStrategy:
+				i.Simple = nil
+				i.Marin3rSidecar = nil
+				i.Ingress = nil
+			case saasv1alpha1.IngressStrategy:
+				i.Simple = nil
+				i.Marin3rSidecar = nil
+				i.GatewayAPI = nil
 			}
 
 			out[index] = i
@@ -109,6 +109,62 @@ func TestMergeWithDefaultPublishingStrategy(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Merge: migrates an endpoint to the GatewayAPI strategy",
+			args: args{
+				def: []ServiceDescriptor{
+					{
+						PublishingStrategy: saasv1alpha1.PublishingStrategy{
+							Strategy:     saasv1alpha1.SimpleStrategy,
+							EndpointName: "Gateway",
+							Simple:       &saasv1alpha1.Simple{ServiceType: ptr.To(saasv1alpha1.ServiceTypeNLB)},
+						},
+						PortDefinitions: []corev1.ServicePort{{Name: "gateway-http", Protocol: corev1.ProtocolTCP, Port: 80}},
+					},
+					{
+						PublishingStrategy: saasv1alpha1.PublishingStrategy{
+							Strategy:     saasv1alpha1.SimpleStrategy,
+							EndpointName: "Management",
+							Simple:       &saasv1alpha1.Simple{ServiceType: ptr.To(saasv1alpha1.ServiceTypeClusterIP)},
+						},
+						PortDefinitions: []corev1.ServicePort{{Name: "management", Protocol: corev1.ProtocolTCP, Port: 8090}},
+					},
+				},
+				in: &saasv1alpha1.PublishingStrategies{
+					Mode: ptr.To(saasv1alpha1.PublishingStrategiesReconcileModeMerge),
+					Endpoints: []saasv1alpha1.PublishingStrategy{{
+						Strategy:     saasv1alpha1.GatewayAPIStrategy,
+						EndpointName: "Gateway",
+						GatewayAPI: &saasv1alpha1.GatewayAPISpec{
+							ParentRefs: []saasv1alpha1.GatewayParentRef{{Name: "edge"}},
+							Hostnames:  []string{"example.com"},
+						},
+					}},
+				},
+			},
+			want: []ServiceDescriptor{
+				{
+					PublishingStrategy: saasv1alpha1.PublishingStrategy{
+						Strategy:     saasv1alpha1.GatewayAPIStrategy,
+						EndpointName: "Gateway",
+						GatewayAPI: &saasv1alpha1.GatewayAPISpec{
+							ParentRefs: []saasv1alpha1.GatewayParentRef{{Name: "edge"}},
+							Hostnames:  []string{"example.com"},
+						},
+					},
+					PortDefinitions: []corev1.ServicePort{{Name: "gateway-http", Protocol: corev1.ProtocolTCP, Port: 80}},
+				},
+				{
+					PublishingStrategy: saasv1alpha1.PublishingStrategy{
+						Strategy:     saasv1alpha1.SimpleStrategy,
+						EndpointName: "Management",
+						Simple:       &saasv1alpha1.Simple{ServiceType: ptr.To(saasv1alpha1.ServiceTypeClusterIP)},
+					},
+					PortDefinitions: []corev1.ServicePort{{Name: "management", Protocol: corev1.ProtocolTCP, Port: 8090}},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "Merge: modifies some parameters of the publishing strategy",
 			args: args{
@@ -67,6 +67,100 @@ func NLBServiceAnnotations(cfg saasv1alpha1.NetworkLoadBalancerSpec, hostnames [
 	return annotations
 }
 
+// AWSLoadBalancerServiceAnnotations returns annotations for services exposed through
+// NLBs managed by the aws-load-balancer-controller
+func AWSLoadBalancerServiceAnnotations(cfg saasv1alpha1.AWSLoadBalancerSpec, hostnames []string) map[string]string {
+	annotations := map[string]string{
+		"external-dns.alpha.kubernetes.io/hostname":                    strings.Join(hostnames, ","),
+		"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+		"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": string(*cfg.TargetType),
+		"service.beta.kubernetes.io/aws-load-balancer-scheme":          string(*cfg.Scheme),
+	}
+
+	if cfg.LoadBalancerName != nil {
+		annotations["service.beta.kubernetes.io/aws-load-balancer-name"] = *cfg.LoadBalancerName
+	}
+
+	if len(cfg.EIPAllocations) != 0 {
+		annotations["service.beta.kubernetes.io/aws-load-balancer-eip-allocations"] = strings.Join(cfg.EIPAllocations, ",")
+	}
+
+	if len(cfg.SecurityGroups) != 0 {
+		annotations["service.beta.kubernetes.io/aws-load-balancer-security-groups"] = strings.Join(cfg.SecurityGroups, ",")
+	}
+
+	if cfg.ManageBackendSecurityGroupRules != nil {
+		annotations["service.beta.kubernetes.io/aws-load-balancer-manage-backend-security-group-rules"] = strconv.FormatBool(*cfg.ManageBackendSecurityGroupRules)
+	}
+
+	if cfg.TLS != nil {
+		annotations["service.beta.kubernetes.io/aws-load-balancer-ssl-cert"] = strings.Join(cfg.TLS.CertificateARNs, ",")
+		annotations["service.beta.kubernetes.io/aws-load-balancer-ssl-ports"] = strings.Join(cfg.TLS.Ports, ",")
+
+		if cfg.TLS.SSLPolicy != nil {
+			annotations["service.beta.kubernetes.io/aws-load-balancer-ssl-negotiation-policy"] = *cfg.TLS.SSLPolicy
+		}
+	}
+
+	if hc := cfg.HealthCheck; hc != nil {
+		for key, value := range map[string]*string{
+			"protocol":            hc.Protocol,
+			"port":                hc.Port,
+			"path":                hc.Path,
+			"healthy-threshold":   itoa(hc.HealthyThreshold),
+			"unhealthy-threshold": itoa(hc.UnhealthyThreshold),
+			"interval":            itoa(hc.Interval),
+			"timeout":             itoa(hc.Timeout),
+			"success-codes":       hc.SuccessCodes,
+		} {
+			if value != nil {
+				annotations["service.beta.kubernetes.io/aws-load-balancer-healthcheck-"+key] = *value
+			}
+		}
+	}
+
+	attributes := []string{
+		"load_balancing.cross_zone.enabled=" + strconv.FormatBool(*cfg.CrossZoneLoadBalancingEnabled),
+		"deletion_protection.enabled=" + strconv.FormatBool(*cfg.DeletionProtection),
+	}
+
+	if cfg.AccessLogs != nil {
+		attributes = append(attributes, "access_logs.s3.enabled=true", "access_logs.s3.bucket="+cfg.AccessLogs.S3Bucket)
+		if cfg.AccessLogs.S3Prefix != nil {
+			attributes = append(attributes, "access_logs.s3.prefix="+*cfg.AccessLogs.S3Prefix)
+		}
+	}
+
+	annotations["service.beta.kubernetes.io/aws-load-balancer-attributes"] = strings.Join(attributes, ",")
+
+	tgAttributes := []string{
+		"proxy_protocol_v2.enabled=" + strconv.FormatBool(*cfg.ProxyProtocol),
+	}
+
+	if cfg.DeregistrationDelayTimeout != nil {
+		tgAttributes = append(tgAttributes, "deregistration_delay.timeout_seconds="+strconv.Itoa(int(*cfg.DeregistrationDelayTimeout)))
+	}
+
+	if cfg.PreserveClientIP != nil {
+		tgAttributes = append(tgAttributes, "preserve_client_ip.enabled="+strconv.FormatBool(*cfg.PreserveClientIP))
+	}
+
+	tgAttributes = append(tgAttributes, cfg.ExtraTargetGroupAttributes...)
+	annotations["service.beta.kubernetes.io/aws-load-balancer-target-group-attributes"] = strings.Join(tgAttributes, ",")
+
+	return annotations
+}
+
+func itoa(i *int32) *string {
+	if i == nil {
+		return nil
+	}
+
+	s := strconv.Itoa(int(*i))
+
+	return &s
+}
+
 // TCPPort returns a TCP corev1.ServicePort
 func TCPPort(name string, port int32, targetPort intstr.IntOrString) corev1.ServicePort {
 	return corev1.ServicePort{
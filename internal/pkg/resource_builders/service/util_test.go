@@ -0,0 +1,110 @@
+package service
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+)
+
+func TestAWSLoadBalancerServiceAnnotations(t *testing.T) {
+	type args struct {
+		cfg       *saasv1alpha1.AWSLoadBalancerSpec
+		hostnames []string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want map[string]string
+	}{
+		{
+			name: "Returns the default annotations",
+			args: args{
+				cfg:       &saasv1alpha1.AWSLoadBalancerSpec{},
+				hostnames: []string{"example.com", "www.example.com"},
+			},
+			want: map[string]string{
+				"external-dns.alpha.kubernetes.io/hostname":                            "example.com,www.example.com",
+				"service.beta.kubernetes.io/aws-load-balancer-type":                    "external",
+				"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type":         "ip",
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                  "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-attributes":              "load_balancing.cross_zone.enabled=true,deletion_protection.enabled=false",
+				"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": "proxy_protocol_v2.enabled=true",
+			},
+		},
+		{
+			name: "Returns annotations for all the options",
+			args: args{
+				cfg: &saasv1alpha1.AWSLoadBalancerSpec{
+					TargetType:                      ptr.To(saasv1alpha1.AWSLoadBalancerTargetTypeInstance),
+					Scheme:                          ptr.To(saasv1alpha1.AWSLoadBalancerSchemeInternal),
+					ProxyProtocol:                   ptr.To(false),
+					DeletionProtection:              ptr.To(true),
+					LoadBalancerName:                ptr.To("my-lb"),
+					EIPAllocations:                  []string{"eipalloc-1", "eipalloc-2"},
+					SecurityGroups:                  []string{"sg-1"},
+					ManageBackendSecurityGroupRules: ptr.To(true),
+					TLS: &saasv1alpha1.AWSLoadBalancerTLSSpec{
+						CertificateARNs: []string{"arn:cert1", "arn:cert2"},
+						Ports:           []string{"443"},
+						SSLPolicy:       ptr.To("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+					},
+					AccessLogs: &saasv1alpha1.AWSLoadBalancerAccessLogsSpec{
+						S3Bucket: "logs",
+						S3Prefix: ptr.To("nlb"),
+					},
+					HealthCheck: &saasv1alpha1.AWSLoadBalancerHealthCheckSpec{
+						Protocol:           ptr.To("HTTP"),
+						Port:               ptr.To("traffic-port"),
+						Path:               ptr.To("/healthz"),
+						HealthyThreshold:   ptr.To[int32](2),
+						UnhealthyThreshold: ptr.To[int32](3),
+						Interval:           ptr.To[int32](10),
+						Timeout:            ptr.To[int32](6),
+						SuccessCodes:       ptr.To("200-399"),
+					},
+					DeregistrationDelayTimeout: ptr.To[int32](60),
+					PreserveClientIP:           ptr.To(false),
+					ExtraTargetGroupAttributes: []string{"stickiness.enabled=true"},
+				},
+				hostnames: []string{"example.com"},
+			},
+			want: map[string]string{
+				"external-dns.alpha.kubernetes.io/hostname":                                        "example.com",
+				"service.beta.kubernetes.io/aws-load-balancer-type":                                "external",
+				"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type":                     "instance",
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                              "internal",
+				"service.beta.kubernetes.io/aws-load-balancer-name":                                "my-lb",
+				"service.beta.kubernetes.io/aws-load-balancer-eip-allocations":                     "eipalloc-1,eipalloc-2",
+				"service.beta.kubernetes.io/aws-load-balancer-security-groups":                     "sg-1",
+				"service.beta.kubernetes.io/aws-load-balancer-manage-backend-security-group-rules": "true",
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-cert":                            "arn:cert1,arn:cert2",
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-ports":                           "443",
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-negotiation-policy":              "ELBSecurityPolicy-TLS13-1-2-2021-06",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-protocol":                "HTTP",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-port":                    "traffic-port",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-path":                    "/healthz",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-healthy-threshold":       "2",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-unhealthy-threshold":     "3",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-interval":                "10",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-timeout":                 "6",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-success-codes":           "200-399",
+				"service.beta.kubernetes.io/aws-load-balancer-attributes": "load_balancing.cross_zone.enabled=true,deletion_protection.enabled=true," +
+					"access_logs.s3.enabled=true,access_logs.s3.bucket=logs,access_logs.s3.prefix=nlb",
+				"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": "proxy_protocol_v2.enabled=false," +
+					"deregistration_delay.timeout_seconds=60,preserve_client_ip.enabled=false,stickiness.enabled=true",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := saasv1alpha1.InitializeAWSLoadBalancerSpec(tt.args.cfg, saasv1alpha1.DefaultAWSLoadBalancerSpec)
+			got := AWSLoadBalancerServiceAnnotations(*cfg, tt.args.hostnames)
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("AWSLoadBalancerServiceAnnotations() got diff %v", diff)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"k8s.io/utils/ptr"
 )
 
 const (
@@ -65,12 +66,18 @@ type ServerPoolConfig struct {
 	Backlog            int      `json:"backlog,omitempty"`
 	PreConnect         bool     `json:"preconnect"`
 	Redis              bool     `json:"redis"`
+	RedisAuth          string   `json:"redis_auth,omitempty"`
+	RedisDB            int      `json:"redis_db,omitempty"`
 	AutoEjectHosts     bool     `json:"auto_eject_hosts"`
+	ServerRetryTimeout int      `json:"server_retry_timeout,omitempty"`
 	ServerFailureLimit int      `json:"server_failure_limit,omitempty"`
 	Servers            []Server `json:"servers"`
 }
 
-func GenerateServerPool(pool saasv1alpha1.TwemproxyServerPool, targets map[string]Server) ServerPoolConfig {
+// GenerateServerPool returns the nutcracker config for the given server pool. The
+// redisAuth param is the password to authenticate to the redis servers, resolved from
+// the Secret referenced in the server pool options (empty if no auth is required).
+func GenerateServerPool(pool saasv1alpha1.TwemproxyServerPool, targets map[string]Server, redisAuth string) ServerPoolConfig {
 	servers := make([]Server, 0, len(pool.Topology))
 
 	for _, s := range pool.Topology {
@@ -80,12 +87,17 @@ func GenerateServerPool(pool saasv1alpha1.TwemproxyServerPool, targets map[strin
 	}
 
 	return ServerPoolConfig{
-		// The following parameters cannot be changed
-		Hash:           "fnv1a_64",
-		HashTag:        "{}",
-		Distribution:   "ketama",
-		AutoEjectHosts: false,
-		Redis:          true,
+		Redis: true,
+		// The following parameters can be tuned through the server pool options. Changing
+		// the hash, hash_tag or distribution will change the key to server mapping though.
+		Hash:               *pool.Hash,
+		HashTag:            *pool.HashTag,
+		Distribution:       *pool.Distribution,
+		AutoEjectHosts:     *pool.AutoEjectHosts,
+		ServerRetryTimeout: int(ptr.Deref(pool.ServerRetryTimeout, 0)),
+		ServerFailureLimit: int(ptr.Deref(pool.ServerFailureLimit, 0)),
+		RedisAuth:          redisAuth,
+		RedisDB:            int(ptr.Deref(pool.RedisDB, 0)),
 		// The following parameters could be safely modified or exposed in the CR
 		Listen:     pool.BindAddress,
 		Backlog:    pool.TCPBacklog,
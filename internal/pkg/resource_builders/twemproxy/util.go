@@ -1,14 +1,19 @@
 package twemproxy
 
 import (
+	"context"
 	"path/filepath"
 
+	"github.com/3scale-sre/basereconciler/resource"
 	"github.com/3scale-sre/basereconciler/util"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/pod"
 	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -65,7 +70,7 @@ func AddTwemproxySidecar(podTemplateSpec corev1.PodTemplateSpec, twemproxySpec *
 	podTemplateSpec.ObjectMeta.Labels = util.MergeMaps(
 		map[string]string{},
 		podTemplateSpec.GetLabels(),
-		map[string]string{saasv1alpha1.TwemproxyPodSyncLabelKey: twemproxySpec.TwemproxyConfigRef},
+		map[string]string{saasv1alpha1.TwemproxyPodSyncLabelKey: twemproxySpec.TwemproxyConfigName()},
 	)
 
 	// Twemproxy container
@@ -104,3 +109,53 @@ func AddTwemproxyTaskSidecar(taskSpec pipelinev1.TaskSpec, twemproxySpec *saasv1
 
 	return taskSpec
 }
+
+// DerivedTwemproxyConfigs returns the templates of the TwemproxyConfig resources required by
+// the passed TwemproxySpecs. A TwemproxySpec requires its own copy of the referenced TwemproxyConfig
+// when it overrides the options of any server pool. Specs with the same overrides share the copy.
+func DerivedTwemproxyConfigs(namespace string, labels map[string]string,
+	twemproxySpecs ...*saasv1alpha1.TwemproxySpec) []resource.TemplateInterface {
+	templates := []resource.TemplateInterface{}
+	seen := map[string]bool{}
+
+	for _, spec := range twemproxySpecs {
+		if spec == nil || len(spec.ServerPoolOverrides) == 0 || seen[spec.TwemproxyConfigName()] {
+			continue
+		}
+
+		seen[spec.TwemproxyConfigName()] = true
+		templates = append(templates, resource.NewTemplateFromObjectFunction(
+			func() *saasv1alpha1.TwemproxyConfig {
+				return &saasv1alpha1.TwemproxyConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      spec.TwemproxyConfigName(),
+						Namespace: namespace,
+						Labels:    labels,
+					},
+				}
+			}).
+			WithMutation(derivedTwemproxyConfigSpec(spec)),
+		)
+	}
+
+	return templates
+}
+
+// derivedTwemproxyConfigSpec returns a mutation that populates the spec of a derived
+// TwemproxyConfig from the spec of the referenced one, applying the server pool overrides.
+// The GrafanaDashboard is disabled in the derived TwemproxyConfig as the referenced one
+// already has it.
+func derivedTwemproxyConfigSpec(twemproxySpec *saasv1alpha1.TwemproxySpec) resource.TemplateMutationFunction {
+	return func(ctx context.Context, cl client.Client, desired client.Object) error {
+		base := &saasv1alpha1.TwemproxyConfig{}
+		if err := cl.Get(ctx, types.NamespacedName{Name: twemproxySpec.TwemproxyConfigRef, Namespace: desired.GetNamespace()}, base); err != nil {
+			return err
+		}
+
+		spec := twemproxySpec.ApplyServerPoolOverrides(base.Spec)
+		spec.GrafanaDashboard = &saasv1alpha1.GrafanaDashboardSpec{}
+		desired.(*saasv1alpha1.TwemproxyConfig).Spec = spec
+
+		return nil
+	}
+}
@@ -1,6 +1,7 @@
 package twemproxy
 
 import (
+	"context"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -12,7 +13,9 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func Test_AddTwemproxySidecar(t *testing.T) {
@@ -155,3 +158,41 @@ func Test_AddTwemproxySidecar(t *testing.T) {
 		})
 	}
 }
+
+func Test_derivedTwemproxyConfigSpec(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = saasv1alpha1.AddToScheme(scheme)
+
+	base := &saasv1alpha1.TwemproxyConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "ns"},
+		Spec: saasv1alpha1.TwemproxyConfigSpec{
+			ServerPools: []saasv1alpha1.TwemproxyServerPool{{Name: "pool"}},
+			GrafanaDashboard: &saasv1alpha1.GrafanaDashboardSpec{
+				SelectorKey:   ptr.To("monitoring-key"),
+				SelectorValue: ptr.To("middleware"),
+			},
+		},
+	}
+	spec := &saasv1alpha1.TwemproxySpec{
+		TwemproxyConfigRef: "config",
+		ServerPoolOverrides: []saasv1alpha1.TwemproxyServerPoolOverride{{
+			Name:                       "pool",
+			TwemproxyServerPoolOptions: saasv1alpha1.TwemproxyServerPoolOptions{ServerRetryTimeout: ptr.To[int32](100)},
+		}},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(base).Build()
+	got := &saasv1alpha1.TwemproxyConfig{ObjectMeta: metav1.ObjectMeta{Name: "config-derived", Namespace: "ns"}}
+
+	if err := derivedTwemproxyConfigSpec(spec)(context.TODO(), cl, got); err != nil {
+		t.Fatalf("derivedTwemproxyConfigSpec() error = %v", err)
+	}
+
+	if !got.Spec.GrafanaDashboard.IsDeactivated() {
+		t.Errorf("derived TwemproxyConfig has the GrafanaDashboard enabled")
+	}
+
+	if *got.Spec.ServerPools[0].ServerRetryTimeout != 100 {
+		t.Errorf("derived TwemproxyConfig ServerRetryTimeout = %v, want 100", *got.Spec.ServerPools[0].ServerRetryTimeout)
+	}
+}
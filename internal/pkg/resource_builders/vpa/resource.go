@@ -0,0 +1,82 @@
+package vpa
+
+import (
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	vpav1 "github.com/3scale-sre/saas-operator/internal/pkg/vpa/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// New returns a basereconciler_types.GeneratorFunction function that will return a
+// VerticalPodAutoscaler resource when called
+func New(key types.NamespacedName, labels map[string]string, cfg saasv1alpha1.VerticalPodAutoscalerSpec) func(client.Object) (*vpav1.VerticalPodAutoscaler, error) {
+	return func(client.Object) (*vpav1.VerticalPodAutoscaler, error) {
+		vpa := &vpav1.VerticalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    labels,
+			},
+			Spec: vpav1.VerticalPodAutoscalerSpec{
+				TargetRef: &autoscalingv1.CrossVersionObjectReference{
+					APIVersion: appsv1.SchemeGroupVersion.String(),
+					Kind:       "Deployment",
+					Name:       key.Name,
+				},
+				UpdatePolicy: &vpav1.PodUpdatePolicy{
+					UpdateMode: ptr.To(vpav1.UpdateMode(ptr.Deref(cfg.UpdateMode, saasv1alpha1.VPAUpdateModeOff))),
+				},
+			},
+		}
+
+		if cfg.MinAllowed != nil || cfg.MaxAllowed != nil || cfg.ControlledResources != nil {
+			vpa.Spec.ResourcePolicy = &vpav1.PodResourcePolicy{
+				ContainerPolicies: []vpav1.ContainerResourcePolicy{{
+					// the policy applies to all the containers of the pod
+					ContainerName:       "*",
+					MinAllowed:          cfg.MinAllowed,
+					MaxAllowed:          cfg.MaxAllowed,
+					ControlledResources: cfg.ControlledResources,
+				}},
+			}
+		}
+
+		return vpa, nil
+	}
+}
+
+// Recommendations returns the recommendations of the VerticalPodAutoscaler for each
+// of the containers of the Deployment, next to their configured resource requests
+func Recommendations(dep *appsv1.Deployment, vpa *vpav1.VerticalPodAutoscaler) []saasv1alpha1.ContainerResourceRecommendation {
+	if vpa.Status.Recommendation == nil {
+		return nil
+	}
+
+	recommended := map[string]vpav1.RecommendedContainerResources{}
+	for _, r := range vpa.Status.Recommendation.ContainerRecommendations {
+		recommended[r.ContainerName] = r
+	}
+
+	list := []saasv1alpha1.ContainerResourceRecommendation{}
+
+	for _, c := range dep.Spec.Template.Spec.Containers {
+		r, ok := recommended[c.Name]
+		if !ok {
+			continue
+		}
+
+		list = append(list, saasv1alpha1.ContainerResourceRecommendation{
+			Container:  c.Name,
+			Requests:   c.Resources.Requests,
+			Target:     r.Target,
+			LowerBound: r.LowerBound,
+			UpperBound: r.UpperBound,
+		})
+	}
+
+	return list
+}
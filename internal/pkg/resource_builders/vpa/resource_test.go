@@ -0,0 +1,129 @@
+package vpa
+
+import (
+	"testing"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	vpav1 "github.com/3scale-sre/saas-operator/internal/pkg/vpa/v1"
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestNew(t *testing.T) {
+	key := types.NamespacedName{Name: "backend-listener", Namespace: "ns"}
+	targetRef := &autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "backend-listener"}
+
+	tests := []struct {
+		name string
+		cfg  saasv1alpha1.VerticalPodAutoscalerSpec
+		want *vpav1.VerticalPodAutoscaler
+	}{
+		{
+			name: "Recommendation only",
+			cfg:  saasv1alpha1.VerticalPodAutoscalerSpec{UpdateMode: ptr.To(saasv1alpha1.VPAUpdateModeOff)},
+			want: &vpav1.VerticalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "backend-listener", Namespace: "ns"},
+				Spec: vpav1.VerticalPodAutoscalerSpec{
+					TargetRef:    targetRef,
+					UpdatePolicy: &vpav1.PodUpdatePolicy{UpdateMode: ptr.To(vpav1.UpdateMode("Off"))},
+				},
+			},
+		},
+		{
+			name: "Auto mode with limits",
+			cfg: saasv1alpha1.VerticalPodAutoscalerSpec{
+				UpdateMode:          ptr.To(saasv1alpha1.VPAUpdateModeAuto),
+				MinAllowed:          corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+				MaxAllowed:          corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				ControlledResources: []corev1.ResourceName{corev1.ResourceMemory},
+			},
+			want: &vpav1.VerticalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "backend-listener", Namespace: "ns"},
+				Spec: vpav1.VerticalPodAutoscalerSpec{
+					TargetRef:    targetRef,
+					UpdatePolicy: &vpav1.PodUpdatePolicy{UpdateMode: ptr.To(vpav1.UpdateMode("Auto"))},
+					ResourcePolicy: &vpav1.PodResourcePolicy{
+						ContainerPolicies: []vpav1.ContainerResourcePolicy{{
+							ContainerName:       "*",
+							MinAllowed:          corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+							MaxAllowed:          corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+							ControlledResources: []corev1.ResourceName{corev1.ResourceMemory},
+						}},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(key, nil, tt.cfg)(nil)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("New() got diff %v", diff)
+			}
+		})
+	}
+}
+
+func TestRecommendations(t *testing.T) {
+	requests := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")}
+	target := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m")}
+	lower := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}
+	upper := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}
+
+	dep := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "listener", Resources: corev1.ResourceRequirements{Requests: requests}},
+						{Name: "envoy-sidecar"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		vpa  *vpav1.VerticalPodAutoscaler
+		want []saasv1alpha1.ContainerResourceRecommendation
+	}{
+		{
+			name: "No recommendations yet",
+			vpa:  &vpav1.VerticalPodAutoscaler{},
+			want: nil,
+		},
+		{
+			name: "Reports the recommendations next to the requests",
+			vpa: &vpav1.VerticalPodAutoscaler{
+				Status: vpav1.VerticalPodAutoscalerStatus{
+					Recommendation: &vpav1.RecommendedPodResources{
+						ContainerRecommendations: []vpav1.RecommendedContainerResources{
+							{ContainerName: "listener", Target: target, LowerBound: lower, UpperBound: upper},
+						},
+					},
+				},
+			},
+			want: []saasv1alpha1.ContainerResourceRecommendation{
+				{Container: "listener", Requests: requests, Target: target, LowerBound: lower, UpperBound: upper},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Recommendations(dep, tt.vpa)
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("Recommendations() got diff %v", diff)
+			}
+		})
+	}
+}
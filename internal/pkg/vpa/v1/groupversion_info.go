@@ -0,0 +1,32 @@
+// Package v1 contains the subset of the Kubernetes autoscaler autoscaling.k8s.io/v1
+// types that the operator generates. Only the fields used by the operator are defined,
+// which avoids depending on the whole vertical-pod-autoscaler module:
+//
+//   - VerticalPodAutoscaler: spec.targetRef, spec.updatePolicy.updateMode, the
+//     containerName, minAllowed, maxAllowed and controlledResources of
+//     spec.resourcePolicy.containerPolicies, and the recommendation and
+//     conditions of the status
+//
+// Any other field is dropped when an object is read into these types, so the objects
+// must never be written with a full update, which would remove the fields set by
+// others. The operator reconciles them with merge patches, which only contain the
+// modelled fields that have changed.
+// +kubebuilder:object:generate=true
+// +kubebuilder:skip
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is group version used to register these objects
+	GroupVersion = schema.GroupVersion{Group: "autoscaling.k8s.io", Version: "v1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
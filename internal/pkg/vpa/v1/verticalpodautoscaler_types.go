@@ -0,0 +1,93 @@
+package v1
+
+import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpdateMode controls when autoscaler applies changes to the pod resources
+type UpdateMode string
+
+// PodUpdatePolicy describes the rules on how changes are applied to the pods
+type PodUpdatePolicy struct {
+	UpdateMode *UpdateMode `json:"updateMode,omitempty"`
+}
+
+// ContainerResourcePolicy controls how autoscaler computes the recommended
+// resources for a specific container
+type ContainerResourcePolicy struct {
+	ContainerName       string                `json:"containerName,omitempty"`
+	MinAllowed          corev1.ResourceList   `json:"minAllowed,omitempty"`
+	MaxAllowed          corev1.ResourceList   `json:"maxAllowed,omitempty"`
+	ControlledResources []corev1.ResourceName `json:"controlledResources,omitempty"`
+}
+
+// PodResourcePolicy controls how autoscaler computes the recommended resources
+// for containers belonging to the pod
+type PodResourcePolicy struct {
+	ContainerPolicies []ContainerResourcePolicy `json:"containerPolicies,omitempty"`
+}
+
+// VerticalPodAutoscalerSpec is the specification of the behavior of the autoscaler
+type VerticalPodAutoscalerSpec struct {
+	TargetRef      *autoscalingv1.CrossVersionObjectReference `json:"targetRef"`
+	UpdatePolicy   *PodUpdatePolicy                           `json:"updatePolicy,omitempty"`
+	ResourcePolicy *PodResourcePolicy                         `json:"resourcePolicy,omitempty"`
+}
+
+// RecommendedContainerResources is the recommendation of resources computed by
+// autoscaler for a specific container
+type RecommendedContainerResources struct {
+	ContainerName  string              `json:"containerName,omitempty"`
+	Target         corev1.ResourceList `json:"target"`
+	LowerBound     corev1.ResourceList `json:"lowerBound,omitempty"`
+	UpperBound     corev1.ResourceList `json:"upperBound,omitempty"`
+	UncappedTarget corev1.ResourceList `json:"uncappedTarget,omitempty"`
+}
+
+// RecommendedPodResources is the recommendation of resources computed by
+// autoscaler for the containers of a pod
+type RecommendedPodResources struct {
+	ContainerRecommendations []RecommendedContainerResources `json:"containerRecommendations,omitempty"`
+}
+
+// VerticalPodAutoscalerCondition describes the state of a VerticalPodAutoscaler
+type VerticalPodAutoscalerCondition struct {
+	Type               string                 `json:"type"`
+	Status             corev1.ConditionStatus `json:"status"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime,omitempty"`
+	Reason             string                 `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+}
+
+// VerticalPodAutoscalerStatus describes the runtime state of the autoscaler
+type VerticalPodAutoscalerStatus struct {
+	Recommendation *RecommendedPodResources         `json:"recommendation,omitempty"`
+	Conditions     []VerticalPodAutoscalerCondition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VerticalPodAutoscaler is the configuration for a vertical pod autoscaler, which
+// automatically manages pod resources based on historical and real time resource utilization
+type VerticalPodAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VerticalPodAutoscalerSpec   `json:"spec"`
+	Status VerticalPodAutoscalerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VerticalPodAutoscalerList is a list of VerticalPodAutoscaler objects
+type VerticalPodAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VerticalPodAutoscaler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VerticalPodAutoscaler{}, &VerticalPodAutoscalerList{})
+}
@@ -2,37 +2,56 @@ package deployment
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/3scale-sre/basereconciler/mutators"
 	"github.com/3scale-sre/basereconciler/resource"
 	"github.com/3scale-sre/basereconciler/util"
 	marin3rv1alpha1 "github.com/3scale-sre/marin3r/api/marin3r/v1alpha1"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	kedav1alpha1 "github.com/3scale-sre/saas-operator/internal/pkg/keda/v1alpha1"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/envoyconfig"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/envoyconfig/factory"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/hpa"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/keda"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/pdb"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/podmonitor"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/service"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/vpa"
+	vpav1 "github.com/3scale-sre/saas-operator/internal/pkg/vpa/v1"
+	routev1 "github.com/openshift/api/route/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/samber/lo"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
-func New(main DeploymentWorkload, canary DeploymentWorkload) ([]resource.TemplateInterface, error) {
-	resources := workloadResources(main)
+func New(main DeploymentWorkload, canary DeploymentWorkload, opts Options) ([]resource.TemplateInterface, error) {
+	resources := workloadResources(main, opts)
 
 	if !lo.IsNil(canary) {
-		resources = append(resources, workloadResources(canary)...)
+		resources = append(resources, workloadResources(canary, opts)...)
+	}
+
+	// An aborted canary is kept scaled to zero and does not receive traffic
+	live := canary
+	if aborted(canary) {
+		live = nil
 	}
 
 	services := []*resource.Template[*corev1.Service]{}
+	split := trafficSplit(main, live)
+	upstreams := false
 
 	// Generate resources to implement the desired publishing strategies
 	if _, ok := main.(WithPublishingStrategies); ok {
@@ -47,29 +66,82 @@ func New(main DeploymentWorkload, canary DeploymentWorkload) ([]resource.Templat
 			case saasv1alpha1.SimpleStrategy:
 				services = append(services,
 					resource.NewTemplateFromObjectFunction(func() *corev1.Service { return descriptor.Service(main.GetKey().Name, "svc") }).
-						WithMutation(mutators.SetServiceLiveValues()),
+						WithMutation(mutators.SetServiceLiveValues()).
+						Apply(trafficSelectorToService(main.(WithCanary), toWithCanaryOrNil(live))),
+				)
+
+			case saasv1alpha1.GatewayAPIStrategy:
+				if descriptor.GatewayAPI == nil {
+					return nil, errors.New("GatewayAPISpec is missing, can't implement strategy without it")
+				}
+
+				svc := descriptor.Service(main.GetKey().Name, "svc")
+				services = append(services,
+					resource.NewTemplateFromObjectFunction(func() *corev1.Service { return svc.DeepCopy() }).
+						WithMutation(mutators.SetServiceLiveValues()).
+						Apply(trafficSelectorToService(main.(WithCanary), toWithCanaryOrNil(live))),
+				)
+
+				route, err := descriptor.Route(main.GetKey().Name, svc)
+				if err != nil {
+					return nil, err
+				}
+
+				resources = append(resources, routeTemplate(main, route))
+
+			case saasv1alpha1.IngressStrategy:
+				if descriptor.Ingress == nil {
+					return nil, errors.New("IngressSpec is missing, can't implement strategy without it")
+				}
+
+				svc := descriptor.Service(main.GetKey().Name, "svc")
+				services = append(services,
+					resource.NewTemplateFromObjectFunction(func() *corev1.Service { return svc.DeepCopy() }).
+						WithMutation(mutators.SetServiceLiveValues()).
+						Apply(trafficSelectorToService(main.(WithCanary), toWithCanaryOrNil(live))),
 				)
 
+				ingresses, err := descriptor.Ingresses(main.GetKey().Name, svc, opts.DefaultIngressKind)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, ingress := range ingresses {
+					resources = append(resources, routeTemplate(main, ingress))
+				}
+
 			case saasv1alpha1.Marin3rSidecarStrategy:
 				if descriptor.Marin3rSidecar == nil {
 					return nil, errors.New("Marin3rSidecarSpec is missing, can't implement strategy without it")
 				}
 
-				services = append(services,
-					resource.NewTemplateFromObjectFunction(func() *corev1.Service { return descriptor.Service(main.GetKey().Name, "marin3r") }).
-						WithMutation(mutators.SetServiceLiveValues()),
-				)
+				svct := resource.NewTemplateFromObjectFunction(func() *corev1.Service { return descriptor.Service(main.GetKey().Name, "marin3r") }).
+					WithMutation(mutators.SetServiceLiveValues())
+				if split != nil {
+					// When the traffic is split by weight the envoy sidecars of the main Deployment
+					// send the requests to the canary, so only the main pods receive traffic
+					svct.Apply(weightedTrafficSelectorToService(main.(WithCanary)))
+					upstreams = true
+				} else {
+					svct.Apply(trafficSelectorToService(main.(WithCanary), toWithCanaryOrNil(live)))
+				}
+				services = append(services, svct)
 
 				// Add Marin3r sidecar to Deployment
 				for _, deployment := range resource.ExtractGVK[*appsv1.Deployment](resources) {
 					deployment.Apply(marin3rSidecarToDeployment(descriptor))
 				}
 
-				// Add EnvoyConfig resource
+				// Add EnvoyConfig resource. The envoy config is validated before returning
+				// the list of resources so nothing gets published if it is invalid.
 				dynamicConfigurations := descriptor.Marin3rSidecar.EnvoyDynamicConfig.AsList()
+				if err := envoyconfig.Validate(factory.Default(), split, dynamicConfigurations...); err != nil {
+					return nil, err
+				}
+
 				resources = append(resources,
 					resource.NewTemplate(
-						envoyconfig.New(EmptyKey, EmptyKey.Name, factory.Default(), dynamicConfigurations...)).
+						envoyconfig.NewWithTrafficSplit(EmptyKey, EmptyKey.Name, factory.Default(), split, dynamicConfigurations...)).
 						WithEnabled(len(dynamicConfigurations) > 0).
 						Apply(meta[*marin3rv1alpha1.EnvoyConfig](main)).
 						Apply(nodeIdToEnvoyConfig(descriptor)),
@@ -78,30 +150,105 @@ func New(main DeploymentWorkload, canary DeploymentWorkload) ([]resource.Templat
 		}
 	}
 
-	// Apply traffic routing logic (canary yes/no)
 	for _, svct := range services {
-		resources = append(resources,
-			svct.Apply(meta[*corev1.Service](main)).
-				Apply(trafficSelectorToService(main.(WithCanary), toWithCanaryOrNil(canary))),
-		)
+		resources = append(resources, svct.Apply(meta[*corev1.Service](main)))
+	}
+
+	// Headless Services used by the envoy sidecars to reach
+	// the main and the canary pods when traffic is split by weight
+	if upstreams {
+		for _, w := range []DeploymentWorkload{main, canary} {
+			resources = append(resources,
+				resource.NewTemplateFromObjectFunction(func() *corev1.Service { return upstreamService(w) }).
+					Apply(meta[*corev1.Service](w)),
+			)
+		}
 	}
 
 	return resources, nil
 }
 
+// routeTemplate returns the template for the given Gateway API route,
+// OpenShift Route or Ingress. The vendored OpenShift types lack the fields
+// added by later OpenShift releases, so Routes are reconciled with patches
+// that leave those fields untouched.
+func routeTemplate(main DeploymentWorkload, route client.Object) resource.TemplateInterface {
+	switch o := route.(type) {
+	case *networkingv1.Ingress:
+		return resource.NewTemplateFromObjectFunction(func() *networkingv1.Ingress { return o }).
+			Apply(meta[*networkingv1.Ingress](main))
+	case *routev1.Route:
+		return resource.NewTemplateFromObjectFunction(func() *routev1.Route { return o }).
+			WithModifyOp(resource.ModifyOpPatch).
+			Apply(meta[*routev1.Route](main))
+	case *gatewayv1.GRPCRoute:
+		return resource.NewTemplateFromObjectFunction(func() *gatewayv1.GRPCRoute { return o }).
+			Apply(meta[*gatewayv1.GRPCRoute](main))
+	case *gatewayv1alpha2.TLSRoute:
+		return resource.NewTemplateFromObjectFunction(func() *gatewayv1alpha2.TLSRoute { return o }).
+			Apply(meta[*gatewayv1alpha2.TLSRoute](main))
+	default:
+		return resource.NewTemplateFromObjectFunction(func() *gatewayv1.HTTPRoute { return route.(*gatewayv1.HTTPRoute) }).
+			Apply(meta[*gatewayv1.HTTPRoute](main))
+	}
+}
+
+// trafficSplit returns the envoy traffic split between the main and the canary
+// workloads, or nil if the canary does not have a traffic weight
+func trafficSplit(main DeploymentWorkload, canary DeploymentWorkload) *envoyconfig.TrafficSplit {
+	if lo.IsNil(canary) {
+		return nil
+	}
+
+	w, ok := canary.(WithTrafficWeight)
+	if !ok || w.TrafficWeight() == nil {
+		return nil
+	}
+
+	return &envoyconfig.TrafficSplit{
+		Weight:     uint32(*w.TrafficWeight()),
+		MainHost:   upstreamHost(main),
+		CanaryHost: upstreamHost(canary),
+	}
+}
+
+func upstreamName(w WithKey) string {
+	return w.GetKey().Name + "-upstream"
+}
+
+func upstreamHost(w WithKey) string {
+	return fmt.Sprintf("%s.%s.svc.cluster.local", upstreamName(w), w.GetKey().Namespace)
+}
+
+func upstreamService(w DeploymentWorkload) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: upstreamName(w),
+		},
+		Spec: corev1.ServiceSpec{
+			Type:      corev1.ServiceTypeClusterIP,
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  w.GetSelector(),
+		},
+	}
+}
+
 var (
 	EmptyKey      types.NamespacedName = types.NamespacedName{}
 	EmptyLabel    map[string]string    = map[string]string{}
 	EmptySelector map[string]string    = map[string]string{}
 )
 
-func workloadResources(workload DeploymentWorkload) []resource.TemplateInterface {
+func workloadResources(workload DeploymentWorkload, opts Options) []resource.TemplateInterface {
+	scaledObject := kedaSpec(workload)
+
 	resources := []resource.TemplateInterface{
 
 		workload.Deployment().
 			Apply(meta[*appsv1.Deployment](workload)).
 			Apply(selector[*appsv1.Deployment](workload)).
-			Apply(trafficSelectorToDeployment(workload)),
+			Apply(trafficSelectorToDeployment(workload)).
+			Apply(scaleAbortedToZero(workload)),
 
 		resource.NewTemplate(
 			pdb.New(EmptyKey, EmptyLabel, EmptySelector, *workload.PDBSpec())).
@@ -111,7 +258,8 @@ func workloadResources(workload DeploymentWorkload) []resource.TemplateInterface
 
 		resource.NewTemplate(
 			hpa.New(EmptyKey, EmptyLabel, *workload.HPASpec())).
-			WithEnabled(!workload.HPASpec().IsDeactivated()).
+			// KEDA manages its own HorizontalPodAutoscaler
+			WithEnabled(!workload.HPASpec().IsDeactivated() && scaledObject == nil).
 			Apply(meta[*autoscalingv2.HorizontalPodAutoscaler](workload)).
 			Apply(scaleTargetRefToHPA(workload)),
 
@@ -122,16 +270,65 @@ func workloadResources(workload DeploymentWorkload) []resource.TemplateInterface
 			Apply(selector[*monitoringv1.PodMonitor](workload)),
 	}
 
+	// The ScaledObject template is only added when KEDA is used or its API is known
+	// to be served, so clusters without KEDA installed do not need to serve the keda.sh API
+	if scaledObject != nil || opts.KEDAAvailable {
+		resources = append(resources,
+			resource.NewTemplate(
+				keda.New(EmptyKey, EmptyLabel, ptr.Deref(scaledObject, saasv1alpha1.KEDAScaledObjectSpec{}))).
+				WithEnabled(scaledObject != nil).
+				// the KEDA types only model a subset of the API
+				WithModifyOp(resource.ModifyOpPatch).
+				Apply(meta[*kedav1alpha1.ScaledObject](workload)).
+				Apply(scaleTargetRefToScaledObject(workload)),
+		)
+	}
+
+	// The VerticalPodAutoscaler template is only added when it is used, so clusters
+	// without the vertical-pod-autoscaler installed do not need to serve its API
+	if vpaSpec := VPASpec(workload); vpaSpec != nil {
+		resources = append(resources,
+			resource.NewTemplate(
+				vpa.New(EmptyKey, EmptyLabel, *vpaSpec)).
+				// the VerticalPodAutoscaler types only model a subset of the API
+				WithModifyOp(resource.ModifyOpPatch).
+				Apply(meta[*vpav1.VerticalPodAutoscaler](workload)).
+				Apply(targetRefToVPA(workload)).
+				Apply(autoscalersToVPA(vpaSpec, workload.HPASpec(), scaledObject)),
+		)
+	}
+
 	return resources
 }
 
+// VPASpec returns the VerticalPodAutoscaler spec of the workload, or nil
+// if the workload does not have a VerticalPodAutoscaler
+func VPASpec(w DeploymentWorkload) *saasv1alpha1.VerticalPodAutoscalerSpec {
+	if v, ok := w.(WithVerticalPodAutoscaler); ok {
+		return v.VPASpec()
+	}
+
+	return nil
+}
+
+// kedaSpec returns the KEDA ScaledObject spec of the workload, or nil if
+// the workload is not autoscaled by KEDA
+func kedaSpec(w DeploymentWorkload) *saasv1alpha1.KEDAScaledObjectSpec {
+	if k, ok := w.(WithKEDAScaledObject); ok {
+		return k.KEDASpec()
+	}
+
+	return nil
+}
+
 func meta[T client.Object](w WithWorkloadMeta) resource.TemplateBuilderFunction[T] {
 	return func(o client.Object) (T, error) {
 		switch o.(type) {
-		case *corev1.Service:
+		case *corev1.Service, *gatewayv1.HTTPRoute, *gatewayv1.GRPCRoute, *gatewayv1alpha2.TLSRoute,
+			*networkingv1.Ingress, *routev1.Route:
 			// Do not enforce metadata.name:
-			//   Services are special because there can be more than one of them, so the Name
-			//   is relevant and must be provided by the service template
+			//   Services and routes are special because there can be more than one of them, so the
+			//   Name is relevant and must be provided by the template
 		default:
 			o.SetName(w.GetKey().Name)
 		}
@@ -184,6 +381,15 @@ func trafficSwitcher(main WithCanary, canary WithCanary) map[string]string {
 	}
 }
 
+func weightedTrafficSelectorToService(main WithCanary) resource.TemplateBuilderFunction[*corev1.Service] {
+	return func(o client.Object) (*corev1.Service, error) {
+		svc := o.(*corev1.Service)
+		svc.Spec.Selector = util.MergeMaps(map[string]string{}, main.GetSelector(), main.TrafficSelector())
+
+		return svc, nil
+	}
+}
+
 func scaleTargetRefToHPA(w WithWorkloadMeta) resource.TemplateBuilderFunction[*autoscalingv2.HorizontalPodAutoscaler] {
 	return func(o client.Object) (*autoscalingv2.HorizontalPodAutoscaler, error) {
 		hpa := o.(*autoscalingv2.HorizontalPodAutoscaler)
@@ -197,6 +403,45 @@ func scaleTargetRefToHPA(w WithWorkloadMeta) resource.TemplateBuilderFunction[*a
 	}
 }
 
+func scaleTargetRefToScaledObject(w WithWorkloadMeta) resource.TemplateBuilderFunction[*kedav1alpha1.ScaledObject] {
+	return func(o client.Object) (*kedav1alpha1.ScaledObject, error) {
+		so := o.(*kedav1alpha1.ScaledObject)
+		so.Spec.ScaleTargetRef = &kedav1alpha1.ScaleTarget{
+			Kind:       "Deployment",
+			Name:       w.GetKey().Name,
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		}
+
+		return so, nil
+	}
+}
+
+func targetRefToVPA(w WithWorkloadMeta) resource.TemplateBuilderFunction[*vpav1.VerticalPodAutoscaler] {
+	return func(o client.Object) (*vpav1.VerticalPodAutoscaler, error) {
+		vpa := o.(*vpav1.VerticalPodAutoscaler)
+		vpa.Spec.TargetRef = &autoscalingv1.CrossVersionObjectReference{
+			Kind:       "Deployment",
+			Name:       w.GetKey().Name,
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		}
+
+		return vpa, nil
+	}
+}
+
+// autoscalersToVPA refuses to generate the VerticalPodAutoscaler if it conflicts with the
+// HorizontalPodAutoscaler, as the admission webhooks that reject it might be disabled
+func autoscalersToVPA(spec *saasv1alpha1.VerticalPodAutoscalerSpec, hpa *saasv1alpha1.HorizontalPodAutoscalerSpec,
+	keda *saasv1alpha1.KEDAScaledObjectSpec) resource.TemplateBuilderFunction[*vpav1.VerticalPodAutoscaler] {
+	return func(o client.Object) (*vpav1.VerticalPodAutoscaler, error) {
+		if err := spec.ValidateAutoscalers(hpa, keda); err != nil {
+			return nil, err
+		}
+
+		return o.(*vpav1.VerticalPodAutoscaler), nil
+	}
+}
+
 func selector[T client.Object](w DeploymentWorkload) resource.TemplateBuilderFunction[T] {
 	return func(o client.Object) (T, error) {
 		switch v := o.(type) {
@@ -243,6 +488,29 @@ func nodeIdToEnvoyConfig(sd service.ServiceDescriptor) resource.TemplateBuilderF
 	}
 }
 
+// aborted returns true if the workload is a canary that
+// has not passed the canary analysis
+func aborted(w DeploymentWorkload) bool {
+	if lo.IsNil(w) {
+		return false
+	}
+
+	a, ok := w.(WithCanaryAnalysis)
+
+	return ok && a.Aborted()
+}
+
+func scaleAbortedToZero(w DeploymentWorkload) resource.TemplateBuilderFunction[*appsv1.Deployment] {
+	return func(o client.Object) (*appsv1.Deployment, error) {
+		dep := o.(*appsv1.Deployment)
+		if aborted(w) {
+			dep.Spec.Replicas = ptr.To[int32](0)
+		}
+
+		return dep, nil
+	}
+}
+
 func toWithCanaryOrNil(w DeploymentWorkload) WithCanary {
 	if lo.IsNil(w) {
 		return nil
@@ -12,14 +12,19 @@ import (
 	envoy_serializer "github.com/3scale-sre/marin3r/api/envoy/serializer"
 	marin3rv1alpha1 "github.com/3scale-sre/marin3r/api/marin3r/v1alpha1"
 	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	kedav1alpha1 "github.com/3scale-sre/saas-operator/internal/pkg/keda/v1alpha1"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/envoyconfig"
 	descriptor "github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/envoyconfig/descriptor"
 	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/service"
 	operatorscheme "github.com/3scale-sre/saas-operator/internal/pkg/scheme"
+	vpav1 "github.com/3scale-sre/saas-operator/internal/pkg/vpa/v1"
 	"github.com/google/go-cmp/cmp"
+	routev1 "github.com/openshift/api/route/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -27,6 +32,8 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
 // TEST GENERATORS
@@ -34,6 +41,8 @@ type TestWorkloadGenerator struct {
 	TName               string
 	TNamespace          string
 	TTraffic            bool
+	TWeight             *int32
+	TAborted            bool
 	TLabels             map[string]string
 	TSelector           map[string]string
 	TTrafficSelector    map[string]string
@@ -98,7 +107,9 @@ func (gen *TestWorkloadGenerator) PDBSpec() *saasv1alpha1.PodDisruptionBudgetSpe
 		MaxUnavailable: ptr.To(intstr.FromInt(1)),
 	}
 }
-func (gen *TestWorkloadGenerator) SendTraffic() bool { return gen.TTraffic }
+func (gen *TestWorkloadGenerator) SendTraffic() bool     { return gen.TTraffic }
+func (gen *TestWorkloadGenerator) TrafficWeight() *int32 { return gen.TWeight }
+func (gen *TestWorkloadGenerator) Aborted() bool         { return gen.TAborted }
 
 func (gen *TestWorkloadGenerator) PublishingStrategies() ([]service.ServiceDescriptor, error) {
 	return gen.TPublishingStrategy, nil
@@ -568,7 +579,7 @@ func TestWorkloadReconciler_NewDeploymentWorkload(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			operatorscheme.BuildAndRegister()
 
-			templates, err := New(tt.args.main, tt.args.canary)
+			templates, err := New(tt.args.main, tt.args.canary, Options{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
 
@@ -634,6 +645,178 @@ func Test_applyTrafficSelectorToDeployment(t *testing.T) {
 	}
 }
 
+func Test_scaleAbortedToZero(t *testing.T) {
+	type args struct {
+		w DeploymentWorkload
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want *appsv1.Deployment
+	}{
+		{
+			name: "Scales an aborted canary to zero",
+			args: args{
+				w: &TestWorkloadGenerator{TAborted: true},
+			},
+			want: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](0)},
+			},
+		},
+		{
+			name: "Does not modify other workloads",
+			args: args{
+				w: &TestWorkloadGenerator{},
+			},
+			want: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operatorscheme.BuildAndRegister()
+
+			got, _ := resource.NewTemplate[*appsv1.Deployment](
+				func(client.Object) (*appsv1.Deployment, error) {
+					return &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)}}, nil
+				}).Apply(scaleAbortedToZero(tt.args.w)).Build(context.TODO(), nil, nil)
+			if diff := cmp.Diff(got, tt.want); len(diff) > 0 {
+				t.Errorf("scaleAbortedToZero() got diff %v", diff)
+			}
+		})
+	}
+}
+
+func Test_workloadResources_ScaledObject(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        Options
+		wantPresent bool
+	}{
+		{
+			name:        "Does not add the ScaledObject if KEDA is not used",
+			opts:        Options{},
+			wantPresent: false,
+		},
+		{
+			name:        "Adds a disabled ScaledObject if the KEDA API is available",
+			opts:        Options{KEDAAvailable: true},
+			wantPresent: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operatorscheme.BuildAndRegister()
+
+			w := &TestWorkloadGenerator{TName: "my-workload", TNamespace: "ns"}
+			present := false
+
+			for _, tpl := range workloadResources(w, tt.opts) {
+				o, err := tpl.Build(context.TODO(), nil, nil)
+				if err != nil {
+					t.Fatalf("Build() error = %v", err)
+				}
+
+				if _, ok := o.(*kedav1alpha1.ScaledObject); ok {
+					present = true
+
+					if tpl.Enabled() {
+						t.Errorf("workloadResources() expected the ScaledObject to be disabled")
+					}
+				}
+			}
+
+			if present != tt.wantPresent {
+				t.Errorf("workloadResources() ScaledObject present = %v, want %v", present, tt.wantPresent)
+			}
+		})
+	}
+}
+
+func Test_autoscalersToVPA(t *testing.T) {
+	hpa := &saasv1alpha1.HorizontalPodAutoscalerSpec{ResourceName: ptr.To("cpu")}
+
+	tests := []struct {
+		name    string
+		spec    *saasv1alpha1.VerticalPodAutoscalerSpec
+		keda    *saasv1alpha1.KEDAScaledObjectSpec
+		wantErr bool
+	}{
+		{
+			name:    "Refuses a VPA that applies recommendations for the HPA resource",
+			spec:    &saasv1alpha1.VerticalPodAutoscalerSpec{UpdateMode: ptr.To(saasv1alpha1.VPAUpdateModeAuto)},
+			wantErr: true,
+		},
+		{
+			name:    "Allows a VPA that only computes recommendations",
+			spec:    &saasv1alpha1.VerticalPodAutoscalerSpec{UpdateMode: ptr.To(saasv1alpha1.VPAUpdateModeOff)},
+			wantErr: false,
+		},
+		{
+			name:    "Allows a VPA if the workload is autoscaled by KEDA",
+			spec:    &saasv1alpha1.VerticalPodAutoscalerSpec{UpdateMode: ptr.To(saasv1alpha1.VPAUpdateModeAuto)},
+			keda:    &saasv1alpha1.KEDAScaledObjectSpec{},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := resource.NewTemplate[*vpav1.VerticalPodAutoscaler](
+				func(client.Object) (*vpav1.VerticalPodAutoscaler, error) {
+					return &vpav1.VerticalPodAutoscaler{}, nil
+				}).Apply(autoscalersToVPA(tt.spec, hpa, tt.keda)).Build(context.TODO(), nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("autoscalersToVPA() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_routeTemplate(t *testing.T) {
+	tests := []struct {
+		name  string
+		route client.Object
+		want  resource.ModifyOp
+	}{
+		{
+			name:  "Updates Ingresses",
+			route: &networkingv1.Ingress{},
+			want:  resource.ModifyOpUpdate,
+		},
+		{
+			name:  "Patches OpenShift Routes",
+			route: &routev1.Route{},
+			want:  resource.ModifyOpPatch,
+		},
+		{
+			name:  "Updates HTTPRoutes",
+			route: &gatewayv1.HTTPRoute{},
+			want:  resource.ModifyOpUpdate,
+		},
+		{
+			name:  "Updates GRPCRoutes",
+			route: &gatewayv1.GRPCRoute{},
+			want:  resource.ModifyOpUpdate,
+		},
+		{
+			name:  "Updates TLSRoutes",
+			route: &gatewayv1alpha2.TLSRoute{},
+			want:  resource.ModifyOpUpdate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operatorscheme.BuildAndRegister()
+
+			if got := routeTemplate(&TestWorkloadGenerator{}, tt.route).GetReconcileOptions().ModifyOp; got != tt.want {
+				t.Errorf("routeTemplate() ModifyOp = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_applyHPAScaleTargetRef(t *testing.T) {
 	type args struct {
 		w WithWorkloadMeta
@@ -850,6 +1033,79 @@ func Test_applyTrafficSelectorToService(t *testing.T) {
 	}
 }
 
+func Test_weightedTrafficSelectorToService(t *testing.T) {
+	operatorscheme.BuildAndRegister()
+
+	main := &TestWorkloadGenerator{
+		TName:            "w1",
+		TNamespace:       "ns",
+		TTraffic:         false,
+		TSelector:        map[string]string{"name": "w1"},
+		TTrafficSelector: map[string]string{"aaa": "aaa"},
+	}
+	want := &corev1.Service{
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"aaa": "aaa", "name": "w1"},
+		},
+	}
+
+	got, _ := resource.NewTemplate[*corev1.Service](
+		func(client.Object) (*corev1.Service, error) { return &corev1.Service{}, nil },
+	).Apply(weightedTrafficSelectorToService(main)).Build(context.TODO(), nil, nil)
+	if diff := cmp.Diff(got, want); len(diff) > 0 {
+		t.Errorf("weightedTrafficSelectorToService() got diff %v", diff)
+	}
+}
+
+func Test_trafficSplit(t *testing.T) {
+	type args struct {
+		main   DeploymentWorkload
+		canary DeploymentWorkload
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want *envoyconfig.TrafficSplit
+	}{
+		{
+			name: "Returns nil without canary",
+			args: args{
+				main:   &TestWorkloadGenerator{TName: "main", TNamespace: "ns"},
+				canary: nil,
+			},
+			want: nil,
+		},
+		{
+			name: "Returns nil if the canary has no weight",
+			args: args{
+				main:   &TestWorkloadGenerator{TName: "main", TNamespace: "ns"},
+				canary: &TestWorkloadGenerator{TName: "canary", TNamespace: "ns", TTraffic: true},
+			},
+			want: nil,
+		},
+		{
+			name: "Returns the traffic split",
+			args: args{
+				main:   &TestWorkloadGenerator{TName: "main", TNamespace: "ns"},
+				canary: &TestWorkloadGenerator{TName: "canary", TNamespace: "ns", TWeight: ptr.To[int32](25)},
+			},
+			want: &envoyconfig.TrafficSplit{
+				Weight:     25,
+				MainHost:   "main-upstream.ns.svc.cluster.local",
+				CanaryHost: "canary-upstream.ns.svc.cluster.local",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(trafficSplit(tt.args.main, tt.args.canary), tt.want); len(diff) > 0 {
+				t.Errorf("trafficSplit() got diff %v", diff)
+			}
+		})
+	}
+}
+
 func Test_trafficSwitcher(t *testing.T) {
 	type args struct {
 		main   TestWorkloadGenerator
@@ -0,0 +1,77 @@
+package deployment
+
+import (
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/service"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Endpoint holds the resources that publish an endpoint of a workload
+type Endpoint struct {
+	service.ServiceDescriptor
+	// Service is the Service of the endpoint
+	Service *corev1.Service
+	// Routes are the Gateway API routes, Ingresses or OpenShift
+	// Routes that send traffic to the Service, if any
+	Routes []client.Object
+}
+
+// Endpoints returns the endpoints of the workload, with the same resource
+// names and namespace that New generates for them
+func Endpoints(main DeploymentWorkload, opts Options) ([]Endpoint, error) {
+	w, ok := main.(WithPublishingStrategies)
+	if !ok {
+		return nil, nil
+	}
+
+	strategies, err := w.PublishingStrategies()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]Endpoint, 0, len(strategies))
+
+	for _, descriptor := range strategies {
+		ep := Endpoint{ServiceDescriptor: descriptor}
+
+		switch descriptor.Strategy {
+		case saasv1alpha1.SimpleStrategy:
+			ep.Service = descriptor.Service(main.GetKey().Name, "svc")
+
+		case saasv1alpha1.Marin3rSidecarStrategy:
+			ep.Service = descriptor.Service(main.GetKey().Name, "marin3r")
+
+		case saasv1alpha1.GatewayAPIStrategy:
+			ep.Service = descriptor.Service(main.GetKey().Name, "svc")
+
+			route, err := descriptor.Route(main.GetKey().Name, ep.Service)
+			if err != nil {
+				return nil, err
+			}
+
+			ep.Routes = []client.Object{route}
+
+		case saasv1alpha1.IngressStrategy:
+			ep.Service = descriptor.Service(main.GetKey().Name, "svc")
+
+			ep.Routes, err = descriptor.Ingresses(main.GetKey().Name, ep.Service, opts.DefaultIngressKind)
+			if err != nil {
+				return nil, err
+			}
+
+		default:
+			continue
+		}
+
+		ep.Service.SetNamespace(main.GetKey().Namespace)
+
+		for _, route := range ep.Routes {
+			route.SetNamespace(main.GetKey().Namespace)
+		}
+
+		endpoints = append(endpoints, ep)
+	}
+
+	return endpoints, nil
+}
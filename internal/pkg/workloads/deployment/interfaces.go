@@ -44,6 +44,18 @@ type WithHorizontalPodAutoscaler interface {
 	HPASpec() *saasv1alpha1.HorizontalPodAutoscalerSpec
 }
 
+// WithKEDAScaledObject is implemented by workloads that can be autoscaled
+// by a KEDA ScaledObject instead of a HorizontalPodAutoscaler
+type WithKEDAScaledObject interface {
+	KEDASpec() *saasv1alpha1.KEDAScaledObjectSpec
+}
+
+// WithVerticalPodAutoscaler is implemented by workloads that can have
+// their resource requirements recommended by a VerticalPodAutoscaler
+type WithVerticalPodAutoscaler interface {
+	VPASpec() *saasv1alpha1.VerticalPodAutoscalerSpec
+}
+
 type WithCanary interface {
 	WithWorkloadMeta
 	WithSelector
@@ -51,6 +63,18 @@ type WithCanary interface {
 	TrafficSelector() map[string]string
 }
 
+// WithTrafficWeight is implemented by canary workloads that can
+// receive a percentage of the traffic of the main workload
+type WithTrafficWeight interface {
+	TrafficWeight() *int32
+}
+
+// WithCanaryAnalysis is implemented by canary workloads that are
+// scaled to zero when they do not pass the canary analysis
+type WithCanaryAnalysis interface {
+	Aborted() bool
+}
+
 type WithMarin3rSidecar interface {
 	WithWorkloadMeta
 	EnvoyDynamicConfigurations() []descriptor.EnvoyDynamicConfigDescriptor
@@ -0,0 +1,17 @@
+package deployment
+
+import (
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+)
+
+// Options holds the settings that depend on the cluster the workloads
+// are deployed to and not on the custom resource being reconciled
+type Options struct {
+	// DefaultIngressKind is the kind of resource generated by the Ingress publishing
+	// strategy when the kind is not explicitly set. Defaults to Ingress.
+	DefaultIngressKind saasv1alpha1.IngressKind
+	// KEDAAvailable is true when the cluster serves the keda.sh API. The ScaledObject
+	// of the workloads is then always reconciled, so it is deleted once it is no longer
+	// used. Otherwise it is only reconciled when used, as the API might not exist.
+	KEDAAvailable bool
+}
@@ -0,0 +1,54 @@
+package v1alpha1
+
+import (
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/envoyconfig"
+	"github.com/3scale-sre/saas-operator/internal/pkg/resource_builders/envoyconfig/factory"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validatePublishingStrategies checks that the envoy dynamic configurations of the
+// Marin3rSidecar publishing strategies use registered generator versions and that
+// the envoy resources they generate are valid
+func validatePublishingStrategies(fldPath *field.Path, strategies *saasv1alpha1.PublishingStrategies) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if strategies == nil {
+		return errs
+	}
+
+	for idx, strategy := range strategies.Endpoints {
+		if strategy.Marin3rSidecar == nil || len(strategy.Marin3rSidecar.EnvoyDynamicConfig) == 0 {
+			continue
+		}
+
+		path := fldPath.Child("endpoints").Index(idx).Child("marin3rSidecar", "dynamicConfigs")
+		descriptors := strategy.Marin3rSidecar.EnvoyDynamicConfig.AsList()
+		registered := true
+
+		for _, desc := range descriptors {
+			if desc.GetOptions() == nil {
+				errs = append(errs, field.Required(path.Key(desc.GetName()), "one type of envoy resource must be configured"))
+				registered = false
+
+				continue
+			}
+
+			if _, err := factory.Default().GetClass(desc); err != nil {
+				errs = append(errs, field.NotSupported(path.Key(desc.GetName()).Child("generatorVersion"),
+					desc.GetGeneratorVersion(), factory.Default().GeneratorVersions(desc)))
+				registered = false
+			}
+		}
+
+		if !registered {
+			continue
+		}
+
+		if err := envoyconfig.Validate(factory.Default(), nil, descriptors...); err != nil {
+			errs = append(errs, field.Invalid(path, field.OmitValueType{}, err.Error()))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,176 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-apicast,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=apicasts,verbs=create;update,versions=v1alpha1,name=mapicast-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-apicast,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=apicasts,verbs=create;update,versions=v1alpha1,name=vapicast-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-autossl,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=autossls,verbs=create;update,versions=v1alpha1,name=mautossl-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-autossl,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=autossls,verbs=create;update,versions=v1alpha1,name=vautossl-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-backend,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=backends,verbs=create;update,versions=v1alpha1,name=mbackend-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-backend,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=backends,verbs=create;update,versions=v1alpha1,name=vbackend-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-corsproxy,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=corsproxies,verbs=create;update,versions=v1alpha1,name=mcorsproxy-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-corsproxy,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=corsproxies,verbs=create;update,versions=v1alpha1,name=vcorsproxy-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-echoapi,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=echoapis,verbs=create;update,versions=v1alpha1,name=mechoapi-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-echoapi,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=echoapis,verbs=create;update,versions=v1alpha1,name=vechoapi-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-mappingservice,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=mappingservices,verbs=create;update,versions=v1alpha1,name=mmappingservice-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-mappingservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=mappingservices,verbs=create;update,versions=v1alpha1,name=vmappingservice-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-redisshard,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=redisshards,verbs=create;update,versions=v1alpha1,name=mredisshard-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-redisshard,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=redisshards,verbs=create;update,versions=v1alpha1,name=vredisshard-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-sentinel,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=sentinels,verbs=create;update,versions=v1alpha1,name=msentinel-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-sentinel,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=sentinels,verbs=create;update,versions=v1alpha1,name=vsentinel-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-shardedredisbackup,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=shardedredisbackups,verbs=create;update,versions=v1alpha1,name=mshardedredisbackup-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-shardedredisbackup,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=shardedredisbackups,verbs=create;update,versions=v1alpha1,name=vshardedredisbackup-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-system,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=systems,verbs=create;update,versions=v1alpha1,name=msystem-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-system,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=systems,verbs=create;update,versions=v1alpha1,name=vsystem-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-twemproxyconfig,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=twemproxyconfigs,verbs=create;update,versions=v1alpha1,name=mtwemproxyconfig-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-twemproxyconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=twemproxyconfigs,verbs=create;update,versions=v1alpha1,name=vtwemproxyconfig-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-saas-3scale-net-v1alpha1-zync,mutating=true,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=zyncs,verbs=create;update,versions=v1alpha1,name=mzync-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-saas-3scale-net-v1alpha1-zync,mutating=false,failurePolicy=fail,sideEffects=None,groups=saas.3scale.net,resources=zyncs,verbs=create;update,versions=v1alpha1,name=vzync-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Object is a custom resource that implements in memory
+// defaulting and validation
+type Object interface {
+	client.Object
+	Default()
+	Validate() error
+}
+
+// SetupWebhooksWithManager registers the defaulting and validating
+// webhooks of all the v1alpha1 custom resources in the manager
+func SetupWebhooksWithManager(mgr ctrl.Manager) error {
+	spec := field.NewPath("spec")
+
+	for _, setup := range []func(ctrl.Manager) error{
+		webhookFor(&saasv1alpha1.Apicast{}, func(o *saasv1alpha1.Apicast) field.ErrorList {
+			return append(
+				validatePublishingStrategies(spec.Child("staging", "publishingStrategies"), o.Spec.Staging.PublishingStrategies),
+				validatePublishingStrategies(spec.Child("production", "publishingStrategies"), o.Spec.Production.PublishingStrategies)...,
+			)
+		}),
+		webhookFor(&saasv1alpha1.AutoSSL{}, func(o *saasv1alpha1.AutoSSL) field.ErrorList {
+			return validatePublishingStrategies(spec.Child("publishingStrategies"), o.Spec.PublishingStrategies)
+		}),
+		webhookFor(&saasv1alpha1.Backend{}, func(o *saasv1alpha1.Backend) field.ErrorList {
+			return validatePublishingStrategies(spec.Child("listener", "publishingStrategies"), o.Spec.Listener.PublishingStrategies)
+		}),
+		webhookFor(&saasv1alpha1.CORSProxy{}, func(o *saasv1alpha1.CORSProxy) field.ErrorList {
+			return validatePublishingStrategies(spec.Child("publishingStrategies"), o.Spec.PublishingStrategies)
+		}),
+		webhookFor(&saasv1alpha1.EchoAPI{}, func(o *saasv1alpha1.EchoAPI) field.ErrorList {
+			return validatePublishingStrategies(spec.Child("publishingStrategies"), o.Spec.PublishingStrategies)
+		}),
+		webhookFor(&saasv1alpha1.MappingService{}, func(o *saasv1alpha1.MappingService) field.ErrorList {
+			return validatePublishingStrategies(spec.Child("publishingStrategies"), o.Spec.PublishingStrategies)
+		}),
+		webhookFor(&saasv1alpha1.System{}, func(o *saasv1alpha1.System) field.ErrorList {
+			return validatePublishingStrategies(spec.Child("app", "publishingStrategies"), o.Spec.App.PublishingStrategies)
+		}),
+		webhookFor(&saasv1alpha1.Zync{}, func(o *saasv1alpha1.Zync) field.ErrorList {
+			return validatePublishingStrategies(spec.Child("api", "publishingStrategies"), o.Spec.API.PublishingStrategies)
+		}),
+		webhookFor[*saasv1alpha1.RedisShard](&saasv1alpha1.RedisShard{}, nil),
+		webhookFor[*saasv1alpha1.Sentinel](&saasv1alpha1.Sentinel{}, nil),
+		webhookFor[*saasv1alpha1.ShardedRedisBackup](&saasv1alpha1.ShardedRedisBackup{}, nil),
+		webhookFor[*saasv1alpha1.TwemproxyConfig](&saasv1alpha1.TwemproxyConfig{}, nil),
+	} {
+		if err := setup(mgr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// webhookFor returns a function that registers the defaulting and validating webhooks
+// of the given custom resource. The extra checks are used for validations that require
+// packages the API can't import.
+func webhookFor[T Object](obj T, extra func(T) field.ErrorList) func(ctrl.Manager) error {
+	return func(mgr ctrl.Manager) error {
+		return ctrl.NewWebhookManagedBy(mgr).For(obj).
+			WithDefaulter(&CustomDefaulter[T]{}).
+			WithValidator(&CustomValidator[T]{Extra: extra}).
+			Complete()
+	}
+}
+
+// CustomDefaulter sets the default values of a custom resource
+// using its in memory Default() method
+type CustomDefaulter[T Object] struct{}
+
+var _ admission.CustomDefaulter = &CustomDefaulter[*saasv1alpha1.Apicast]{}
+
+// Default implements admission.CustomDefaulter
+func (d *CustomDefaulter[T]) Default(_ context.Context, obj runtime.Object) error {
+	o, ok := obj.(T)
+	if !ok {
+		return fmt.Errorf("expected an object of type %T but got %T", *new(T), obj)
+	}
+
+	o.Default()
+
+	return nil
+}
+
+// CustomValidator validates a custom resource using its in memory
+// Validate() method and the Extra checks, if any
+type CustomValidator[T Object] struct {
+	Extra func(T) field.ErrorList
+}
+
+var _ admission.CustomValidator = &CustomValidator[*saasv1alpha1.Apicast]{}
+
+// ValidateCreate implements admission.CustomValidator
+func (v *CustomValidator[T]) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator
+func (v *CustomValidator[T]) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator
+func (v *CustomValidator[T]) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *CustomValidator[T]) validate(obj runtime.Object) error {
+	o, ok := obj.(T)
+	if !ok {
+		return fmt.Errorf("expected an object of type %T but got %T", *new(T), obj)
+	}
+
+	// Do not block the updates that release a resource being
+	// deleted, like the removal of finalizers
+	if o.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	// Validate a defaulted copy so the result does not depend on
+	// the mutating webhook being called first
+	o = o.DeepCopyObject().(T)
+	o.Default()
+
+	if err := o.Validate(); err != nil {
+		return err
+	}
+
+	if v.Extra != nil {
+		if errs := v.Extra(o); len(errs) > 0 {
+			return apierrors.NewInvalid(o.GetObjectKind().GroupVersionKind().GroupKind(), o.GetName(), errs)
+		}
+	}
+
+	return nil
+}
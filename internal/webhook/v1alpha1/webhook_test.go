@@ -0,0 +1,170 @@
+package v1alpha1
+
+import (
+	"context"
+
+	saasv1alpha1 "github.com/3scale-sre/saas-operator/api/v1alpha1"
+	saasv1beta1 "github.com/3scale-sre/saas-operator/api/v1beta1"
+	testutil "github.com/3scale-sre/saas-operator/test/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("v1alpha1 webhooks", func() {
+	namespace := *(new(string))
+
+	BeforeEach(func() {
+		namespace = testutil.CreateNamespace(nameGenerator, k8sClient, timeout, poll)
+	})
+
+	autossl := func(name string) *saasv1alpha1.AutoSSL {
+		return &saasv1alpha1.AutoSSL{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: saasv1alpha1.AutoSSLSpec{
+				Config: saasv1alpha1.AutoSSLConfig{
+					ContactEmail:         "admin@example.com",
+					ProxyEndpoint:        "http://proxy",
+					VerificationEndpoint: "http://verify",
+					RedisHost:            "redis",
+				},
+			},
+		}
+	}
+
+	backup := func(name, schedule string) *saasv1alpha1.ShardedRedisBackup {
+		return &saasv1alpha1.ShardedRedisBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: saasv1alpha1.ShardedRedisBackupSpec{
+				SentinelRef: "sentinel",
+				Schedule:    schedule,
+				DBFile:      "/data/dump.rdb",
+				SSHOptions: saasv1alpha1.SSHOptions{
+					User:                "root",
+					PrivateKeySecretRef: corev1.LocalObjectReference{Name: "ssh-key"},
+				},
+				S3Options: saasv1alpha1.S3Options{
+					Bucket:               "bucket",
+					Path:                 "backups",
+					Region:               "us-east-1",
+					CredentialsSecretRef: corev1.LocalObjectReference{Name: "aws-credentials"},
+				},
+			},
+		}
+	}
+
+	When("creating a custom resource", func() {
+
+		It("persists the defaulted spec", func() {
+			instance := autossl("defaulted")
+			Expect(k8sClient.Create(context.Background(), instance)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(context.Background(), types.NamespacedName{Name: "defaulted", Namespace: namespace}, instance)
+			}, timeout, poll).ShouldNot(HaveOccurred())
+
+			Expect(instance.Spec.Image).ToNot(BeNil())
+			Expect(instance.Spec.Image.Name).To(Equal(ptr.To("quay.io/3scale/autossl")))
+			Expect(instance.Spec.HPA).ToNot(BeNil())
+			Expect(instance.Spec.Config.RedisPort).To(Equal(ptr.To[int32](6379)))
+		})
+
+		It("rejects canary patches that can't be decoded", func() {
+			instance := autossl("canary")
+			instance.Spec.Canary = &saasv1alpha1.Canary{Patches: []string{`{"op":"replace"}`}}
+
+			err := k8sClient.Create(context.Background(), instance)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.canary.patches[0]"))
+		})
+
+		It("rejects unknown envoy generator versions", func() {
+			instance := autossl("envoy")
+			instance.Spec.PublishingStrategies = &saasv1alpha1.PublishingStrategies{
+				Endpoints: []saasv1alpha1.PublishingStrategy{{
+					Strategy:     saasv1alpha1.Marin3rSidecarStrategy,
+					EndpointName: "Proxy",
+					Marin3rSidecar: &saasv1alpha1.Marin3rSidecarSpec{
+						EnvoyDynamicConfig: saasv1alpha1.MapOfEnvoyDynamicConfig{
+							"autossl": {
+								GeneratorVersion: ptr.To("v9"),
+								Cluster:          &saasv1alpha1.Cluster{Host: "127.0.0.1", Port: 8080},
+							},
+						},
+					},
+				}},
+			}
+
+			err := k8sClient.Create(context.Background(), instance)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("dynamicConfigs[autossl].generatorVersion"))
+		})
+
+		It("rejects malformed backup schedules", func() {
+			err := k8sClient.Create(context.Background(), backup("malformed", "every day"))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.schedule"))
+		})
+
+		It("rejects overlapping twemproxy bind addresses", func() {
+			instance := &saasv1alpha1.TwemproxyConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "overlapping", Namespace: namespace},
+				Spec: saasv1alpha1.TwemproxyConfigSpec{
+					ServerPools: []saasv1alpha1.TwemproxyServerPool{
+						{Name: "a", BindAddress: "0.0.0.0:22121", Topology: []saasv1alpha1.ShardedRedisTopology{}},
+						{Name: "b", BindAddress: "127.0.0.1:22121", Topology: []saasv1alpha1.ShardedRedisTopology{}},
+					},
+				},
+			}
+
+			err := k8sClient.Create(context.Background(), instance)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.serverPools[1].bindAddress"))
+		})
+	})
+
+	When("using the v1beta1 API version", func() {
+
+		It("converts the custom resource to and from v1alpha1", func() {
+			instance := &saasv1beta1.EchoAPI{
+				ObjectMeta: metav1.ObjectMeta{Name: "conversion", Namespace: namespace},
+				Spec:       saasv1beta1.EchoAPISpec{Replicas: ptr.To[int32](3)},
+			}
+			Expect(k8sClient.Create(context.Background(), instance)).To(Succeed())
+
+			hub := &saasv1alpha1.EchoAPI{}
+			Eventually(func() error {
+				return k8sClient.Get(context.Background(), types.NamespacedName{Name: "conversion", Namespace: namespace}, hub)
+			}, timeout, poll).ShouldNot(HaveOccurred())
+
+			Expect(hub.Spec.Replicas).To(Equal(ptr.To[int32](3)))
+			// the v1alpha1 defaulting webhook also serves v1beta1 requests
+			Expect(hub.Spec.Image).ToNot(BeNil())
+
+			Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: "conversion", Namespace: namespace}, instance)).To(Succeed())
+			Expect(instance.Spec.Image).ToNot(BeNil())
+			Expect(instance.Spec.Image.Name).To(Equal(hub.Spec.Image.Name))
+		})
+	})
+
+	When("updating a custom resource", func() {
+
+		It("rejects invalid changes", func() {
+			instance := backup("update", "0 * * * *")
+			Expect(k8sClient.Create(context.Background(), instance)).To(Succeed())
+
+			Eventually(func() error {
+				if err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "update", Namespace: namespace}, instance); err != nil {
+					return err
+				}
+				instance.Spec.Schedule = "every hour"
+
+				return k8sClient.Update(context.Background(), instance)
+			}, timeout, poll).Should(WithTransform(apierrors.IsInvalid, BeTrue()))
+		})
+	})
+})